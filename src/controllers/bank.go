@@ -0,0 +1,355 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/zoroplay/go-wallet-service/encryption"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/paystack"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	paystackProvider = "paystack"
+	bankListTTL      = 24 * time.Hour
+)
+
+// banks caches the bank list of each provider per client
+var banks = struct {
+	sync.Mutex
+	lists   map[string][]paystack.Bank
+	expires map[string]time.Time
+}{lists: map[string][]paystack.Bank{}, expires: map[string]time.Time{}}
+
+// bankListProvider returns the provider banks are listed from, set with bank_list_provider
+func bankListProvider() string {
+
+	if p := os.Getenv("bank_list_provider"); p != "" {
+
+		return p
+	}
+
+	return paystackProvider
+}
+
+// getBanks returns the banks a client's provider lists, fetched with the client's own settings. The
+// fetch runs outside the lock so a slow provider does not hold up other clients
+func getBanks(db *sql.DB, clientId int32, provider string) ([]paystack.Bank, error) {
+
+	key := fmt.Sprintf("%s:%d", provider, clientId)
+
+	banks.Lock()
+	list, ok := banks.lists[key]
+	fresh := ok && time.Now().Before(banks.expires[key])
+	banks.Unlock()
+
+	if fresh {
+
+		return list, nil
+	}
+
+	if provider != paystackProvider {
+
+		return nil, fmt.Errorf("bank list is not supported for %s", provider)
+	}
+
+	pm, err := getPaymentMethod(db, clientId, provider)
+	if err != nil {
+
+		return nil, err
+	}
+
+	list, err = paystack.NewClient(pm.BaseURL, pm.SecretKey).ListBanks(os.Getenv("bank_list_country"))
+	if err != nil {
+
+		return nil, err
+	}
+
+	banks.Lock()
+	banks.lists[key] = list
+	banks.expires[key] = time.Now().Add(bankListTTL)
+	banks.Unlock()
+
+	return list, nil
+}
+
+func bankName(db *sql.DB, clientId int32, provider, code string) string {
+
+	list, err := getBanks(db, clientId, provider)
+	if err != nil {
+
+		return ""
+	}
+
+	for _, bank := range list {
+
+		if bank.Code == code {
+
+			return bank.Name
+		}
+	}
+
+	return ""
+}
+
+// ListBanks returns the banks a client's players can withdraw to
+func ListBanks(db *sql.DB, in *pbWallet.ListBanksRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	list, err := getBanks(db, in.ClientId, bankListProvider())
+	if err == sql.ErrNoRows {
+
+		return false, 404, "Bank list is not configured for this client", nil
+	}
+
+	if err != nil {
+
+		log.Printf("error getting bank list for client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch banks", nil
+	}
+
+	return true, 200, "Banks fetched", toStructList(list)
+}
+
+// registeredName returns the name the user service recorded for a player through SavePlayerName. A
+// name sent with a verification request is never trusted, the player could choose it to match any account
+func registeredName(db *sql.DB, clientId, userId int32) (string, error) {
+
+	var name string
+	err := db.QueryRow("SELECT full_name FROM player_names WHERE client_id = ? AND user_id = ?", clientId, userId).Scan(&name)
+
+	return name, err
+}
+
+// SavePlayerName records the name a player registered with, which bank accounts are checked against
+func SavePlayerName(db *sql.DB, in *pbWallet.PlayerNameRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	name := strings.TrimSpace(in.FullName)
+	if name == "" {
+
+		return false, 400, "Full name is required", nil
+	}
+
+	_, err := db.Exec("INSERT INTO player_names (client_id, user_id, full_name) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE full_name = VALUES(full_name)",
+		in.ClientId, in.UserId, name)
+
+	if err != nil {
+
+		log.Printf("error saving name of user %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to save name", nil
+	}
+
+	return true, 200, "Name saved", toStruct(map[string]interface{}{"clientId": in.ClientId, "userId": in.UserId, "fullName": name})
+}
+
+// VerifyBankAccount resolves the name on a bank account, saves the account for the player and checks
+// the name against the player's stored registered name
+func VerifyBankAccount(db *sql.DB, in *pbWallet.VerifyBankAccountRequest) (success bool, status int32, message string, accountName string) {
+
+	log.Printf("Verifying bank account for user %d in client %d ", in.UserId, in.ClientId)
+
+	if in.AccountNumber == "" || in.BankCode == "" {
+
+		return false, 400, "Account number and bank code are required", ""
+	}
+
+	pm, err := getPaymentMethod(db, in.ClientId, paystackProvider)
+	if err != nil {
+
+		return false, 404, "Account verification is not configured for this client", ""
+	}
+
+	registered, err := registeredName(db, in.ClientId, in.UserId)
+	if err == sql.ErrNoRows {
+
+		return false, 400, "No registered name on record, the account cannot be verified", ""
+	}
+
+	if err != nil {
+
+		log.Printf("error getting registered name of user %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to verify account", ""
+	}
+
+	resolved, err := paystack.NewClient(pm.BaseURL, pm.SecretKey).ResolveAccount(in.AccountNumber, in.BankCode)
+	if err != nil {
+
+		log.Printf("error resolving bank account %s %s ", in.BankCode, err.Error())
+		return false, 400, "Unable to verify account", ""
+	}
+
+	matched := namesMatch(registered, resolved.AccountName)
+
+	account := &models.WithdrawalAccount{
+		ClientID:      int64(in.ClientId),
+		UserID:        int64(in.UserId),
+		BankCode:      in.BankCode,
+		BankName:      bankName(db, in.ClientId, paystackProvider, in.BankCode),
+		AccountNumber: in.AccountNumber,
+		AccountName:   resolved.AccountName,
+		Provider:      paystackProvider,
+	}
+
+	if matched {
+
+		account.NameMatched = 1
+	}
+
+	if err := saveWithdrawalAccount(db, account); err != nil {
+
+		log.Printf("error saving bank account for user %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to save account", resolved.AccountName
+	}
+
+	if !matched {
+
+		return false, 400, "Account name does not match your registered name", resolved.AccountName
+	}
+
+	return true, 200, "Account verified", resolved.AccountName
+}
+
+// GetUserAccounts returns the bank accounts a player has verified
+func GetUserAccounts(db *sql.DB, in *pbWallet.GetBalanceRequest) []*pbWallet.GetUserAccountsResponse_BankAccount {
+
+	rows, err := db.Query("SELECT bank_code, bank_name, account_number, account_name FROM withdrawal_accounts WHERE client_id = ? AND user_id = ? ORDER BY id DESC",
+		in.ClientId, in.UserId)
+
+	if err != nil {
+
+		log.Printf("error getting bank accounts for user %d %s ", in.UserId, err.Error())
+		return nil
+	}
+
+	defer rows.Close()
+
+	var accounts []*pbWallet.GetUserAccountsResponse_BankAccount
+
+	for rows.Next() {
+
+		var code, number, name string
+		var bankName sql.NullString
+
+		if err := rows.Scan(&code, &bankName, &number, &name); err != nil {
+
+			log.Printf("error scanning bank account %s ", err.Error())
+			continue
+		}
+
+		number, err = encryption.Decrypt(number)
+		if err != nil {
+
+			log.Printf("error decrypting bank account for user %d %s ", in.UserId, err.Error())
+			continue
+		}
+
+		accounts = append(accounts, &pbWallet.GetUserAccountsResponse_BankAccount{
+			BankCode:      code,
+			BankName:      bankName.String,
+			AccountNumber: number,
+			AccountName:   name,
+		})
+	}
+
+	return accounts
+}
+
+// saveWithdrawalAccount stores a verified account with its number encrypted, updating it if it was saved before
+func saveWithdrawalAccount(db *sql.DB, a *models.WithdrawalAccount) error {
+
+	encrypted, err := encryption.Encrypt(a.AccountNumber)
+	if err != nil {
+
+		return err
+	}
+
+	hash, err := encryption.Hash(a.AccountNumber)
+	if err != nil {
+
+		return err
+	}
+
+	_, err = db.Exec("INSERT INTO withdrawal_accounts (client_id, user_id, bank_code, bank_name, account_number, account_number_hash, account_name, provider, name_matched, created_at) "+
+		" VALUES (?,?,?,?,?,?,?,?,?,NOW()) ON DUPLICATE KEY UPDATE account_name = VALUES(account_name), bank_name = VALUES(bank_name), name_matched = VALUES(name_matched)",
+		a.ClientID, a.UserID, a.BankCode, nullString(a.BankName), encrypted, hash, a.AccountName, a.Provider, a.NameMatched)
+
+	return err
+}
+
+// checkWithdrawalAccount allows payouts only to accounts the player verified under their own name
+func checkWithdrawalAccount(db *sql.DB, clientId, userId int32, bankCode, accountNumber string) error {
+
+	hash, err := encryption.Hash(accountNumber)
+	if err != nil {
+
+		return err
+	}
+
+	var nameMatched int64
+	err = db.QueryRow("SELECT name_matched FROM withdrawal_accounts WHERE client_id = ? AND user_id = ? AND bank_code = ? AND account_number_hash = ?",
+		clientId, userId, bankCode, hash).Scan(&nameMatched)
+
+	if err == sql.ErrNoRows {
+
+		return fmt.Errorf("Please verify this account before withdrawing to it")
+	}
+
+	if err != nil {
+
+		return err
+	}
+
+	if nameMatched != 1 {
+
+		return fmt.Errorf("Account name does not match your registered name")
+	}
+
+	return nil
+}
+
+// namesMatch checks that the account name carries the registered name: every part of a one or two part
+// name, or at least two parts of a longer one, in any order
+func namesMatch(registered, accountName string) bool {
+
+	want := nameParts(registered)
+	if len(want) == 0 {
+
+		return false
+	}
+
+	have := map[string]bool{}
+	for _, part := range nameParts(accountName) {
+
+		have[part] = true
+	}
+
+	found := 0
+	for _, part := range want {
+
+		if have[part] {
+
+			found++
+		}
+	}
+
+	if len(want) <= 2 {
+
+		return found == len(want)
+	}
+
+	return found >= 2
+}
+
+func nameParts(name string) []string {
+
+	return strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
@@ -0,0 +1,344 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const cashInOutColumns = "id, client_id, branch_id, user_id, type, amount, comment, status, verified_by, verified_at, balance, transaction_no, created_at"
+
+func scanCashInOut(row rowScanner) (*models.CashInOut, error) {
+
+	var c models.CashInOut
+	var comment, verifiedAt, transactionNo sql.NullString
+	var verifiedBy sql.NullInt64
+	var balance sql.NullFloat64
+
+	err := row.Scan(&c.ID, &c.ClientID, &c.BranchID, &c.UserID, &c.Type, &c.Amount, &comment, &c.Status, &verifiedBy, &verifiedAt, &balance,
+		&transactionNo, &c.CreatedAt)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	c.Comment = comment.String
+	c.VerifiedBy = verifiedBy.Int64
+	c.VerifiedAt = verifiedAt.String
+	c.Balance = balance.Float64
+	c.TransactionNo = transactionNo.String
+
+	return &c, nil
+}
+
+func getCashInOut(db *sql.DB, kind string, id int64) (*models.CashInOut, error) {
+
+	return scanCashInOut(db.QueryRow("SELECT "+cashInOutColumns+" FROM cashbook_cash_in_out WHERE id = ? AND type = ?", id, kind))
+}
+
+func cashInOutName(kind string) string {
+
+	if kind == models.CashOut {
+
+		return "Cash out"
+	}
+
+	return "Cash in"
+}
+
+// toCashInOut converts an entry to its grpc message. Approved entries carry the branch balance right
+// after them, others the branch's current balance
+func toCashInOut(db *sql.DB, c *models.CashInOut, balances map[int64]float64) *pbWallet.CashInOut {
+
+	balance := c.Balance
+
+	if c.Status != models.CashbookApproved {
+
+		b, ok := balances[c.BranchID]
+		if !ok {
+
+			b = branchBalance(db, c.ClientID, c.BranchID)
+			balances[c.BranchID] = b
+		}
+
+		balance = b
+	}
+
+	b := int32(balance)
+
+	return &pbWallet.CashInOut{
+		Id:         int32(c.ID),
+		UserId:     int32(c.UserID),
+		ApprovedBy: int32(c.VerifiedBy),
+		BranchId:   int32(c.BranchID),
+		Amount:     int32(c.Amount),
+		Comment:    c.Comment,
+		Status:     int32(c.Status),
+		CreatedAt:  c.CreatedAt,
+		Balance:    &b,
+	}
+}
+
+func queryCashInOut(db *sql.DB, kind, query string, args ...interface{}) (success bool, status int32, message string, data []*pbWallet.CashInOut) {
+
+	args = append([]interface{}{kind}, args...)
+
+	rows, err := db.Query("SELECT "+cashInOutColumns+" FROM cashbook_cash_in_out WHERE type = ? "+query+" ORDER BY id DESC", args...)
+	if err != nil {
+
+		log.Printf("error getting %s entries %s ", kind, err.Error())
+		return false, 500, "Unable to fetch " + cashInOutName(kind), nil
+	}
+
+	defer rows.Close()
+
+	balances := map[int64]float64{}
+	data = []*pbWallet.CashInOut{}
+
+	for rows.Next() {
+
+		c, err := scanCashInOut(rows)
+		if err != nil {
+
+			log.Printf("error scanning %s entry %s ", kind, err.Error())
+			continue
+		}
+
+		data = append(data, toCashInOut(db, c, balances))
+	}
+
+	return true, 200, cashInOutName(kind) + " retrieved", data
+}
+
+// CreateCashInOut records cash a branch received from or handed to head office. It waits for approval
+func CreateCashInOut(db *sql.DB, kind string, in *pbWallet.CashbookCreateCashInOutRequest) (success bool, status int32, message string, data *pbWallet.CashInOut) {
+
+	log.Printf("Creating %s for branch %d in client %d ", kind, in.BranchId, in.ClientId)
+
+	if in.Amount <= 0 {
+
+		return false, 400, "Amount must be greater than zero", nil
+	}
+
+	res, err := db.Exec("INSERT INTO cashbook_cash_in_out (client_id, branch_id, user_id, type, amount, comment, status, created_at) VALUES (?,?,?,?,?,?,?,NOW())",
+		in.ClientId, in.BranchId, in.UserId, kind, in.Amount, nullString(in.Comment), models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error saving %s %s ", kind, err.Error())
+		return false, 500, "Unable to save " + cashInOutName(kind), nil
+	}
+
+	id, _ := res.LastInsertId()
+
+	c, err := getCashInOut(db, kind, id)
+	if err != nil {
+
+		return false, 500, "Unable to fetch " + cashInOutName(kind), nil
+	}
+
+	return true, 201, cashInOutName(kind) + " created", toCashInOut(db, c, map[int64]float64{})
+}
+
+// UpdateCashInOut changes an entry until it has been verified
+func UpdateCashInOut(db *sql.DB, kind string, in *pbWallet.CashbookCreateCashInOutRequest) (success bool, status int32, message string, data *pbWallet.CashInOut) {
+
+	c, err := getCashInOut(db, kind, int64(in.GetId()))
+	if err != nil || c.ClientID != int64(in.ClientId) {
+
+		return false, 404, cashInOutName(kind) + " not found", nil
+	}
+
+	if in.Amount <= 0 {
+
+		return false, 400, "Amount must be greater than zero", nil
+	}
+
+	res, err := db.Exec("UPDATE cashbook_cash_in_out SET amount = ?, comment = ? WHERE id = ? AND status = ?",
+		in.Amount, nullString(in.Comment), c.ID, models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error updating %s %d %s ", kind, c.ID, err.Error())
+		return false, 500, "Unable to update " + cashInOutName(kind), nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, cashInOutName(kind) + " has already been verified and can no longer be changed", nil
+	}
+
+	c, _ = getCashInOut(db, kind, c.ID)
+	return true, 200, cashInOutName(kind) + " updated", toCashInOut(db, c, map[int64]float64{})
+}
+
+// DeleteCashInOut removes an entry that has not been approved
+func DeleteCashInOut(db *sql.DB, kind string, in *pbWallet.CashbookIdRequest) (success bool, status int32, message string, data *pbWallet.CashInOut) {
+
+	c, err := getCashInOut(db, kind, int64(in.Id))
+	if err != nil || (in.ClientId > 0 && c.ClientID != int64(in.ClientId)) {
+
+		return false, 404, cashInOutName(kind) + " not found", nil
+	}
+
+	res, err := db.Exec("DELETE FROM cashbook_cash_in_out WHERE id = ? AND status <> ?", c.ID, models.CashbookApproved)
+	if err != nil {
+
+		log.Printf("error deleting %s %d %s ", kind, c.ID, err.Error())
+		return false, 500, "Unable to delete " + cashInOutName(kind), nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, "Approved entries cannot be deleted", nil
+	}
+
+	return true, 200, cashInOutName(kind) + " deleted", toCashInOut(db, c, map[int64]float64{})
+}
+
+func FindOneCashInOut(db *sql.DB, kind string, in *pbWallet.CashbookIdRequest) (success bool, status int32, message string, data *pbWallet.CashInOut) {
+
+	c, err := getCashInOut(db, kind, int64(in.Id))
+	if err != nil || (in.ClientId > 0 && c.ClientID != int64(in.ClientId)) {
+
+		return false, 404, cashInOutName(kind) + " not found", nil
+	}
+
+	return true, 200, cashInOutName(kind) + " retrieved", toCashInOut(db, c, map[int64]float64{})
+}
+
+func FindAllCashInOut(db *sql.DB, kind string) (success bool, status int32, message string, data []*pbWallet.CashInOut) {
+
+	return queryCashInOut(db, kind, "")
+}
+
+// FindAllBranchCashInOut lists a branch's entries, of one day when a date is given
+func FindAllBranchCashInOut(db *sql.DB, kind string, in *pbWallet.BranchRequest) (success bool, status int32, message string, data []*pbWallet.CashInOut) {
+
+	query := " AND client_id = ? AND branch_id = ? "
+	args := []interface{}{in.ClientId, in.BranchId}
+
+	if in.GetDate() != "" {
+
+		query += " AND DATE(created_at) = ? "
+		args = append(args, in.GetDate())
+	}
+
+	return queryCashInOut(db, kind, query, args...)
+}
+
+// FindAllBranchCashInOutByStatus lists a branch's entries with a status, of one day when a date is given
+func FindAllBranchCashInOutByStatus(db *sql.DB, kind string, entryStatus int, in *pbWallet.BranchRequest) (success bool, status int32, message string, data []*pbWallet.CashInOut) {
+
+	query := " AND client_id = ? AND branch_id = ? AND status = ? "
+	args := []interface{}{in.ClientId, in.BranchId, entryStatus}
+
+	if in.GetDate() != "" {
+
+		query += " AND DATE(created_at) = ? "
+		args = append(args, in.GetDate())
+	}
+
+	return queryCashInOut(db, kind, query, args...)
+}
+
+// ApproveCashInOut approves or rejects a pending entry. An approved cash in is credited to the branch
+// wallet and a cash out debited from it
+func ApproveCashInOut(db *sql.DB, kind string, in *pbWallet.CashbookApproveCashInOutRequest) (success bool, status int32, message string, data *pbWallet.CashInOut) {
+
+	log.Printf("Verifying %s %d by %d ", kind, in.Id, in.VerifiedBy)
+
+	c, err := getCashInOut(db, kind, int64(in.Id))
+	if err != nil {
+
+		return false, 404, cashInOutName(kind) + " not found", nil
+	}
+
+	if in.Status != models.CashbookApproved && in.Status != models.CashbookRejected {
+
+		return false, 400, "Invalid status", nil
+	}
+
+	// claim the entry first, so it is paid once however many times it is approved
+	res, err := db.Exec("UPDATE cashbook_cash_in_out SET status = ?, verified_by = ?, verified_at = NOW() WHERE id = ? AND status = ?",
+		in.Status, in.VerifiedBy, c.ID, models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error verifying %s %d %s ", kind, c.ID, err.Error())
+		return false, 500, "Unable to verify " + cashInOutName(kind), nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, cashInOutName(kind) + " has already been verified", nil
+	}
+
+	if in.Status == models.CashbookRejected {
+
+		c, _ = getCashInOut(db, kind, c.ID)
+		return true, 200, cashInOutName(kind) + " rejected", toCashInOut(db, c, map[int64]float64{})
+	}
+
+	username, _ := getWalletUsername(db, int32(c.ClientID), int32(c.BranchID))
+	amount := fmt.Sprintf("%d", c.Amount)
+	description := fmt.Sprintf("%s #%d", cashInOutName(kind), c.ID)
+
+	var ok bool
+	var walletStatus int32
+	var walletMessage, transactionNo string
+	var wallet *pbWallet.Wallet
+
+	if kind == models.CashIn {
+
+		ok, walletStatus, walletMessage, wallet, transactionNo = creditUser(db, &pbWallet.CreditUserRequest{
+			UserId:      int32(c.BranchID),
+			ClientId:    int32(c.ClientID),
+			Amount:      amount,
+			Source:      "cashbook",
+			Description: description,
+			Username:    username,
+			Wallet:      "main",
+			Subject:     "Cash In",
+			Channel:     "retail",
+		})
+	} else {
+
+		ok, walletStatus, walletMessage, wallet, transactionNo = debitUser(db, &pbWallet.DebitUserRequest{
+			UserId:      int32(c.BranchID),
+			ClientId:    int32(c.ClientID),
+			Amount:      amount,
+			Source:      "cashbook",
+			Description: description,
+			Username:    username,
+			Wallet:      "main",
+			Subject:     "Cash Out",
+			Channel:     "retail",
+		})
+	}
+
+	if !ok {
+
+		// release the entry so it can be approved again
+		_, err = db.Exec("UPDATE cashbook_cash_in_out SET status = ?, verified_by = NULL, verified_at = NULL WHERE id = ?", models.CashbookPending, c.ID)
+		if err != nil {
+
+			log.Printf("error releasing %s %d %s ", kind, c.ID, err.Error())
+		}
+
+		return false, walletStatus, walletMessage, nil
+	}
+
+	_, err = db.Exec("UPDATE cashbook_cash_in_out SET balance = ?, transaction_no = ? WHERE id = ?", wallet.AvailableBalance, transactionNo, c.ID)
+	if err != nil {
+
+		log.Printf("error saving balance of %s %d %s ", kind, c.ID, err.Error())
+	}
+
+	c, _ = getCashInOut(db, kind, c.ID)
+	return true, 200, cashInOutName(kind) + " approved", toCashInOut(db, c, map[int64]float64{})
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+// pendingCashDB returns a database holding a pending cash in or out of 500 for branch 4 of client 1
+func pendingCashDB(t *testing.T, kind string) (*sql.DB, *fakeDB) {
+
+	db, fake := newFakeDB(t)
+
+	fake.On("FROM cashbook_cash_in_out WHERE id = ?", []string{"id", "client_id", "branch_id", "user_id", "type", "amount", "comment", "status",
+		"verified_by", "verified_at", "balance", "transaction_no", "created_at"},
+		[]driver.Value{int64(6), int64(1), int64(4), int64(3), kind, int64(500), nil, int64(models.CashbookPending),
+			nil, nil, nil, nil, "2024-01-01 00:00:00"})
+
+	return db, fake
+}
+
+func approveCash() *pbWallet.CashbookApproveCashInOutRequest {
+
+	return &pbWallet.CashbookApproveCashInOutRequest{Id: 6, VerifiedBy: 2, Status: models.CashbookApproved}
+}
+
+func TestApproveCashInOut(t *testing.T) {
+
+	cases := []struct {
+		kind    string
+		subject string
+	}{
+		{models.CashIn, "Cash In"},
+		{models.CashOut, "Cash Out"},
+	}
+
+	for _, c := range cases {
+
+		t.Run(c.kind, func(t *testing.T) {
+
+			db, fake := pendingCashDB(t, c.kind)
+			openWallet(fake, 1000)
+
+			ok, status, message, _ := ApproveCashInOut(db, c.kind, approveCash())
+			if !ok {
+
+				t.Fatalf("unexpected result %d %s", status, message)
+			}
+
+			claim := fake.Index("UPDATE cashbook_cash_in_out SET status = ?, verified_by = ?")
+			entry := fake.Index("INSERT INTO transactions")
+
+			if claim < 0 || entry < 0 || claim > entry {
+
+				t.Fatalf("expected the entry claimed before the branch wallet moves, claim %d transaction %d", claim, entry)
+			}
+
+			transactions := fake.Ran("INSERT INTO transactions")
+			if len(transactions) != 1 || transactions[0].args[5] != c.subject {
+
+				t.Fatalf("expected one %s transaction, got %+v", c.subject, transactions)
+			}
+		})
+	}
+}
+
+func TestApproveCashInOutTwice(t *testing.T) {
+
+	db, fake := pendingCashDB(t, models.CashOut)
+	openWallet(fake, 1000)
+
+	// another approver claimed it after it was read
+	fake.Affects("UPDATE cashbook_cash_in_out SET status = ?, verified_by = ?", 0)
+
+	ok, status, _, _ := ApproveCashInOut(db, models.CashOut, approveCash())
+	if ok || status != 400 {
+
+		t.Fatalf("expected the second approval refused, got %v %d", ok, status)
+	}
+
+	if moves := fake.Ran("UPDATE wallets"); len(moves) != 0 {
+
+		t.Fatalf("expected the branch wallet untouched, got %+v", moves)
+	}
+}
+
+func TestApproveCashOutReleasedWhenDebitFails(t *testing.T) {
+
+	db, fake := pendingCashDB(t, models.CashOut)
+	openWallet(fake, 100)
+
+	// the branch cannot cover the cash out
+	fake.Affects("UPDATE wallets SET available_balance", 0)
+
+	ok, status, _, _ := ApproveCashInOut(db, models.CashOut, approveCash())
+	if ok || status != 400 {
+
+		t.Fatalf("expected the approval to fail, got %v %d", ok, status)
+	}
+
+	releases := fake.Ran("UPDATE cashbook_cash_in_out SET status = ?, verified_by = NULL")
+	if len(releases) != 1 || releases[0].args[0] != int64(models.CashbookPending) {
+
+		t.Fatalf("expected the entry released to pending, got %+v", releases)
+	}
+
+	if transactions := fake.Ran("INSERT INTO transactions"); len(transactions) != 0 {
+
+		t.Fatalf("expected no transaction, got %+v", transactions)
+	}
+}
@@ -0,0 +1,443 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const expenseColumns = "e.id, e.client_id, e.branch_id, e.expense_type_id, e.requested_amount, e.amount, e.status, e.branch_comment, e.admin_comment, " +
+	"e.verified_by, e.verified_at, e.balance, e.transaction_no, e.approval_level, t.title, e.created_at"
+
+const expenseTables = " cashbook_expenses e LEFT JOIN expense_types t ON t.id = e.expense_type_id "
+
+func scanExpense(row rowScanner) (*models.Expense, error) {
+
+	var e models.Expense
+	var branchComment, adminComment, verifiedAt, transactionNo, expenseType sql.NullString
+	var verifiedBy sql.NullInt64
+	var balance sql.NullFloat64
+
+	err := row.Scan(&e.ID, &e.ClientID, &e.BranchID, &e.ExpenseTypeID, &e.RequestedAmount, &e.Amount, &e.Status, &branchComment, &adminComment,
+		&verifiedBy, &verifiedAt, &balance, &transactionNo, &e.ApprovalLevel, &expenseType, &e.CreatedAt)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	e.BranchComment = branchComment.String
+	e.AdminComment = adminComment.String
+	e.VerifiedBy = verifiedBy.Int64
+	e.VerifiedAt = verifiedAt.String
+	e.Balance = balance.Float64
+	e.TransactionNo = transactionNo.String
+	e.ExpenseType = expenseType.String
+
+	return &e, nil
+}
+
+func getExpense(db *sql.DB, id int64) (*models.Expense, error) {
+
+	return scanExpense(db.QueryRow("SELECT "+expenseColumns+" FROM "+expenseTables+" WHERE e.id = ?", id))
+}
+
+func queryExpenses(db *sql.DB, query string, args ...interface{}) ([]*models.Expense, error) {
+
+	rows, err := db.Query("SELECT "+expenseColumns+" FROM "+expenseTables+query, args...)
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var expenses []*models.Expense
+	for rows.Next() {
+
+		e, err := scanExpense(rows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		expenses = append(expenses, e)
+	}
+
+	return expenses, rows.Err()
+}
+
+// branchBalance returns the current balance of a branch wallet
+func branchBalance(db *sql.DB, clientId, branchId int64) float64 {
+
+	var balance float64
+
+	err := db.QueryRow("SELECT available_balance FROM wallets WHERE user_id = ? AND client_id = ? AND "+defaultCurrencyWallet, branchId, clientId).Scan(&balance)
+	if err != nil {
+
+		log.Printf("error getting balance of branch %d %s ", branchId, err.Error())
+	}
+
+	return balance
+}
+
+// toExpense converts an expense to its grpc message. Approved expenses carry the branch balance left
+// after they were paid, pending ones the branch's current balance
+func toExpense(db *sql.DB, e *models.Expense, balances map[int64]float64) *pbWallet.Expense {
+
+	balance := e.Balance
+
+	if e.Status != models.CashbookApproved {
+
+		b, ok := balances[e.BranchID]
+		if !ok {
+
+			b = branchBalance(db, e.ClientID, e.BranchID)
+			balances[e.BranchID] = b
+		}
+
+		balance = b
+	}
+
+	b := int32(balance)
+	level := int32(e.ApprovalLevel)
+
+	return &pbWallet.Expense{
+		Id:              int32(e.ID),
+		UserId:          int32(e.BranchID),
+		ExpenseTypeId:   int32(e.ExpenseTypeID),
+		RequestedAmount: int32(e.RequestedAmount),
+		Amount:          int32(e.Amount),
+		Status:          int32(e.Status),
+		BranchComment:   e.BranchComment,
+		AdminComment:    e.AdminComment,
+		VerifiedAt:      e.VerifiedAt,
+		VerifiedBy:      int32(e.VerifiedBy),
+		CreatedAt:       e.CreatedAt,
+		Balance:         &b,
+		ExpenseType:     &e.ExpenseType,
+		ApprovalLevel:   &level,
+	}
+}
+
+func toExpenses(db *sql.DB, expenses []*models.Expense) []*pbWallet.Expense {
+
+	balances := map[int64]float64{}
+
+	data := make([]*pbWallet.Expense, 0, len(expenses))
+	for _, e := range expenses {
+
+		data = append(data, toExpense(db, e, balances))
+	}
+
+	return data
+}
+
+// CreateExpense records an expense a branch wants to pay from its till. It waits for approval
+func CreateExpense(db *sql.DB, in *pbWallet.CashbookCreateExpenseRequest) (success bool, status int32, message string, data *pbWallet.Expense) {
+
+	log.Printf("Creating expense for branch %d in client %d ", in.BranchId, in.ClientId)
+
+	amount, err := expenseAmount(db, int64(in.ClientId), int64(in.ExpenseTypeId), int64(in.Amount))
+	if err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	level := expenseApprovalLevel(db, int64(in.ClientId), int64(in.BranchId), int64(in.ExpenseTypeId), 0, amount, true)
+
+	res, err := db.Exec("INSERT INTO cashbook_expenses (client_id, branch_id, expense_type_id, requested_amount, status, branch_comment, approval_level, created_at) "+
+		" VALUES (?,?,?,?,?,?,?,NOW())", in.ClientId, in.BranchId, in.ExpenseTypeId, amount, models.CashbookPending, nullString(in.Comment), level)
+
+	if err != nil {
+
+		log.Printf("error saving expense %s ", err.Error())
+		return false, 500, "Unable to save expense", nil
+	}
+
+	id, _ := res.LastInsertId()
+
+	e, err := getExpense(db, id)
+	if err != nil {
+
+		return false, 500, "Unable to fetch expense", nil
+	}
+
+	return true, 201, "Expense created", toExpense(db, e, map[int64]float64{})
+}
+
+// UpdateExpense lets a branch change an expense until it has been approved or rejected
+func UpdateExpense(db *sql.DB, in *pbWallet.CashbookCreateExpenseRequest) (success bool, status int32, message string, data *pbWallet.Expense) {
+
+	e, err := getExpense(db, int64(in.GetId()))
+	if err != nil || e.ClientID != int64(in.ClientId) {
+
+		return false, 404, "Expense not found", nil
+	}
+
+	if e.Status != models.CashbookPending {
+
+		return false, 400, "Expense has already been verified and can no longer be changed", nil
+	}
+
+	amount, err := expenseAmount(db, e.ClientID, int64(in.ExpenseTypeId), int64(in.Amount))
+	if err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	level := expenseApprovalLevel(db, e.ClientID, e.BranchID, int64(in.ExpenseTypeId), e.ID, amount, true)
+
+	res, err := db.Exec("UPDATE cashbook_expenses SET expense_type_id = ?, requested_amount = ?, branch_comment = ?, approval_level = ? WHERE id = ? AND status = ?",
+		in.ExpenseTypeId, amount, nullString(in.Comment), level, e.ID, models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error updating expense %d %s ", e.ID, err.Error())
+		return false, 500, "Unable to update expense", nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, "Expense has already been verified and can no longer be changed", nil
+	}
+
+	e, _ = getExpense(db, e.ID)
+	return true, 200, "Expense updated", toExpense(db, e, map[int64]float64{})
+}
+
+// DeleteExpense removes an expense that has not been approved
+func DeleteExpense(db *sql.DB, in *pbWallet.CashbookIdRequest) (success bool, status int32, message string, data *pbWallet.Expense) {
+
+	e, err := getExpense(db, int64(in.Id))
+	if err != nil || (in.ClientId > 0 && e.ClientID != int64(in.ClientId)) {
+
+		return false, 404, "Expense not found", nil
+	}
+
+	if e.Status == models.CashbookApproved {
+
+		return false, 400, "Approved expenses cannot be deleted", nil
+	}
+
+	res, err := db.Exec("DELETE FROM cashbook_expenses WHERE id = ? AND status <> ?", e.ID, models.CashbookApproved)
+	if err != nil {
+
+		log.Printf("error deleting expense %d %s ", e.ID, err.Error())
+		return false, 500, "Unable to delete expense", nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, "Approved expenses cannot be deleted", nil
+	}
+
+	return true, 200, "Expense deleted", toExpense(db, e, map[int64]float64{})
+}
+
+func FindOneExpense(db *sql.DB, in *pbWallet.CashbookIdRequest) (success bool, status int32, message string, data *pbWallet.Expense) {
+
+	e, err := getExpense(db, int64(in.Id))
+	if err != nil || (in.ClientId > 0 && e.ClientID != int64(in.ClientId)) {
+
+		return false, 404, "Expense not found", nil
+	}
+
+	return true, 200, "Expense retrieved", toExpense(db, e, map[int64]float64{})
+}
+
+func FindAllExpense(db *sql.DB, in *pbWallet.ClientRequest) (success bool, status int32, message string, data []*pbWallet.Expense) {
+
+	expenses, err := queryExpenses(db, " WHERE e.client_id = ? ORDER BY e.id DESC", in.ClientId)
+	if err != nil {
+
+		log.Printf("error getting expenses of client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch expenses", nil
+	}
+
+	return true, 200, "Expenses retrieved", toExpenses(db, expenses)
+}
+
+func FindAllBranchExpense(db *sql.DB, in *pbWallet.BranchRequest) (success bool, status int32, message string, data []*pbWallet.Expense) {
+
+	query := " WHERE e.client_id = ? AND e.branch_id = ? "
+	args := []interface{}{in.ClientId, in.BranchId}
+
+	if in.GetDate() != "" {
+
+		query += " AND DATE(e.created_at) = ? "
+		args = append(args, in.GetDate())
+	}
+
+	expenses, err := queryExpenses(db, query+" ORDER BY e.id DESC", args...)
+	if err != nil {
+
+		log.Printf("error getting expenses of branch %d %s ", in.BranchId, err.Error())
+		return false, 500, "Unable to fetch expenses", nil
+	}
+
+	return true, 200, "Expenses retrieved", toExpenses(db, expenses)
+}
+
+// expenseApproverLevel returns the level a user approves expenses at, the lowest unless they were made a senior approver
+func expenseApproverLevel(db *sql.DB, clientId, userId int64) int64 {
+
+	var level int64
+	err := db.QueryRow("SELECT level FROM cashbook_approvers WHERE client_id = ? AND user_id = ?", clientId, userId).Scan(&level)
+	if err != nil && err != sql.ErrNoRows {
+
+		log.Printf("error getting approver level of user %d %s ", userId, err.Error())
+	}
+
+	if level < models.ApproverLevel {
+
+		return models.ApproverLevel
+	}
+
+	return level
+}
+
+// SetExpenseApprover sets the level a user approves a client's expenses at
+func SetExpenseApprover(db *sql.DB, in *pbWallet.ExpenseApproverRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	if in.ClientId == 0 || in.UserId == 0 {
+
+		return false, 400, "Client and user are required", nil
+	}
+
+	if in.Level != models.ApproverLevel && in.Level != models.SeniorApproverLevel {
+
+		return false, 400, "Invalid approver level", nil
+	}
+
+	_, err := db.Exec("INSERT INTO cashbook_approvers (client_id, user_id, level) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE level = VALUES(level)",
+		in.ClientId, in.UserId, in.Level)
+
+	if err != nil {
+
+		log.Printf("error saving approver level of user %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to save approver", nil
+	}
+
+	return true, 200, "Approver saved", toStruct(map[string]interface{}{"clientId": in.ClientId, "userId": in.UserId, "level": in.Level})
+}
+
+// ApproveExpense approves or rejects a pending expense. The approver may approve a different amount
+// from the one requested; it defaults to the requested amount. Approval debits the branch wallet and
+// locks the expense against further changes
+func ApproveExpense(db *sql.DB, in *pbWallet.CashbookApproveExpenseRequest) (success bool, status int32, message string, data *pbWallet.Expense) {
+
+	log.Printf("Verifying expense %d by %d ", in.ExpenseId, in.VerifiedBy)
+
+	e, err := getExpense(db, int64(in.ExpenseId))
+	if err != nil || e.ClientID != int64(in.ClientId) {
+
+		return false, 404, "Expense not found", nil
+	}
+
+	if e.Status != models.CashbookPending {
+
+		return false, 400, "Expense has already been verified", nil
+	}
+
+	if in.Status != models.CashbookApproved && in.Status != models.CashbookRejected {
+
+		return false, 400, "Invalid status", nil
+	}
+
+	if in.Status == models.CashbookRejected {
+
+		res, err := db.Exec("UPDATE cashbook_expenses SET status = ?, admin_comment = ?, verified_by = ?, verified_at = NOW() WHERE id = ? AND status = ?",
+			models.CashbookRejected, nullString(in.Comment), in.VerifiedBy, e.ID, models.CashbookPending)
+
+		if err != nil {
+
+			log.Printf("error rejecting expense %d %s ", e.ID, err.Error())
+			return false, 500, "Unable to reject expense", nil
+		}
+
+		if n, _ := res.RowsAffected(); n == 0 {
+
+			return false, 400, "Expense has already been verified", nil
+		}
+
+		e, _ = getExpense(db, e.ID)
+		return true, 200, "Expense rejected", toExpense(db, e, map[int64]float64{})
+	}
+
+	amount := int64(in.Amount)
+	if amount <= 0 {
+
+		amount = e.RequestedAmount
+	}
+
+	amount, err = expenseAmount(db, e.ClientID, e.ExpenseTypeID, amount)
+	if err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	approverLevel := expenseApproverLevel(db, e.ClientID, int64(in.VerifiedBy))
+
+	// the budget is checked again on the approved amount, as other expenses may have been approved since this one was created
+	level := expenseApprovalLevel(db, e.ClientID, e.BranchID, e.ExpenseTypeID, e.ID, amount, false)
+	if level > approverLevel {
+
+		_, _ = db.Exec("UPDATE cashbook_expenses SET approval_level = ? WHERE id = ?", level, e.ID)
+		return false, 403, "Expense is over the monthly budget and needs a senior approver", nil
+	}
+
+	// claim the expense before paying it, so two approvers cannot both debit the branch
+	res, err := db.Exec("UPDATE cashbook_expenses SET status = ?, amount = ?, admin_comment = ?, verified_by = ?, verified_at = NOW(), approval_level = ? WHERE id = ? AND status = ?",
+		models.CashbookApproved, amount, nullString(in.Comment), in.VerifiedBy, level, e.ID, models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error approving expense %d %s ", e.ID, err.Error())
+		return false, 500, "Unable to approve expense", nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, "Expense has already been verified", nil
+	}
+
+	username, _ := getWalletUsername(db, int32(e.ClientID), int32(e.BranchID))
+
+	ok, debitStatus, debitMessage, wallet, transactionNo := debitUser(db, &pbWallet.DebitUserRequest{
+		UserId:      int32(e.BranchID),
+		ClientId:    int32(e.ClientID),
+		Amount:      fmt.Sprintf("%d", amount),
+		Source:      "cashbook",
+		Description: fmt.Sprintf("Expense #%d", e.ID),
+		Username:    username,
+		Wallet:      "main",
+		Subject:     "Expense",
+		Channel:     "retail",
+	})
+
+	if !ok {
+
+		// release the expense so it can be approved again once the branch can pay it
+		_, err = db.Exec("UPDATE cashbook_expenses SET status = ?, amount = 0, verified_by = NULL, verified_at = NULL WHERE id = ?", models.CashbookPending, e.ID)
+		if err != nil {
+
+			log.Printf("error releasing expense %d %s ", e.ID, err.Error())
+		}
+
+		return false, debitStatus, debitMessage, nil
+	}
+
+	_, err = db.Exec("UPDATE cashbook_expenses SET balance = ?, transaction_no = ? WHERE id = ?", wallet.AvailableBalance, transactionNo, e.ID)
+	if err != nil {
+
+		log.Printf("error saving balance of expense %d %s ", e.ID, err.Error())
+	}
+
+	e, _ = getExpense(db, e.ID)
+	return true, 200, "Expense approved", toExpense(db, e, map[int64]float64{})
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+// pendingExpenseDB returns a database holding a pending expense of 300 raised by branch 4 of client 1
+func pendingExpenseDB(t *testing.T) (*sql.DB, *fakeDB) {
+
+	db, fake := newFakeDB(t)
+
+	fake.On("cashbook_expenses e LEFT JOIN", []string{"id", "client_id", "branch_id", "expense_type_id", "requested_amount", "amount", "status",
+		"branch_comment", "admin_comment", "verified_by", "verified_at", "balance", "transaction_no", "approval_level", "title", "created_at"},
+		[]driver.Value{int64(5), int64(1), int64(4), int64(2), int64(300), int64(0), int64(models.CashbookPending),
+			nil, nil, nil, nil, nil, nil, int64(models.ApproverLevel), "Fuel", "2024-01-01 00:00:00"})
+
+	fake.On("FROM expense_types WHERE id = ?", []string{"id", "client_id", "title", "fixed", "amount", "status", "created_at"},
+		[]driver.Value{int64(2), int64(1), "Fuel", int64(0), int64(0), int64(1), "2024-01-01 00:00:00"})
+
+	return db, fake
+}
+
+func approveExpense() *pbWallet.CashbookApproveExpenseRequest {
+
+	return &pbWallet.CashbookApproveExpenseRequest{ClientId: 1, ExpenseId: 5, VerifiedBy: 3, Status: models.CashbookApproved}
+}
+
+func TestApproveExpenseClaimsThenDebits(t *testing.T) {
+
+	db, fake := pendingExpenseDB(t)
+	openWallet(fake, 1000)
+
+	ok, status, message, _ := ApproveExpense(db, approveExpense())
+	if !ok {
+
+		t.Fatalf("unexpected result %d %s", status, message)
+	}
+
+	claim := fake.Index("UPDATE cashbook_expenses SET status = ?, amount = ?")
+	debit := fake.Index("INSERT INTO transactions")
+
+	if claim < 0 || debit < 0 || claim > debit {
+
+		t.Fatalf("expected the expense claimed before the branch is debited, claim %d debit %d", claim, debit)
+	}
+
+	debits := fake.Ran("INSERT INTO transactions")
+	if len(debits) != 1 || debits[0].args[1] != int64(4) || debits[0].args[4] != 300.0 {
+
+		t.Fatalf("expected the branch debited once, got %+v", debits)
+	}
+}
+
+func TestApproveExpenseTwice(t *testing.T) {
+
+	db, fake := pendingExpenseDB(t)
+	openWallet(fake, 1000)
+
+	// another approver claimed it after it was read
+	fake.Affects("UPDATE cashbook_expenses SET status = ?, amount = ?", 0)
+
+	ok, status, _, _ := ApproveExpense(db, approveExpense())
+	if ok || status != 400 {
+
+		t.Fatalf("expected the second approval refused, got %v %d", ok, status)
+	}
+
+	if debits := fake.Ran("UPDATE wallets"); len(debits) != 0 {
+
+		t.Fatalf("expected the branch not debited, got %+v", debits)
+	}
+}
+
+func TestApproveExpenseReleasedWhenDebitFails(t *testing.T) {
+
+	db, fake := pendingExpenseDB(t)
+	openWallet(fake, 100)
+
+	// the branch cannot cover the expense
+	fake.Affects("UPDATE wallets SET available_balance", 0)
+
+	ok, status, _, _ := ApproveExpense(db, approveExpense())
+	if ok || status != 400 {
+
+		t.Fatalf("expected the approval to fail, got %v %d", ok, status)
+	}
+
+	releases := fake.Ran("UPDATE cashbook_expenses SET status = ?, amount = 0")
+	if len(releases) != 1 || releases[0].args[0] != int64(models.CashbookPending) {
+
+		t.Fatalf("expected the expense released to pending, got %+v", releases)
+	}
+
+	if debits := fake.Ran("INSERT INTO transactions"); len(debits) != 0 {
+
+		t.Fatalf("expected no transaction, got %+v", debits)
+	}
+}
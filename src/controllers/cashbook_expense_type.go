@@ -0,0 +1,335 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const expenseTypeColumns = "id, client_id, title, fixed, amount, status, created_at"
+
+func scanExpenseType(row rowScanner) (*models.ExpenseType, error) {
+
+	var t models.ExpenseType
+
+	err := row.Scan(&t.ID, &t.ClientID, &t.Title, &t.Fixed, &t.Amount, &t.Status, &t.CreatedAt)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func getExpenseType(db *sql.DB, id int64) (*models.ExpenseType, error) {
+
+	return scanExpenseType(db.QueryRow("SELECT "+expenseTypeColumns+" FROM expense_types WHERE id = ?", id))
+}
+
+// expenseAmount checks an expense amount against its type. Fixed types only allow their configured
+// amount, which is also used when no amount was given
+func expenseAmount(db *sql.DB, clientId, expenseTypeId, amount int64) (int64, error) {
+
+	t, err := getExpenseType(db, expenseTypeId)
+	if err != nil || t.ClientID != clientId || t.Status != 1 {
+
+		return 0, fmt.Errorf("Expense type not found")
+	}
+
+	if t.Fixed == 1 {
+
+		if amount > 0 && amount != t.Amount {
+
+			return 0, fmt.Errorf("%s is a fixed expense of %d", t.Title, t.Amount)
+		}
+
+		return t.Amount, nil
+	}
+
+	if amount <= 0 {
+
+		return 0, fmt.Errorf("Amount must be greater than zero")
+	}
+
+	return amount, nil
+}
+
+// monthlyBudget returns the monthly cap of an expense type for a branch. A budget set for the branch
+// overrides the one set for all branches; false means the type has no cap
+func monthlyBudget(db *sql.DB, expenseTypeId, branchId int64) (int64, bool) {
+
+	var budget int64
+
+	err := db.QueryRow("SELECT monthly_budget FROM expense_type_budgets WHERE expense_type_id = ? AND branch_id IN (?, 0) "+
+		" ORDER BY branch_id DESC LIMIT 1", expenseTypeId, branchId).Scan(&budget)
+
+	if err != nil {
+
+		if err != sql.ErrNoRows {
+
+			log.Printf("error getting budget of expense type %d %s ", expenseTypeId, err.Error())
+		}
+
+		return 0, false
+	}
+
+	return budget, true
+}
+
+// monthSpent returns what a branch has spent on an expense type this month, leaving out one expense.
+// Pending requests are counted when withPending is set
+func monthSpent(db *sql.DB, clientId, branchId, expenseTypeId, excludeId int64, withPending bool) int64 {
+
+	var spent int64
+
+	err := db.QueryRow("SELECT COALESCE(SUM(CASE WHEN status = ? THEN amount ELSE requested_amount END), 0) FROM cashbook_expenses "+
+		" WHERE client_id = ? AND branch_id = ? AND expense_type_id = ? AND id <> ? AND (status = ? OR (status = ? AND ?)) "+
+		" AND created_at >= DATE_FORMAT(CURDATE(), '%Y-%m-01')",
+		models.CashbookApproved, clientId, branchId, expenseTypeId, excludeId, models.CashbookApproved, models.CashbookPending, withPending).Scan(&spent)
+
+	if err != nil {
+
+		log.Printf("error getting month expenses of branch %d %s ", branchId, err.Error())
+	}
+
+	return spent
+}
+
+// expenseApprovalLevel returns the level of approver an expense needs: a senior approver when it takes
+// the branch over the monthly budget of its type
+func expenseApprovalLevel(db *sql.DB, clientId, branchId, expenseTypeId, expenseId, amount int64, withPending bool) int64 {
+
+	budget, ok := monthlyBudget(db, expenseTypeId, branchId)
+	if !ok {
+
+		return models.ApproverLevel
+	}
+
+	if monthSpent(db, clientId, branchId, expenseTypeId, expenseId, withPending)+amount > budget {
+
+		return models.SeniorApproverLevel
+	}
+
+	return models.ApproverLevel
+}
+
+func toExpenseType(db *sql.DB, t *models.ExpenseType, branchId int64) *pbWallet.ExpenseType {
+
+	data := &pbWallet.ExpenseType{
+		Id:        int32(t.ID),
+		ClientId:  int32(t.ClientID),
+		Title:     t.Title,
+		Amount:    int32(t.Amount),
+		CreatedAt: t.CreatedAt,
+		Status:    int32(t.Status),
+		Fixed:     int32(t.Fixed),
+	}
+
+	if budget, ok := monthlyBudget(db, t.ID, branchId); ok {
+
+		b := int32(budget)
+		data.MonthlyBudget = &b
+
+		if branchId > 0 {
+
+			spent := int32(monthSpent(db, t.ClientID, branchId, t.ID, 0, false))
+			data.Spent = &spent
+		}
+	}
+
+	return data
+}
+
+func saveExpenseBudget(db *sql.DB, clientId, expenseTypeId, branchId, budget int64) error {
+
+	if budget <= 0 {
+
+		_, err := db.Exec("DELETE FROM expense_type_budgets WHERE expense_type_id = ? AND branch_id = ?", expenseTypeId, branchId)
+		return err
+	}
+
+	_, err := db.Exec("INSERT INTO expense_type_budgets (client_id, expense_type_id, branch_id, monthly_budget, created_at) VALUES (?,?,?,?,NOW()) "+
+		" ON DUPLICATE KEY UPDATE monthly_budget = VALUES(monthly_budget)", clientId, expenseTypeId, branchId, budget)
+
+	return err
+}
+
+func CreateExpenseType(db *sql.DB, in *pbWallet.CashbookCreateExpenseTypeRequest) (success bool, status int32, message string, data *pbWallet.ExpenseType) {
+
+	log.Printf("Creating expense type %s in client %d ", in.Title, in.ClientId)
+
+	if in.ClientId == 0 || strings.TrimSpace(in.Title) == "" {
+
+		return false, 400, "Client and title are required", nil
+	}
+
+	if in.GetFixed() == 1 && in.GetAmount() <= 0 {
+
+		return false, 400, "A fixed expense type needs an amount", nil
+	}
+
+	res, err := db.Exec("INSERT INTO expense_types (client_id, title, fixed, amount, status, created_at) VALUES (?,?,?,?,1,NOW())",
+		in.ClientId, strings.TrimSpace(in.Title), in.GetFixed(), in.GetAmount())
+
+	if err != nil {
+
+		log.Printf("error saving expense type %s ", err.Error())
+		return false, 500, "Unable to save expense type", nil
+	}
+
+	id, _ := res.LastInsertId()
+
+	if in.MonthlyBudget != nil {
+
+		if err := saveExpenseBudget(db, int64(in.ClientId), id, 0, int64(in.GetMonthlyBudget())); err != nil {
+
+			log.Printf("error saving budget of expense type %d %s ", id, err.Error())
+		}
+	}
+
+	t, err := getExpenseType(db, id)
+	if err != nil {
+
+		return false, 500, "Unable to fetch expense type", nil
+	}
+
+	return true, 201, "Expense type created", toExpenseType(db, t, 0)
+}
+
+func UpdateExpenseType(db *sql.DB, in *pbWallet.CashbookCreateExpenseTypeRequest) (success bool, status int32, message string, data *pbWallet.ExpenseType) {
+
+	t, err := getExpenseType(db, int64(in.GetId()))
+	if err != nil || t.ClientID != int64(in.ClientId) {
+
+		return false, 404, "Expense type not found", nil
+	}
+
+	fixed, amount := t.Fixed, t.Amount
+	if in.Fixed != nil {
+
+		fixed = int64(in.GetFixed())
+	}
+
+	if in.Amount != nil {
+
+		amount = int64(in.GetAmount())
+	}
+
+	if fixed == 1 && amount <= 0 {
+
+		return false, 400, "A fixed expense type needs an amount", nil
+	}
+
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+
+		title = t.Title
+	}
+
+	typeStatus := t.Status
+	if in.Status != nil {
+
+		typeStatus = int64(in.GetStatus())
+	}
+
+	_, err = db.Exec("UPDATE expense_types SET title = ?, fixed = ?, amount = ?, status = ? WHERE id = ?", title, fixed, amount, typeStatus, t.ID)
+	if err != nil {
+
+		log.Printf("error updating expense type %d %s ", t.ID, err.Error())
+		return false, 500, "Unable to update expense type", nil
+	}
+
+	if in.MonthlyBudget != nil {
+
+		if err := saveExpenseBudget(db, t.ClientID, t.ID, 0, int64(in.GetMonthlyBudget())); err != nil {
+
+			log.Printf("error saving budget of expense type %d %s ", t.ID, err.Error())
+		}
+	}
+
+	t, _ = getExpenseType(db, t.ID)
+	return true, 200, "Expense type updated", toExpenseType(db, t, 0)
+}
+
+// DeleteExpenseType disables an expense type. It is kept so past expenses still show their type
+func DeleteExpenseType(db *sql.DB, in *pbWallet.CashbookIdRequest) (success bool, status int32, message string, data *pbWallet.ExpenseType) {
+
+	t, err := getExpenseType(db, int64(in.Id))
+	if err != nil || t.ClientID != int64(in.ClientId) {
+
+		return false, 404, "Expense type not found", nil
+	}
+
+	_, err = db.Exec("UPDATE expense_types SET status = 0 WHERE id = ?", t.ID)
+	if err != nil {
+
+		log.Printf("error deleting expense type %d %s ", t.ID, err.Error())
+		return false, 500, "Unable to delete expense type", nil
+	}
+
+	t.Status = 0
+	return true, 200, "Expense type deleted", toExpenseType(db, t, 0)
+}
+
+func queryExpenseTypes(db *sql.DB, branchId int64, query string, args ...interface{}) (success bool, status int32, message string, data []*pbWallet.ExpenseType) {
+
+	rows, err := db.Query("SELECT "+expenseTypeColumns+" FROM expense_types "+query+" ORDER BY title", args...)
+	if err != nil {
+
+		log.Printf("error getting expense types %s ", err.Error())
+		return false, 500, "Unable to fetch expense types", nil
+	}
+
+	defer rows.Close()
+
+	data = []*pbWallet.ExpenseType{}
+	for rows.Next() {
+
+		t, err := scanExpenseType(rows)
+		if err != nil {
+
+			log.Printf("error scanning expense type %s ", err.Error())
+			continue
+		}
+
+		data = append(data, toExpenseType(db, t, branchId))
+	}
+
+	return true, 200, "Expense types retrieved", data
+}
+
+func FindAllExpenseType(db *sql.DB, in *pbWallet.ClientRequest) (success bool, status int32, message string, data []*pbWallet.ExpenseType) {
+
+	return queryExpenseTypes(db, 0, " WHERE client_id = ? AND status = 1 ", in.ClientId)
+}
+
+// FindAllClientExpenseType lists a client's active expense types. When a branch is given each type
+// carries that branch's budget and what it has spent this month
+func FindAllClientExpenseType(db *sql.DB, in *pbWallet.BranchRequest) (success bool, status int32, message string, data []*pbWallet.ExpenseType) {
+
+	return queryExpenseTypes(db, int64(in.BranchId), " WHERE client_id = ? AND status = 1 ", in.ClientId)
+}
+
+// SetExpenseBudget sets the monthly budget of an expense type for one branch, or for all branches when
+// no branch is given. A budget of zero removes the cap
+func SetExpenseBudget(db *sql.DB, in *pbWallet.ExpenseBudgetRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	t, err := getExpenseType(db, int64(in.ExpenseTypeId))
+	if err != nil || t.ClientID != int64(in.ClientId) {
+
+		return false, 404, "Expense type not found", nil
+	}
+
+	if err := saveExpenseBudget(db, t.ClientID, t.ID, int64(in.BranchId), int64(in.MonthlyBudget)); err != nil {
+
+		log.Printf("error saving budget of expense type %d %s ", t.ID, err.Error())
+		return false, 500, "Unable to save budget", nil
+	}
+
+	return true, 200, "Budget saved", toStruct(toExpenseType(db, t, int64(in.BranchId)))
+}
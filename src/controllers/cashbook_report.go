@@ -0,0 +1,399 @@
+package controllers
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const shopReportColumns = "id, client_id, branch_id, DATE_FORMAT(date, '%Y-%m-%d'), opening_balance, closing_balance, expected_closing_balance, variance, " +
+	"online_sales, online_payouts, normal_sales, normal_payouts, other_sales, other_payouts, cashin, cashout, expenses, status, verified_by, created_at"
+
+func scanShopReport(row rowScanner) (*models.ShopReport, error) {
+
+	var r models.ShopReport
+	var verifiedBy sql.NullInt64
+
+	err := row.Scan(&r.ID, &r.ClientID, &r.BranchID, &r.Date, &r.OpeningBalance, &r.ClosingBalance, &r.ExpectedClosingBalance, &r.Variance,
+		&r.OnlineSales, &r.OnlinePayouts, &r.NormalSales, &r.NormalPayouts, &r.OtherSales, &r.OtherPayouts, &r.CashIn, &r.CashOut, &r.Expenses,
+		&r.Status, &verifiedBy, &r.CreatedAt)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	r.VerifiedBy = verifiedBy.Int64
+	return &r, nil
+}
+
+func getShopReport(db *sql.DB, clientId, branchId int64, date string) (*models.ShopReport, error) {
+
+	return scanShopReport(db.QueryRow("SELECT "+shopReportColumns+" FROM cashbook_reports WHERE client_id = ? AND branch_id = ? AND date = ?",
+		clientId, branchId, date))
+}
+
+// previousShopReport returns the last closing of a branch before a day
+func previousShopReport(db *sql.DB, clientId, branchId int64, date string) (*models.ShopReport, error) {
+
+	return scanShopReport(db.QueryRow("SELECT "+shopReportColumns+" FROM cashbook_reports WHERE client_id = ? AND branch_id = ? AND date < ? "+
+		" ORDER BY date DESC LIMIT 1", clientId, branchId, date))
+}
+
+func lastApprovedShopReport(db *sql.DB, clientId, branchId int64) (*models.ShopReport, error) {
+
+	return scanShopReport(db.QueryRow("SELECT "+shopReportColumns+" FROM cashbook_reports WHERE client_id = ? AND branch_id = ? AND status = ? "+
+		" ORDER BY date DESC LIMIT 1", clientId, branchId, models.CashbookApproved))
+}
+
+func toLastApproved(r *models.ShopReport) *pbWallet.LastApproved {
+
+	expected := int32(r.ExpectedClosingBalance)
+	variance := int32(r.Variance)
+
+	return &pbWallet.LastApproved{
+		Id:                     int32(r.ID),
+		BranchId:               int32(r.BranchID),
+		OpeningBalance:         int32(r.OpeningBalance),
+		ClosingBalance:         int32(r.ClosingBalance),
+		OnlinePayouts:          int32(r.OnlinePayouts),
+		OnlineSales:            int32(r.OnlineSales),
+		NormalSales:            int32(r.NormalSales),
+		NormalPayouts:          int32(r.NormalPayouts),
+		OtherSales:             int32(r.OtherSales),
+		OtherPayouts:           int32(r.OtherPayouts),
+		Cashin:                 int32(r.CashIn),
+		Cashout:                int32(r.CashOut),
+		Expenses:               int32(r.Expenses),
+		Status:                 int32(r.Status),
+		Date:                   r.Date,
+		CreatedAt:              r.CreatedAt,
+		ClientId:               int32(r.ClientID),
+		ExpectedClosingBalance: &expected,
+		Variance:               &variance,
+	}
+}
+
+// shopDay identifies a branch's day in one currency in a report
+type shopDay struct {
+	branchId int64
+	date     string
+	currency string
+}
+
+// shopFlows works out the sales, payouts and approved cashbook flows of a client's shops per day and
+// currency between from and to (exclusive). Amounts in different currencies are never added together,
+// transactions from before the client set up currencies and cashbook entries, which are always posted
+// in the default currency, count in the default currency. With branchId 0 it covers every shop that
+// closes days in the cashbook
+func shopFlows(db *sql.DB, clientId, branchId int64, from, to string) (map[shopDay]*models.ShopReport, error) {
+
+	defaultCurrency, err := resolveCurrency(db, int32(clientId), "")
+	if err != nil {
+
+		return nil, err
+	}
+
+	days := map[shopDay]*models.ShopReport{}
+
+	day := func(branchId int64, date, currency string) *models.ShopReport {
+
+		if currency == "" {
+
+			currency = defaultCurrency.Code
+		}
+
+		key := shopDay{branchId, date, currency}
+		r, ok := days[key]
+		if !ok {
+
+			r = &models.ShopReport{ClientID: clientId, BranchID: branchId, Date: date, Currency: currency}
+			days[key] = r
+		}
+
+		return r
+	}
+
+	branchFilter := " AND user_id = ? "
+	branchArgs := []interface{}{branchId}
+	if branchId == 0 {
+
+		branchFilter = " AND user_id IN (SELECT DISTINCT branch_id FROM cashbook_reports WHERE client_id = ?) "
+		branchArgs = []interface{}{clientId}
+	}
+
+	args := append([]interface{}{clientId, from, to}, branchArgs...)
+
+	rows, err := db.Query("SELECT user_id, DATE_FORMAT(created_at, '%Y-%m-%d'), currency, tranasaction_type, subject, COALESCE(SUM(amount), 0) FROM transactions "+
+		" WHERE client_id = ? AND created_at >= ? AND created_at < ? AND status = 1 AND source <> 'cashbook' "+branchFilter+
+		" GROUP BY user_id, DATE_FORMAT(created_at, '%Y-%m-%d'), currency, tranasaction_type, subject", args...)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		var userId int64
+		var date, currency, trxType, subject string
+		var amount float64
+
+		if err := rows.Scan(&userId, &date, &currency, &trxType, &subject, &amount); err != nil {
+
+			return nil, err
+		}
+
+		r := day(userId, date, currency)
+
+		switch models.ShopSubjects[subject] {
+		case models.NormalSales:
+			r.NormalSales += int64(amount)
+		case models.NormalPayouts:
+			r.NormalPayouts += int64(amount)
+		case models.OnlineSales:
+			r.OnlineSales += int64(amount)
+		case models.OnlinePayouts:
+			r.OnlinePayouts += int64(amount)
+		default:
+			if trxType == "debit" {
+
+				r.OtherSales += int64(amount)
+			} else {
+
+				r.OtherPayouts += int64(amount)
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+
+		return nil, err
+	}
+
+	branchFilter = strings.Replace(branchFilter, "user_id", "branch_id", 1)
+
+	// cashbook entries move the branch wallet when they are approved, so they count on that day
+	cashRows, err := db.Query("SELECT branch_id, DATE_FORMAT(verified_at, '%Y-%m-%d'), type, SUM(amount) FROM cashbook_cash_in_out "+
+		" WHERE client_id = ? AND verified_at >= ? AND verified_at < ? AND status = 1 "+branchFilter+
+		" GROUP BY branch_id, DATE_FORMAT(verified_at, '%Y-%m-%d'), type "+
+		" UNION ALL SELECT branch_id, DATE_FORMAT(verified_at, '%Y-%m-%d'), 'expense', SUM(amount) FROM cashbook_expenses "+
+		" WHERE client_id = ? AND verified_at >= ? AND verified_at < ? AND status = 1 "+branchFilter+
+		" GROUP BY branch_id, DATE_FORMAT(verified_at, '%Y-%m-%d')", append(args, args...)...)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer cashRows.Close()
+
+	for cashRows.Next() {
+
+		var id, amount int64
+		var date, kind string
+
+		if err := cashRows.Scan(&id, &date, &kind, &amount); err != nil {
+
+			return nil, err
+		}
+
+		r := day(id, date, "")
+
+		switch kind {
+		case models.CashIn:
+			r.CashIn += amount
+		case models.CashOut:
+			r.CashOut += amount
+		default:
+			r.Expenses += amount
+		}
+	}
+
+	return days, cashRows.Err()
+}
+
+// branchDayFlows fills in the sales, payouts and cashbook flows of a branch's day in the client's
+// default currency on a report, the currency a branch closes its days in
+func branchDayFlows(db *sql.DB, r *models.ShopReport) error {
+
+	date, err := time.Parse("2006-01-02", r.Date)
+	if err != nil {
+
+		return err
+	}
+
+	days, err := shopFlows(db, r.ClientID, r.BranchID, r.Date, date.AddDate(0, 0, 1).Format("2006-01-02"))
+	if err != nil {
+
+		return err
+	}
+
+	currency, err := resolveCurrency(db, int32(r.ClientID), "")
+	if err != nil {
+
+		return err
+	}
+
+	r.Currency = currency.Code
+
+	if flows, ok := days[shopDay{r.BranchID, r.Date, currency.Code}]; ok {
+
+		r.NormalSales, r.NormalPayouts = flows.NormalSales, flows.NormalPayouts
+		r.OnlineSales, r.OnlinePayouts = flows.OnlineSales, flows.OnlinePayouts
+		r.OtherSales, r.OtherPayouts = flows.OtherSales, flows.OtherPayouts
+		r.CashIn, r.CashOut, r.Expenses = flows.CashIn, flows.CashOut, flows.Expenses
+	}
+
+	return nil
+}
+
+// HandleReport closes a branch's day. The opening balance is the closing balance of the previous
+// approved day, sales and payouts come from the shop's transactions and cash in, cash out and expenses
+// from the approved cashbook entries. The closing balance the branch counted is kept with its variance
+// from the expected figure. A day cannot be closed while an earlier day is waiting for approval, and an
+// approved day cannot be changed
+func HandleReport(db *sql.DB, in *pbWallet.HandleReportRequest) (success bool, status int32, message string, data *pbWallet.LastApproved) {
+
+	log.Printf("Closing day %s of branch %d in client %d ", in.Date, in.BranchId, in.ClientId)
+
+	clientId, branchId := int64(in.ClientId), int64(in.BranchId)
+
+	date := in.Date
+	if date == "" {
+
+		date = time.Now().Format("2006-01-02")
+	}
+
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+
+		return false, 400, "Invalid date, use YYYY-MM-DD", nil
+	}
+
+	if existing, err := getShopReport(db, clientId, branchId, date); err == nil && existing.Status == models.CashbookApproved {
+
+		return false, 400, "This day has already been approved", nil
+	}
+
+	report := &models.ShopReport{
+		ClientID:       clientId,
+		BranchID:       branchId,
+		Date:           date,
+		OpeningBalance: int64(in.OpeningBalance),
+		ClosingBalance: int64(in.ClosingBalance),
+	}
+
+	previous, err := previousShopReport(db, clientId, branchId, date)
+	switch {
+	case err == nil && previous.Status != models.CashbookApproved:
+		return false, 400, "The closing of " + previous.Date + " must be approved before a new day is opened", nil
+	case err == nil:
+		report.OpeningBalance = previous.ClosingBalance
+	case err != sql.ErrNoRows:
+		log.Printf("error getting previous report of branch %d %s ", branchId, err.Error())
+		return false, 500, "Unable to fetch previous report", nil
+	}
+
+	// sales and payouts are taken from the shop's transactions rather than the figures submitted
+	if err := branchDayFlows(db, report); err != nil {
+
+		log.Printf("error getting flows of branch %d %s ", branchId, err.Error())
+		return false, 500, "Unable to fetch branch transactions", nil
+	}
+
+	report.ExpectedClosingBalance = report.Expected()
+	report.Variance = report.ClosingBalance - report.ExpectedClosingBalance
+
+	_, err = db.Exec("INSERT INTO cashbook_reports (client_id, branch_id, date, opening_balance, closing_balance, expected_closing_balance, variance, "+
+		" online_sales, online_payouts, normal_sales, normal_payouts, other_sales, other_payouts, cashin, cashout, expenses, status, created_at) "+
+		" VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,NOW()) ON DUPLICATE KEY UPDATE opening_balance = VALUES(opening_balance), "+
+		" closing_balance = VALUES(closing_balance), expected_closing_balance = VALUES(expected_closing_balance), variance = VALUES(variance), "+
+		" online_sales = VALUES(online_sales), online_payouts = VALUES(online_payouts), normal_sales = VALUES(normal_sales), "+
+		" normal_payouts = VALUES(normal_payouts), other_sales = VALUES(other_sales), other_payouts = VALUES(other_payouts), "+
+		" cashin = VALUES(cashin), cashout = VALUES(cashout), expenses = VALUES(expenses)",
+		clientId, branchId, date, report.OpeningBalance, report.ClosingBalance, report.ExpectedClosingBalance, report.Variance,
+		report.OnlineSales, report.OnlinePayouts, report.NormalSales, report.NormalPayouts, report.OtherSales, report.OtherPayouts,
+		report.CashIn, report.CashOut, report.Expenses, models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error saving report of branch %d %s ", branchId, err.Error())
+		return false, 500, "Unable to save report", nil
+	}
+
+	report, err = getShopReport(db, clientId, branchId, date)
+	if err != nil {
+
+		return false, 500, "Unable to fetch report", nil
+	}
+
+	if report.Variance != 0 {
+
+		return true, 200, "Report saved with a variance", toLastApproved(report)
+	}
+
+	return true, 200, "Report saved", toLastApproved(report)
+}
+
+// FetchLastApproved returns the last approved day of a branch, whose closing balance opens the next day
+func FetchLastApproved(db *sql.DB, in *pbWallet.FetchLastApprovedRequest) (success bool, status int32, message string, data *pbWallet.LastApproved) {
+
+	report, err := lastApprovedShopReport(db, int64(in.ClientId), int64(in.BranchId))
+	if err == sql.ErrNoRows {
+
+		return false, 404, "No approved report found", nil
+	}
+
+	if err != nil {
+
+		log.Printf("error getting last approved report of branch %d %s ", in.BranchId, err.Error())
+		return false, 500, "Unable to fetch report", nil
+	}
+
+	return true, 200, "Report retrieved", toLastApproved(report)
+}
+
+// VerifyFinalTransaction approves the oldest day a branch closed that is waiting for approval, which
+// lets the branch open the next day
+func VerifyFinalTransaction(db *sql.DB, in *pbWallet.FetchLastApprovedRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	report, err := scanShopReport(db.QueryRow("SELECT "+shopReportColumns+" FROM cashbook_reports WHERE client_id = ? AND branch_id = ? AND status = ? "+
+		" ORDER BY date LIMIT 1", in.ClientId, in.BranchId, models.CashbookPending))
+
+	if err == sql.ErrNoRows {
+
+		return false, 404, "No report is waiting for approval", nil
+	}
+
+	if err != nil {
+
+		log.Printf("error getting pending report of branch %d %s ", in.BranchId, err.Error())
+		return false, 500, "Unable to fetch report", nil
+	}
+
+	res, err := db.Exec("UPDATE cashbook_reports SET status = ?, verified_by = ?, verified_at = NOW() WHERE id = ? AND status = ?",
+		models.CashbookApproved, in.GetVerifiedBy(), report.ID, models.CashbookPending)
+
+	if err != nil {
+
+		log.Printf("error approving report %d %s ", report.ID, err.Error())
+		return false, 500, "Unable to approve report", nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, "Report has already been approved", nil
+	}
+
+	report.Status = models.CashbookApproved
+	report.VerifiedBy = int64(in.GetVerifiedBy())
+
+	return true, 200, "Report approved", toStruct(toLastApproved(report))
+}
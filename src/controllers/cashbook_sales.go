@@ -0,0 +1,282 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func reportDate(date string) (time.Time, error) {
+
+	if date == "" {
+
+		return time.Now(), nil
+	}
+
+	return time.Parse("2006-01-02", date)
+}
+
+// FetchSalesReport lists the closed days of a branch, or of all branches when none is given, with a
+// status, with their sales and payouts worked out from the shop transactions
+func FetchSalesReport(db *sql.DB, in *pbWallet.FetchSalesReportRequest) (success bool, status int32, message string, data []*pbWallet.LastApproved) {
+
+	query := "SELECT " + shopReportColumns + " FROM cashbook_reports WHERE client_id = ? AND status = ? "
+	args := []interface{}{in.ClientId, in.Status}
+
+	if in.BranchId > 0 {
+
+		query += " AND branch_id = ? "
+		args = append(args, in.BranchId)
+	}
+
+	rows, err := db.Query(query+" ORDER BY date DESC, branch_id LIMIT 500", args...)
+	if err != nil {
+
+		log.Printf("error getting sales report %s ", err.Error())
+		return false, 500, "Unable to fetch sales report", nil
+	}
+
+	defer rows.Close()
+
+	var reports []*models.ShopReport
+	for rows.Next() {
+
+		r, err := scanShopReport(rows)
+		if err != nil {
+
+			log.Printf("error scanning sales report %s ", err.Error())
+			continue
+		}
+
+		reports = append(reports, r)
+	}
+
+	data = []*pbWallet.LastApproved{}
+
+	for _, r := range reports {
+
+		if r.Status != models.CashbookApproved {
+
+			// days that are still open to changes are worked out again
+			if err := branchDayFlows(db, r); err != nil {
+
+				log.Printf("error getting flows of branch %d %s ", r.BranchID, err.Error())
+			}
+		}
+
+		data = append(data, toLastApproved(r))
+	}
+
+	return true, 200, "Sales report retrieved", data
+}
+
+// FetchReport returns a branch's day: the closing when the day has been closed, otherwise its figures so far
+func FetchReport(db *sql.DB, in *pbWallet.FetchReportRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	date, err := reportDate(in.Date)
+	if err != nil {
+
+		return false, 400, "Invalid date, use YYYY-MM-DD", nil
+	}
+
+	report, err := getShopReport(db, int64(in.ClientId), int64(in.UserId), date.Format("2006-01-02"))
+	if err == nil {
+
+		return true, 200, "Report retrieved", withTillSessions(db, toStruct(toLastApproved(report)), report.ClientID, report.BranchID, report.Date)
+	}
+
+	if err != sql.ErrNoRows {
+
+		log.Printf("error getting report of branch %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to fetch report", nil
+	}
+
+	return runningReport(db, int64(in.ClientId), int64(in.UserId), date.Format("2006-01-02"))
+}
+
+// CurrentReport returns today's running figures of a branch before the day is closed
+func CurrentReport(db *sql.DB, in *pbWallet.FetchReportRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	return runningReport(db, int64(in.ClientId), int64(in.UserId), time.Now().Format("2006-01-02"))
+}
+
+func runningReport(db *sql.DB, clientId, branchId int64, date string) (success bool, status int32, message string, data *structpb.Struct) {
+
+	report := &models.ShopReport{ClientID: clientId, BranchID: branchId, Date: date}
+
+	previous, err := previousShopReport(db, clientId, branchId, date)
+	if err == nil {
+
+		report.OpeningBalance = previous.ClosingBalance
+	} else if err != sql.ErrNoRows {
+
+		log.Printf("error getting previous report of branch %d %s ", branchId, err.Error())
+	}
+
+	if err := branchDayFlows(db, report); err != nil {
+
+		log.Printf("error getting flows of branch %d %s ", branchId, err.Error())
+		return false, 500, "Unable to fetch branch transactions", nil
+	}
+
+	report.ExpectedClosingBalance = report.Expected()
+	report.ClosingBalance = report.ExpectedClosingBalance
+
+	return true, 200, "Report retrieved", withTillSessions(db, toStruct(toLastApproved(report)), clientId, branchId, date)
+}
+
+// withTillSessions adds the till sessions of a branch's day, with each cashier's totals and variance, to a report
+func withTillSessions(db *sql.DB, data *structpb.Struct, clientId, branchId int64, date string) *structpb.Struct {
+
+	sessions, err := branchTillSessions(db, clientId, branchId, date)
+	if err != nil {
+
+		log.Printf("error getting till sessions of branch %d %s ", branchId, err.Error())
+		return data
+	}
+
+	var variance float64
+	values := make([]*structpb.Value, 0, len(sessions))
+
+	for _, s := range toStructList(sessions) {
+
+		values = append(values, structpb.NewStructValue(s))
+	}
+
+	for _, t := range sessions {
+
+		variance += t.Variance
+	}
+
+	if data != nil {
+
+		data.Fields["tillSessions"] = structpb.NewListValue(&structpb.ListValue{Values: values})
+		data.Fields["tillVariance"] = structpb.NewNumberValue(variance)
+	}
+
+	return data
+}
+
+// monthlyShop is one shop's month in one currency in the monthly report
+type monthlyShop struct {
+	BranchID      int64                `json:"branchId"`
+	Currency      string               `json:"currency"`
+	Sales         int64                `json:"sales"`
+	Payouts       int64                `json:"payouts"`
+	NormalSales   int64                `json:"normalSales"`
+	NormalPayouts int64                `json:"normalPayouts"`
+	OnlineSales   int64                `json:"onlineSales"`
+	OnlinePayouts int64                `json:"onlinePayouts"`
+	OtherSales    int64                `json:"otherSales"`
+	OtherPayouts  int64                `json:"otherPayouts"`
+	CashIn        int64                `json:"cashin"`
+	CashOut       int64                `json:"cashout"`
+	Expenses      int64                `json:"expenses"`
+	Profit        int64                `json:"profit"`
+	Days          []*models.ShopReport `json:"days"`
+}
+
+func (m *monthlyShop) add(r *models.ShopReport) {
+
+	m.NormalSales += r.NormalSales
+	m.NormalPayouts += r.NormalPayouts
+	m.OnlineSales += r.OnlineSales
+	m.OnlinePayouts += r.OnlinePayouts
+	m.OtherSales += r.OtherSales
+	m.OtherPayouts += r.OtherPayouts
+	m.CashIn += r.CashIn
+	m.CashOut += r.CashOut
+	m.Expenses += r.Expenses
+	m.Sales = m.NormalSales + m.OnlineSales + m.OtherSales
+	m.Payouts = m.NormalPayouts + m.OnlinePayouts + m.OtherPayouts
+	m.Profit = m.Sales - m.Payouts - m.Expenses
+}
+
+// FetchMonthlyShopReport rolls up the month of the given date per shop and currency, with the profit of
+// each shop after payouts and expenses and the totals per currency. With no userId it covers all the
+// client's shops
+func FetchMonthlyShopReport(db *sql.DB, in *pbWallet.FetchReportRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	date, err := reportDate(in.Date)
+	if err != nil {
+
+		return false, 400, "Invalid date, use YYYY-MM-DD", nil
+	}
+
+	from := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 1, 0)
+
+	days, err := shopFlows(db, int64(in.ClientId), int64(in.UserId), from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+
+		log.Printf("error getting monthly shop report %s ", err.Error())
+		return false, 500, "Unable to fetch monthly report", nil
+	}
+
+	type shopCurrency struct {
+		branchId int64
+		currency string
+	}
+
+	shops := map[shopCurrency]*monthlyShop{}
+	totals := map[string]*monthlyShop{}
+
+	for _, r := range days {
+
+		key := shopCurrency{r.BranchID, r.Currency}
+		shop, ok := shops[key]
+		if !ok {
+
+			shop = &monthlyShop{BranchID: r.BranchID, Currency: r.Currency}
+			shops[key] = shop
+		}
+
+		total, ok := totals[r.Currency]
+		if !ok {
+
+			total = &monthlyShop{Currency: r.Currency}
+			totals[r.Currency] = total
+		}
+
+		shop.add(r)
+		shop.Days = append(shop.Days, r)
+		total.add(r)
+	}
+
+	list := make([]*monthlyShop, 0, len(shops))
+	for _, shop := range shops {
+
+		sort.Slice(shop.Days, func(i, j int) bool { return shop.Days[i].Date < shop.Days[j].Date })
+		list = append(list, shop)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+
+		if list[i].BranchID != list[j].BranchID {
+
+			return list[i].BranchID < list[j].BranchID
+		}
+
+		return list[i].Currency < list[j].Currency
+	})
+
+	totalList := make([]*monthlyShop, 0, len(totals))
+	for _, total := range totals {
+
+		totalList = append(totalList, total)
+	}
+
+	sort.Slice(totalList, func(i, j int) bool { return totalList[i].Currency < totalList[j].Currency })
+
+	return true, 200, "Monthly report retrieved", toStruct(map[string]interface{}{
+		"month":  fmt.Sprintf("%d-%02d", from.Year(), from.Month()),
+		"shops":  list,
+		"totals": totalList,
+	})
+}
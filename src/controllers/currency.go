@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// defaultCurrencyWallet restricts a wallets query to the wallet in the client's default currency
+const defaultCurrencyWallet = " currency = COALESCE((SELECT c.currency FROM client_currencies c WHERE c.client_id = wallets.client_id AND c.is_default = 1), '') "
+
+// clientCurrencies lists the currencies a client's players can hold, the default first
+func clientCurrencies(db *sql.DB, clientId int32) ([]models.ClientCurrency, error) {
+
+	rows, err := db.Query("SELECT c.client_id, c.currency, cu.name, cu.minor_units, c.is_default FROM client_currencies c "+
+		" JOIN currencies cu ON cu.code = c.currency WHERE c.client_id = ? ORDER BY c.is_default DESC, c.currency", clientId)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	currencies := []models.ClientCurrency{}
+	for rows.Next() {
+
+		var c models.ClientCurrency
+		if err := rows.Scan(&c.ClientID, &c.Currency, &c.Name, &c.MinorUnits, &c.IsDefault); err != nil {
+
+			return nil, err
+		}
+
+		currencies = append(currencies, c)
+	}
+
+	return currencies, rows.Err()
+}
+
+// resolveCurrency returns the currency a wallet request is for, the client's default when it names
+// none. Clients that have not set up currencies keep their single wallet, whatever code is sent
+func resolveCurrency(db *sql.DB, clientId int32, code string) (models.Currency, error) {
+
+	currencies, err := clientCurrencies(db, clientId)
+	if err != nil {
+
+		return models.Currency{}, err
+	}
+
+	if len(currencies) == 0 {
+
+		return models.Currency{MinorUnits: 2}, nil
+	}
+
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	for _, c := range currencies {
+
+		if c.Currency == code || (code == "" && c.IsDefault) {
+
+			return models.Currency{Code: c.Currency, Name: c.Name, MinorUnits: c.MinorUnits}, nil
+		}
+	}
+
+	return models.Currency{}, fmt.Errorf("currency %s is not enabled for client %d", code, clientId)
+}
+
+// currencyAmount rounds an amount to the minor units of its currency, refusing amounts that carry
+// more decimals than the currency has
+func currencyAmount(amount float64, c models.Currency) (float64, bool) {
+
+	scale := math.Pow10(c.MinorUnits)
+	units := amount * scale
+
+	if math.Abs(units-math.Round(units)) > 1e-6 {
+
+		return 0, false
+	}
+
+	return math.Round(units) / scale, true
+}
+
+// SaveClientCurrency enables a currency for a client. The first currency is the default, and making
+// a currency the default moves the wallets created before the client had currencies into it
+func SaveClientCurrency(db *sql.DB, in *pbWallet.ClientCurrencyRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	code := strings.ToUpper(strings.TrimSpace(in.Currency))
+
+	var c models.Currency
+	err := db.QueryRow("SELECT code, name, minor_units FROM currencies WHERE code = ?", code).Scan(&c.Code, &c.Name, &c.MinorUnits)
+	if err == sql.ErrNoRows {
+
+		return false, 400, "Unknown currency", nil
+	}
+
+	if err != nil {
+
+		log.Printf("error getting currency %s %s ", code, err.Error())
+		return false, 500, "Unable to save currency", nil
+	}
+
+	currencies, err := clientCurrencies(db, in.ClientId)
+	if err != nil {
+
+		log.Printf("error getting currencies of client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to save currency", nil
+	}
+
+	isDefault := in.IsDefault || len(currencies) == 0
+
+	tx, err := db.Begin()
+	if err != nil {
+
+		log.Printf("error starting currency transaction %s ", err.Error())
+		return false, 500, "Unable to save currency", nil
+	}
+
+	defer tx.Rollback()
+
+	if isDefault {
+
+		if _, err = tx.Exec("UPDATE client_currencies SET is_default = 0 WHERE client_id = ?", in.ClientId); err == nil {
+
+			_, err = tx.Exec("UPDATE wallets SET currency = ? WHERE client_id = ? AND currency = ''", code, in.ClientId)
+		}
+
+		if err == nil {
+
+			_, err = tx.Exec("UPDATE sub_wallets SET currency = ? WHERE client_id = ? AND currency = ''", code, in.ClientId)
+		}
+
+		// the player totals follow the wallets they were counted from
+		if err == nil {
+
+			_, err = tx.Exec("UPDATE player_daily_totals SET currency = ? WHERE client_id = ? AND currency = ''", code, in.ClientId)
+		}
+
+		if err == nil {
+
+			_, err = tx.Exec("UPDATE player_wallet_stats SET currency = ? WHERE client_id = ? AND currency = ''", code, in.ClientId)
+		}
+
+		if err != nil {
+
+			log.Printf("error moving wallets of client %d to %s %s ", in.ClientId, code, err.Error())
+			return false, 500, "Unable to save currency", nil
+		}
+	}
+
+	_, err = tx.Exec("INSERT INTO client_currencies (client_id, currency, is_default) VALUES (?, ?, ?) "+
+		" ON DUPLICATE KEY UPDATE is_default = GREATEST(is_default, VALUES(is_default))", in.ClientId, code, isDefault)
+
+	if err != nil {
+
+		log.Printf("error saving currency %s of client %d %s ", code, in.ClientId, err.Error())
+		return false, 500, "Unable to save currency", nil
+	}
+
+	if err := tx.Commit(); err != nil {
+
+		log.Printf("error committing currency %s of client %d %s ", code, in.ClientId, err.Error())
+		return false, 500, "Unable to save currency", nil
+	}
+
+	return true, 200, "Currency saved", toStruct(models.ClientCurrency{
+		ClientID:   int64(in.ClientId),
+		Currency:   c.Code,
+		Name:       c.Name,
+		MinorUnits: c.MinorUnits,
+		IsDefault:  isDefault,
+	})
+}
+
+// GetClientCurrencies lists the currencies enabled for a client
+func GetClientCurrencies(db *sql.DB, in *pbWallet.ClientCurrencyRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	currencies, err := clientCurrencies(db, in.ClientId)
+	if err != nil {
+
+		log.Printf("error getting currencies of client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch currencies", nil
+	}
+
+	return true, 200, "Currencies retrieved", toStructList(currencies)
+}
@@ -0,0 +1,213 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const depositColumns = "id, client_id, user_id, username, provider, reference, provider_reference, amount, currency, msisdn, source, " +
+	"status, provider_status, transaction_no, created_at, updated_at"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDeposit(row rowScanner) (*models.Deposit, error) {
+
+	var d models.Deposit
+	var providerReference, msisdn, providerStatus, transactionNo sql.NullString
+
+	err := row.Scan(&d.ID, &d.ClientID, &d.UserID, &d.Username, &d.Provider, &d.Reference, &providerReference, &d.Amount, &d.Currency,
+		&msisdn, &d.Source, &d.Status, &providerStatus, &transactionNo, &d.CreatedAt, &d.UpdatedAt)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	d.ProviderReference = providerReference.String
+	d.Msisdn = msisdn.String
+	d.ProviderStatus = providerStatus.String
+	d.TransactionNo = transactionNo.String
+
+	return &d, nil
+}
+
+// createDeposit saves a pending deposit
+func createDeposit(db *sql.DB, d *models.Deposit) error {
+
+	return createAccountDeposit(db, d, "")
+}
+
+// createAccountDeposit saves a pending deposit paid into a virtual account. The reference only has to
+// be unique for the account
+func createAccountDeposit(db *sql.DB, d *models.Deposit, accountNumber string) error {
+
+	res, err := db.Exec("INSERT INTO deposits (client_id, user_id, username, provider, reference, provider_reference, account_number, amount, currency, msisdn, source, status, created_at) "+
+		" VALUES (?,?,?,?,?,?,?,?,?,?,?,?,NOW())",
+		d.ClientID, d.UserID, d.Username, d.Provider, d.Reference, nullString(d.ProviderReference), accountNumber, d.Amount, d.Currency, nullString(d.Msisdn), d.Source, models.StatusPending)
+
+	if err != nil {
+
+		log.Printf("error saving %s deposit %s %s ", d.Provider, d.Reference, err.Error())
+		return err
+	}
+
+	d.ID, _ = res.LastInsertId()
+	d.Status = models.StatusPending
+	return nil
+}
+
+func getDepositByReference(db *sql.DB, provider, reference string) (*models.Deposit, error) {
+
+	return scanDeposit(db.QueryRow("SELECT "+depositColumns+" FROM deposits WHERE provider = ? AND reference = ? AND account_number = ''", provider, reference))
+}
+
+// getAccountDeposit returns the deposit made by a transfer into a virtual account
+func getAccountDeposit(db *sql.DB, provider, accountNumber, reference string) (*models.Deposit, error) {
+
+	return scanDeposit(db.QueryRow("SELECT "+depositColumns+" FROM deposits WHERE provider = ? AND reference = ? AND account_number = ?", provider, reference, accountNumber))
+}
+
+func getDepositByProviderReference(db *sql.DB, provider, providerReference string) (*models.Deposit, error) {
+
+	return scanDeposit(db.QueryRow("SELECT "+depositColumns+" FROM deposits WHERE provider = ? AND provider_reference = ?", provider, providerReference))
+}
+
+// getPendingDeposits returns the deposits of a provider that are still pending after the given number of
+// minutes and have been checked fewer than maxAttempts times, those gone longest without a check first
+func getPendingDeposits(db *sql.DB, provider string, olderThanMinutes, maxAttempts, limit int) ([]*models.Deposit, error) {
+
+	rows, err := db.Query("SELECT "+depositColumns+" FROM deposits WHERE provider = ? AND status = ? AND created_at < NOW() - INTERVAL ? MINUTE "+
+		" AND attempts < ? ORDER BY last_checked_at, id LIMIT ?", provider, models.StatusPending, olderThanMinutes, maxAttempts, limit)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var deposits []*models.Deposit
+	for rows.Next() {
+
+		d, err := scanDeposit(rows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		deposits = append(deposits, d)
+	}
+
+	return deposits, rows.Err()
+}
+
+// markDepositChecked counts a sweep check of a deposit and returns how many it has had
+func markDepositChecked(db *sql.DB, d *models.Deposit) int {
+
+	_, err := db.Exec("UPDATE deposits SET last_checked_at = NOW(), attempts = attempts + 1 WHERE id = ?", d.ID)
+	if err != nil {
+
+		log.Printf("error marking deposit %s checked %s ", d.Reference, err.Error())
+		return 0
+	}
+
+	var attempts int
+	if err := db.QueryRow("SELECT attempts FROM deposits WHERE id = ?", d.ID).Scan(&attempts); err != nil {
+
+		log.Printf("error getting attempts of deposit %s %s ", d.Reference, err.Error())
+	}
+
+	return attempts
+}
+
+// completeDeposit marks a pending deposit completed and credits the player. It returns false without
+// crediting when the deposit had already left the pending state, so repeated notifications credit once
+func completeDeposit(db *sql.DB, d *models.Deposit, providerStatus string) (bool, error) {
+
+	res, err := db.Exec("UPDATE deposits SET status = ?, provider_status = ? WHERE id = ? AND status = ?",
+		models.StatusCompleted, providerStatus, d.ID, models.StatusPending)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, nil
+	}
+
+	success, _, message, _, transactionNo := creditUser(db, &pbWallet.CreditUserRequest{
+		UserId:      int32(d.UserID),
+		ClientId:    int32(d.ClientID),
+		Amount:      fmt.Sprintf("%.2f", d.Amount),
+		Source:      d.Source,
+		Description: fmt.Sprintf("Deposit via %s - %s", d.Provider, d.Reference),
+		Username:    d.Username,
+		Wallet:      "main",
+		Subject:     models.SubjectDeposit,
+		Channel:     d.Provider,
+		Currency:    &d.Currency,
+	})
+
+	if !success {
+
+		// put it back so the next notification or a reconciliation can retry
+		_, _ = db.Exec("UPDATE deposits SET status = ? WHERE id = ?", models.StatusPending, d.ID)
+		return false, fmt.Errorf("unable to credit deposit %s: %s", d.Reference, message)
+	}
+
+	_, err = db.Exec("UPDATE deposits SET transaction_no = ? WHERE id = ?", transactionNo, d.ID)
+	if err != nil {
+
+		log.Printf("error saving transaction no for deposit %d %s ", d.ID, err.Error())
+	}
+
+	d.Status = models.StatusCompleted
+	d.ProviderStatus = providerStatus
+	d.TransactionNo = transactionNo
+
+	return true, nil
+}
+
+// failDeposit marks a pending deposit failed
+func failDeposit(db *sql.DB, d *models.Deposit, providerStatus string) (bool, error) {
+
+	res, err := db.Exec("UPDATE deposits SET status = ?, provider_status = ? WHERE id = ? AND status = ?",
+		models.StatusFailed, providerStatus, d.ID, models.StatusPending)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	n, _ := res.RowsAffected()
+	if n > 0 {
+
+		d.Status = models.StatusFailed
+		d.ProviderStatus = providerStatus
+	}
+
+	return n > 0, nil
+}
+
+// setDepositProviderStatus records an intermediate provider status without changing the deposit state
+func setDepositProviderStatus(db *sql.DB, d *models.Deposit, providerStatus string) {
+
+	_, err := db.Exec("UPDATE deposits SET provider_status = ? WHERE id = ? AND status = ?", providerStatus, d.ID, models.StatusPending)
+	if err != nil {
+
+		log.Printf("error updating deposit %d provider status %s ", d.ID, err.Error())
+	}
+}
+
+func nullString(s string) sql.NullString {
+
+	return sql.NullString{String: s, Valid: s != ""}
+}
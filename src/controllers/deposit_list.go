@@ -0,0 +1,252 @@
+package controllers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	depositsPerPage   = 50
+	depositsMaxPage   = 500
+	depositExportPage = 5000
+)
+
+// depositFilter builds the where clause of a deposit listing
+func depositFilter(in *pbWallet.ListDepositRequests) (string, []interface{}) {
+
+	where := []string{"client_id = ?"}
+	args := []interface{}{in.ClientId}
+
+	if in.StartDate != "" {
+
+		where = append(where, "created_at >= ?")
+		args = append(args, in.StartDate)
+	}
+
+	if in.EndDate != "" {
+
+		// a plain date includes the whole day
+		if _, err := time.Parse("2006-01-02", in.EndDate); err == nil {
+
+			where = append(where, "created_at < ? + INTERVAL 1 DAY")
+		} else {
+
+			where = append(where, "created_at <= ?")
+		}
+
+		args = append(args, in.EndDate)
+	}
+
+	if in.PaymentMethod != "" {
+
+		where = append(where, "provider = ?")
+		args = append(args, strings.ToLower(in.PaymentMethod))
+	}
+
+	if in.Status != nil {
+
+		where = append(where, "status = ?")
+		args = append(args, in.GetStatus())
+	}
+
+	if in.Username != "" {
+
+		where = append(where, "username = ?")
+		args = append(args, in.Username)
+	}
+
+	if in.TransactionId != "" {
+
+		where = append(where, "(reference = ? OR provider_reference = ? OR transaction_no = ?)")
+		args = append(args, in.TransactionId, in.TransactionId, in.TransactionId)
+	}
+
+	return " WHERE " + strings.Join(where, " AND "), args
+}
+
+func queryDeposits(db *sql.DB, query string, args ...interface{}) ([]*models.Deposit, error) {
+
+	rows, err := db.Query("SELECT "+depositColumns+" FROM deposits "+query, args...)
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	deposits := []*models.Deposit{}
+	for rows.Next() {
+
+		d, err := scanDeposit(rows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		deposits = append(deposits, d)
+	}
+
+	return deposits, rows.Err()
+}
+
+// ListDeposits lists a client's deposits for payment reconciliation, filtered by provider, status,
+// date, username and reference, with the provider reference and the transaction_no that was credited.
+// With export set the filtered set is returned as CSV files of depositExportPage rows, one per page
+func ListDeposits(db *sql.DB, in *pbWallet.ListDepositRequests) *pbWallet.PaginationResponse {
+
+	where, args := depositFilter(in)
+
+	if in.GetExport() {
+
+		return exportDeposits(db, in.Page, where, args)
+	}
+
+	perPage := int32(depositsPerPage)
+	if in.GetPerPage() > 0 && in.GetPerPage() <= depositsMaxPage {
+
+		perPage = in.GetPerPage()
+	}
+
+	page := in.Page
+	if page < 1 {
+
+		page = 1
+	}
+
+	var count int32
+	if err := db.QueryRow("SELECT COUNT(*) FROM deposits "+where, args...).Scan(&count); err != nil {
+
+		log.Printf("error counting deposits %s ", err.Error())
+		return &pbWallet.PaginationResponse{Message: "Unable to fetch deposits"}
+	}
+
+	deposits, err := queryDeposits(db, where+" ORDER BY id DESC LIMIT ? OFFSET ?", append(args, perPage, (page-1)*perPage)...)
+	if err != nil {
+
+		log.Printf("error getting deposits %s ", err.Error())
+		return &pbWallet.PaginationResponse{Message: "Unable to fetch deposits"}
+	}
+
+	lastPage := (count + perPage - 1) / perPage
+	if lastPage < 1 {
+
+		lastPage = 1
+	}
+
+	res := &pbWallet.PaginationResponse{
+		Message:     "Deposits retrieved",
+		Count:       count,
+		CurrentPage: page,
+		LastPage:    lastPage,
+		Data:        toStructList(deposits),
+	}
+
+	if page < lastPage {
+
+		res.NextPage = page + 1
+	}
+
+	if page > 1 {
+
+		res.PrevPage = page - 1
+	}
+
+	return res
+}
+
+// exportDeposits returns a page of the export as a CSV file. Pages go up in id, so deposits made while
+// an export is fetched only add to its last page, and only the first page has the header, so the files
+// can be joined in order. A file is flagged truncated while more pages follow
+func exportDeposits(db *sql.DB, page int32, where string, args []interface{}) *pbWallet.PaginationResponse {
+
+	if page < 1 {
+
+		page = 1
+	}
+
+	var count int32
+	if err := db.QueryRow("SELECT COUNT(*) FROM deposits "+where, args...).Scan(&count); err != nil {
+
+		log.Printf("error counting deposits %s ", err.Error())
+		return &pbWallet.PaginationResponse{Message: "Unable to export deposits"}
+	}
+
+	deposits, err := queryDeposits(db, where+" ORDER BY id ASC LIMIT ? OFFSET ?", append(args, depositExportPage, (page-1)*depositExportPage)...)
+	if err != nil {
+
+		log.Printf("error exporting deposits %s ", err.Error())
+		return &pbWallet.PaginationResponse{Message: "Unable to export deposits"}
+	}
+
+	lastPage := (count + depositExportPage - 1) / depositExportPage
+	if lastPage < 1 {
+
+		lastPage = 1
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if page == 1 {
+
+		_ = w.Write([]string{"id", "user_id", "username", "provider", "reference", "provider_reference", "amount", "currency", "status",
+			"provider_status", "transaction_no", "created_at"})
+	}
+
+	for _, d := range deposits {
+
+		_ = w.Write([]string{fmt.Sprintf("%d", d.ID), fmt.Sprintf("%d", d.UserID), d.Username, d.Provider, d.Reference, d.ProviderReference,
+			fmt.Sprintf("%.2f", d.Amount), d.Currency, depositStatusName(d.Status), d.ProviderStatus, d.TransactionNo, d.CreatedAt})
+	}
+
+	w.Flush()
+
+	file, _ := structpb.NewStruct(map[string]interface{}{
+		"fileName":    fmt.Sprintf("deposits-%s-%d.csv", time.Now().Format("20060102150405"), page),
+		"contentType": "text/csv",
+		"content":     buf.String(),
+		"rows":        len(deposits),
+		"truncated":   page < lastPage,
+	})
+
+	res := &pbWallet.PaginationResponse{
+		Message:     "Deposits exported",
+		Count:       count,
+		CurrentPage: page,
+		LastPage:    lastPage,
+		Data:        []*structpb.Struct{file},
+	}
+
+	if page < lastPage {
+
+		res.NextPage = page + 1
+	}
+
+	if page > 1 {
+
+		res.PrevPage = page - 1
+	}
+
+	return res
+}
+
+func depositStatusName(status int64) string {
+
+	switch status {
+	case models.StatusCompleted:
+		return "completed"
+	case models.StatusFailed:
+		return "failed"
+	default:
+		return "pending"
+	}
+}
@@ -0,0 +1,360 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const exchangeRateColumns = "id, client_id, base_currency, quote_currency, rate, spread, effective_at, source, created_at"
+
+func scanExchangeRate(row rowScanner) (models.ExchangeRate, error) {
+
+	var r models.ExchangeRate
+	var spread sql.NullFloat64
+
+	err := row.Scan(&r.ID, &r.ClientID, &r.BaseCurrency, &r.QuoteCurrency, &r.Rate, &spread, &r.EffectiveAt, &r.Source, &r.CreatedAt)
+	if err != nil {
+
+		return r, err
+	}
+
+	r.Spread = defaultSpread()
+	if spread.Valid {
+
+		r.Spread = spread.Float64
+	}
+
+	return r, nil
+}
+
+// defaultSpread is the spread in percent applied when a rate does not carry its own
+func defaultSpread() float64 {
+
+	v, err := strconv.ParseFloat(os.Getenv("conversion_spread_percent"), 64)
+	if err != nil || v < 0 {
+
+		return 0
+	}
+
+	return v
+}
+
+// exchangeRate returns the rate in force now for converting from one currency to another. Rates of
+// either direction count, the opposite pair's rate inverted, and the newest one wins. A client's own
+// rate wins over the shared one
+func exchangeRate(db *sql.DB, clientId int32, from, to string) (rate models.ExchangeRate, mid float64, err error) {
+
+	rate, err = scanExchangeRate(db.QueryRow("SELECT "+exchangeRateColumns+" FROM exchange_rates WHERE client_id IN (0, ?) "+
+		" AND ((base_currency = ? AND quote_currency = ?) OR (base_currency = ? AND quote_currency = ?)) "+
+		" AND effective_at <= NOW() ORDER BY client_id DESC, effective_at DESC, id DESC LIMIT 1", clientId, from, to, to, from))
+
+	if err != nil {
+
+		return rate, 0, err
+	}
+
+	if rate.BaseCurrency == from {
+
+		return rate, rate.Rate, nil
+	}
+
+	return rate, 1 / rate.Rate, nil
+}
+
+// convertAmount converts an amount with the rate in force less its spread. The converted amount is
+// rounded down to the minor units of the target currency so a conversion never pays out more
+func convertAmount(db *sql.DB, clientId int32, from, to models.Currency, amount float64) (*models.Conversion, error) {
+
+	rate, mid, err := exchangeRate(db, clientId, from.Code, to.Code)
+	if err != nil {
+
+		return nil, err
+	}
+
+	applied := mid * (1 - rate.Spread/100)
+	scale := math.Pow10(to.MinorUnits)
+
+	return &models.Conversion{
+		ClientID:     int64(clientId),
+		FromCurrency: from.Code,
+		ToCurrency:   to.Code,
+		FromAmount:   amount,
+		ToAmount:     math.Floor(amount*applied*scale+1e-6) / scale,
+		RateID:       rate.ID,
+		MidRate:      mid,
+		Spread:       rate.Spread,
+		AppliedRate:  applied,
+	}, nil
+}
+
+// exchangeRateInput checks a rate before it is saved and returns the time it takes effect
+func exchangeRateInput(db *sql.DB, base, quote string, rate float64, effectiveAt string, spread *float64) (string, error) {
+
+	if base == quote {
+
+		return "", fmt.Errorf("base and quote currency must differ")
+	}
+
+	var known int
+	err := db.QueryRow("SELECT COUNT(*) FROM currencies WHERE code IN (?, ?)", base, quote).Scan(&known)
+	if err != nil {
+
+		return "", err
+	}
+
+	if known != 2 {
+
+		return "", fmt.Errorf("unknown currency in %s/%s", base, quote)
+	}
+
+	if rate <= 0 {
+
+		return "", fmt.Errorf("rate must be above zero")
+	}
+
+	if spread != nil && (*spread < 0 || *spread >= 100) {
+
+		return "", fmt.Errorf("spread must be a percentage below 100")
+	}
+
+	if effectiveAt == "" {
+
+		return time.Now().Format("2006-01-02 15:04:05"), nil
+	}
+
+	// the database keeps local times, which NOW() is compared with, so a time with a zone is moved to
+	// local time first. Times without one are local already
+	if t, err := time.Parse(time.RFC3339, effectiveAt); err == nil {
+
+		return t.In(time.Local).Format("2006-01-02 15:04:05"), nil
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+
+		if t, err := time.Parse(layout, effectiveAt); err == nil {
+
+			return t.Format("2006-01-02 15:04:05"), nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid effective time %s", effectiveAt)
+}
+
+func insertExchangeRate(tx *sql.Tx, clientId int32, base, quote string, rate float64, spread *float64, effectiveAt, source string) (int64, error) {
+
+	var s sql.NullFloat64
+	if spread != nil {
+
+		s = sql.NullFloat64{Float64: *spread, Valid: true}
+	}
+
+	res, err := tx.Exec("INSERT INTO exchange_rates (client_id, base_currency, quote_currency, rate, spread, effective_at, source) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		clientId, base, quote, rate, s, effectiveAt, source)
+
+	if err != nil {
+
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+// SaveExchangeRate loads a rate. Rates are never changed, a new rate with a later effective time
+// replaces the one in force so past conversions can still be restated
+func SaveExchangeRate(db *sql.DB, in *pbWallet.ExchangeRateRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	base := strings.ToUpper(strings.TrimSpace(in.BaseCurrency))
+	quote := strings.ToUpper(strings.TrimSpace(in.QuoteCurrency))
+
+	effectiveAt, err := exchangeRateInput(db, base, quote, in.Rate, in.GetEffectiveAt(), in.Spread)
+	if err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+
+		log.Printf("error starting exchange rate transaction %s ", err.Error())
+		return false, 500, "Unable to save exchange rate", nil
+	}
+
+	defer tx.Rollback()
+
+	id, err := insertExchangeRate(tx, in.ClientId, base, quote, in.Rate, in.Spread, effectiveAt, "admin")
+	if err == nil {
+
+		err = tx.Commit()
+	}
+
+	if err != nil {
+
+		log.Printf("error saving exchange rate %s/%s %s ", base, quote, err.Error())
+		return false, 500, "Unable to save exchange rate", nil
+	}
+
+	rate, err := scanExchangeRate(db.QueryRow("SELECT "+exchangeRateColumns+" FROM exchange_rates WHERE id = ?", id))
+	if err != nil {
+
+		log.Printf("error getting exchange rate %d %s ", id, err.Error())
+		return false, 500, "Unable to fetch exchange rate", nil
+	}
+
+	return true, 200, "Exchange rate saved", toStruct(rate)
+}
+
+// ImportExchangeRates loads rates from a csv file with the columns base, quote, rate and optionally
+// effective_at and spread. A header row is skipped, and one bad line rejects the whole file
+func ImportExchangeRates(db *sql.DB, in *pbWallet.ExchangeRateImportRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	r := csv.NewReader(strings.NewReader(in.Content))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+
+		return false, 400, fmt.Sprintf("Invalid rates file: %s", err.Error()), nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+
+		log.Printf("error starting exchange rate import %s ", err.Error())
+		return false, 500, "Unable to import exchange rates", nil
+	}
+
+	defer tx.Rollback()
+
+	var imported int
+	for i, record := range records {
+
+		if len(record) < 3 {
+
+			return false, 400, fmt.Sprintf("Line %d: expected base, quote and rate", i+1), nil
+		}
+
+		base := strings.ToUpper(strings.TrimSpace(record[0]))
+		quote := strings.ToUpper(strings.TrimSpace(record[1]))
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+
+			if i == 0 {
+
+				// header
+				continue
+			}
+
+			return false, 400, fmt.Sprintf("Line %d: invalid rate %s", i+1, record[2]), nil
+		}
+
+		var effectiveAt string
+		if len(record) > 3 {
+
+			effectiveAt = strings.TrimSpace(record[3])
+		}
+
+		var spread *float64
+		if len(record) > 4 && strings.TrimSpace(record[4]) != "" {
+
+			s, err := strconv.ParseFloat(strings.TrimSpace(record[4]), 64)
+			if err != nil {
+
+				return false, 400, fmt.Sprintf("Line %d: invalid spread %s", i+1, record[4]), nil
+			}
+
+			spread = &s
+		}
+
+		effectiveAt, err = exchangeRateInput(db, base, quote, rate, effectiveAt, spread)
+		if err != nil {
+
+			return false, 400, fmt.Sprintf("Line %d: %s", i+1, err.Error()), nil
+		}
+
+		if _, err := insertExchangeRate(tx, in.ClientId, base, quote, rate, spread, effectiveAt, "import"); err != nil {
+
+			log.Printf("error importing exchange rate %s/%s %s ", base, quote, err.Error())
+			return false, 500, "Unable to import exchange rates", nil
+		}
+
+		imported++
+	}
+
+	if err := tx.Commit(); err != nil {
+
+		log.Printf("error committing exchange rate import %s ", err.Error())
+		return false, 500, "Unable to import exchange rates", nil
+	}
+
+	return true, 200, "Exchange rates imported", toStruct(map[string]interface{}{"imported": imported})
+}
+
+// GetExchangeRates lists the rates in force for a client, or the history of one pair when it is given
+func GetExchangeRates(db *sql.DB, in *pbWallet.ExchangeRateRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	base := strings.ToUpper(strings.TrimSpace(in.BaseCurrency))
+	quote := strings.ToUpper(strings.TrimSpace(in.QuoteCurrency))
+
+	query := "SELECT " + exchangeRateColumns + " FROM exchange_rates WHERE client_id IN (0, ?) "
+	args := []interface{}{in.ClientId}
+
+	if base != "" && quote != "" {
+
+		query += " AND base_currency = ? AND quote_currency = ? ORDER BY effective_at DESC, id DESC LIMIT 100"
+		args = append(args, base, quote)
+	} else {
+
+		query += " AND effective_at <= NOW() ORDER BY base_currency, quote_currency, client_id DESC, effective_at DESC, id DESC"
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+
+		log.Printf("error getting exchange rates of client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch exchange rates", nil
+	}
+
+	defer rows.Close()
+
+	rates := []models.ExchangeRate{}
+	seen := map[string]bool{}
+
+	for rows.Next() {
+
+		r, err := scanExchangeRate(rows)
+		if err != nil {
+
+			log.Printf("error scanning exchange rate %s ", err.Error())
+			continue
+		}
+
+		// without a pair only the first, current, rate of each pair is listed
+		pair := r.BaseCurrency + "/" + r.QuoteCurrency
+		if base == "" || quote == "" {
+
+			if seen[pair] {
+
+				continue
+			}
+
+			seen[pair] = true
+		}
+
+		rates = append(rates, r)
+	}
+
+	return true, 200, "Exchange rates retrieved", toStructList(rates)
+}
@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestExchangeRateInvertsOppositePair(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("FROM exchange_rates", []string{"id", "client_id", "base_currency", "quote_currency", "rate", "spread", "effective_at", "source", "created_at"},
+		[]driver.Value{int64(7), int64(0), "USD", "KES", 125.0, nil, "2026-01-01 00:00:00", "admin", "2026-01-01 00:00:00"})
+
+	rate, mid, err := exchangeRate(db, 1, "KES", "USD")
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if rate.ID != 7 || mid != 1/125.0 {
+
+		t.Fatalf("expected the USD/KES rate inverted, got rate %d at %v", rate.ID, mid)
+	}
+
+	ran := fake.Ran("FROM exchange_rates")
+	if len(ran) != 1 {
+
+		t.Fatalf("expected both directions in one query, got %d queries", len(ran))
+	}
+}
+
+func TestExchangeRateInputMovesZonedTimesToLocal(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("FROM currencies", []string{"count"}, []driver.Value{int64(2)})
+
+	effectiveAt, err := exchangeRateInput(db, "USD", "KES", 125, "2026-03-01T12:00:00+03:00", nil)
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	want := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC).In(time.Local).Format("2006-01-02 15:04:05")
+	if effectiveAt != want {
+
+		t.Fatalf("expected %s, got %s", want, effectiveAt)
+	}
+}
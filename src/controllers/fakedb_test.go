@@ -0,0 +1,250 @@
+package controllers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeRows is the result a fake database returns for queries containing a fragment
+type fakeRows struct {
+	match   string
+	columns []string
+	rows    [][]driver.Value
+}
+
+// fakeResult is what a fake database reports for writes containing a fragment
+type fakeResult struct {
+	match    string
+	affected int64
+	err      error
+}
+
+// fakeStatement is a statement a fake database has run
+type fakeStatement struct {
+	query string
+	args  []driver.Value
+}
+
+// fakeDB answers queries with the first registered result whose fragment the query contains and
+// records every statement. Unknown queries return no rows and writes affect one row
+type fakeDB struct {
+	mu         sync.Mutex
+	results    []fakeRows
+	writes     []fakeResult
+	Statements []fakeStatement
+}
+
+var (
+	fakeDBsMu sync.Mutex
+	fakeDBs   = map[string]*fakeDB{}
+)
+
+func init() {
+
+	sql.Register("fakedb", fakeDriver{})
+}
+
+func newFakeDB(t *testing.T) (*sql.DB, *fakeDB) {
+
+	fake := &fakeDB{}
+
+	fakeDBsMu.Lock()
+	fakeDBs[t.Name()] = fake
+	fakeDBsMu.Unlock()
+
+	db, err := sql.Open("fakedb", t.Name())
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	return db, fake
+}
+
+// On registers the rows returned for queries containing a fragment
+func (f *fakeDB) On(match string, columns []string, rows ...[]driver.Value) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.results = append(f.results, fakeRows{match: match, columns: columns, rows: rows})
+}
+
+// Affects sets the number of rows writes containing a fragment report
+func (f *fakeDB) Affects(match string, affected int64) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.writes = append(f.writes, fakeResult{match: match, affected: affected})
+}
+
+// Fails makes writes containing a fragment return an error
+func (f *fakeDB) Fails(match string, err error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.writes = append(f.writes, fakeResult{match: match, err: err})
+}
+
+// Ran returns the statements that contain a fragment
+func (f *fakeDB) Ran(match string) []fakeStatement {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var ran []fakeStatement
+	for _, s := range f.Statements {
+
+		if strings.Contains(s.query, match) {
+
+			ran = append(ran, s)
+		}
+	}
+
+	return ran
+}
+
+// Index returns the position of the first statement that contains a fragment, -1 when none ran
+func (f *fakeDB) Index(match string) int {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, s := range f.Statements {
+
+		if strings.Contains(s.query, match) {
+
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (f *fakeDB) run(query string, args []driver.Value) fakeRows {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Statements = append(f.Statements, fakeStatement{query: query, args: args})
+
+	for _, r := range f.results {
+
+		if strings.Contains(query, r.match) {
+
+			return r
+		}
+	}
+
+	return fakeRows{}
+}
+
+func (f *fakeDB) write(query string, args []driver.Value) (driver.Result, error) {
+
+	f.run(query, args)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, w := range f.writes {
+
+		if strings.Contains(query, w.match) {
+
+			if w.err != nil {
+
+				return nil, w.err
+			}
+
+			return driver.RowsAffected(w.affected), nil
+		}
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+
+	fakeDBsMu.Lock()
+	defer fakeDBsMu.Unlock()
+
+	fake, ok := fakeDBs[name]
+	if !ok {
+
+		return nil, fmt.Errorf("no fake database %s", name)
+	}
+
+	return &fakeConn{db: fake}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error { return nil }
+
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+
+	return s.db.write(s.query, args)
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+
+	r := s.db.run(s.query, args)
+	return &fakeCursor{columns: r.columns, rows: r.rows}, nil
+}
+
+type fakeCursor struct {
+	columns []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (c *fakeCursor) Columns() []string { return c.columns }
+
+func (c *fakeCursor) Close() error { return nil }
+
+func (c *fakeCursor) Next(dest []driver.Value) error {
+
+	if c.next >= len(c.rows) {
+
+		return io.EOF
+	}
+
+	copy(dest, c.rows[c.next])
+	c.next++
+
+	return nil
+}
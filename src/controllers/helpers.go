@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"log"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// toStruct converts any json serializable value into a protobuf struct
+func toStruct(v interface{}) *structpb.Struct {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+
+		log.Printf("error marshalling response %s ", err.Error())
+		return nil
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+
+		log.Printf("error unmarshalling response %s ", err.Error())
+		return nil
+	}
+
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+
+		log.Printf("error building struct %s ", err.Error())
+		return nil
+	}
+
+	return s
+}
+
+// toStructList converts a slice of json serializable values into protobuf structs
+func toStructList[T any](rows []T) []*structpb.Struct {
+
+	list := make([]*structpb.Struct, 0, len(rows))
+	for _, r := range rows {
+
+		list = append(list, toStruct(r))
+	}
+
+	return list
+}
+
+// getWalletUsername returns the username held on a player's wallet
+func getWalletUsername(db *sql.DB, clientId, userId int32) (string, error) {
+
+	var username string
+	err := db.QueryRow("SELECT username FROM wallets WHERE user_id = ? AND client_id = ? LIMIT 1", userId, clientId).Scan(&username)
+	return username, err
+}
+
+// logCallback keeps the raw provider notification and what we did with it, for support and audit
+func logCallback(db *sql.DB, clientId int32, provider, reference, event, status, body, response string) {
+
+	_, err := db.Exec("INSERT INTO callback_logs (client_id, provider, reference, event, status, body, response, created_at) VALUES (?,?,?,?,?,?,?,NOW())",
+		clientId, provider, reference, nullString(event), nullString(status), nullString(body), response)
+
+	if err != nil {
+
+		log.Printf("error saving %s callback %s %s ", provider, reference, err.Error())
+	}
+}
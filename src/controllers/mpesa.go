@@ -0,0 +1,374 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const mpesaProvider = "mpesa"
+
+// mpesaCountry is how players of an M-Pesa market write their numbers and what they pay in
+type mpesaCountry struct {
+	dialCode string
+	currency string
+}
+
+// mpesaCountries are the M-Pesa markets by ISO3 country
+var mpesaCountries = map[string]mpesaCountry{
+	"KEN": {dialCode: "254", currency: "KES"},
+	"TZA": {dialCode: "255", currency: "TZS"},
+	"MOZ": {dialCode: "258", currency: "MZN"},
+	"COD": {dialCode: "243", currency: "CDF"},
+	"GHA": {dialCode: "233", currency: "GHS"},
+	"ETH": {dialCode: "251", currency: "ETB"},
+}
+
+// mpesaMarkets returns the markets configured on a client's M-Pesa payment method. Clients that
+// have not set a country keep matching Kenyan and Tanzanian numbers
+func mpesaMarkets(db *sql.DB, clientId int32) []mpesaCountry {
+
+	if pm, err := getPaymentMethod(db, clientId, mpesaProvider); err == nil {
+
+		if c, ok := mpesaCountries[strings.ToUpper(pm.Country)]; ok {
+
+			return []mpesaCountry{c}
+		}
+	}
+
+	return []mpesaCountry{mpesaCountries["KEN"], mpesaCountries["TZA"]}
+}
+
+// mpesaCurrency returns the currency an M-Pesa deposit is credited in, that of the configured
+// market when the client has it enabled and the client's default otherwise
+func mpesaCurrency(db *sql.DB, clientId int32, markets []mpesaCountry) (models.Currency, error) {
+
+	if len(markets) == 1 {
+
+		if c, err := resolveCurrency(db, clientId, markets[0].currency); err == nil {
+
+			return c, nil
+		}
+	}
+
+	return resolveCurrency(db, clientId, "")
+}
+
+// StkDepositNotification credits a C2B payment to the player whose username is the paying MSISDN.
+// The M-Pesa receipt (trxCode) is the deposit reference, so a receipt is only ever credited once
+func StkDepositNotification(db *sql.DB, in *pbWallet.StkTransactionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("M-Pesa deposit %s from %s in client %d ", in.TrxCode, in.Msisdn, in.ClientId)
+
+	if in.TrxCode == "" {
+
+		return false, 400, "Transaction code is required", nil
+	}
+
+	amount, err := strconv.ParseFloat(in.Amount, 64)
+	if err != nil || amount <= 0 {
+
+		return false, 400, "Invalid amount", nil
+	}
+
+	deposit, err := getDepositByReference(db, mpesaProvider, in.TrxCode)
+	if err == nil {
+
+		if deposit.ClientID != int64(in.ClientId) {
+
+			log.Printf("M-Pesa deposit %s belongs to client %d, not %d ", in.TrxCode, deposit.ClientID, in.ClientId)
+			return false, 404, "Transaction not found", nil
+		}
+
+		if deposit.Status != models.StatusPending {
+
+			return true, 200, "Transaction already processed", toStruct(deposit)
+		}
+
+	} else if err == sql.ErrNoRows {
+
+		markets := mpesaMarkets(db, in.ClientId)
+
+		userId, username, err := findWalletByMsisdn(db, in.ClientId, in.Msisdn, markets)
+		if err != nil {
+
+			log.Printf("error matching M-Pesa deposit %s to %s %s ", in.TrxCode, in.Msisdn, err.Error())
+			return false, 404, "Player not found", nil
+		}
+
+		currency, err := mpesaCurrency(db, in.ClientId, markets)
+		if err != nil {
+
+			log.Printf("error getting M-Pesa deposit currency for client %d %s ", in.ClientId, err.Error())
+			return false, 500, "Unable to save deposit", nil
+		}
+
+		deposit = &models.Deposit{
+			ClientID:          int64(in.ClientId),
+			UserID:            userId,
+			Username:          username,
+			Provider:          mpesaProvider,
+			Reference:         in.TrxCode,
+			ProviderReference: in.RefId,
+			Amount:            amount,
+			Currency:          currency.Code,
+			Msisdn:            in.Msisdn,
+			Source:            mpesaProvider,
+		}
+
+		if err := createDeposit(db, deposit); err != nil {
+
+			// a concurrent notification for the same receipt may have saved it first
+			if deposit, err = getDepositByReference(db, mpesaProvider, in.TrxCode); err != nil || deposit.ClientID != int64(in.ClientId) {
+
+				return false, 500, "Unable to save deposit", nil
+			}
+		}
+
+	} else {
+
+		log.Printf("error getting M-Pesa deposit %s %s ", in.TrxCode, err.Error())
+		return false, 500, "Unable to process deposit", nil
+	}
+
+	credited, err := completeDeposit(db, deposit, "COMPLETED")
+	if err != nil {
+
+		log.Printf("error crediting M-Pesa deposit %s %s ", in.TrxCode, err.Error())
+		return false, 500, "Unable to credit deposit", nil
+	}
+
+	if !credited {
+
+		return true, 200, "Transaction already processed", toStruct(deposit)
+	}
+
+	return true, 200, "Deposit credited", toStruct(deposit)
+}
+
+// StkWithdrawNotification applies a B2C result to the withdrawal identified by refId. A result with a
+// receipt (trxCode) means the player was paid, one without it means the payout failed
+func StkWithdrawNotification(db *sql.DB, in *pbWallet.StkTransactionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("M-Pesa withdrawal result %s receipt %s in client %d ", in.RefId, in.TrxCode, in.ClientId)
+
+	withdrawal, err := findMpesaWithdrawal(db, in.ClientId, in.RefId)
+	if err != nil {
+
+		log.Printf("error getting M-Pesa withdrawal %s %s ", in.RefId, err.Error())
+		return false, 404, "Withdrawal not found", nil
+	}
+
+	if err := applyMpesaWithdrawal(db, withdrawal, in.TrxCode); err != nil {
+
+		log.Printf("error applying M-Pesa withdrawal result %s %s ", in.RefId, err.Error())
+		return false, 500, "Unable to process withdrawal", nil
+	}
+
+	return true, 200, "Withdrawal processed", toStruct(withdrawal)
+}
+
+// StkStatusNotification applies the result of a transaction status query to the deposit or withdrawal it was made for
+func StkStatusNotification(db *sql.DB, in *pbWallet.StkTransactionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("M-Pesa status result %s receipt %s in client %d ", in.RefId, in.TrxCode, in.ClientId)
+
+	deposit, err := findMpesaDeposit(db, in.ClientId, in.RefId, in.TrxCode)
+	if err == nil {
+
+		if in.TrxCode == "" {
+
+			_, err = failDeposit(db, deposit, "FAILED")
+
+		} else {
+
+			_, err = completeDeposit(db, deposit, "COMPLETED")
+		}
+
+		if err != nil {
+
+			log.Printf("error applying M-Pesa deposit status %s %s ", in.RefId, err.Error())
+			return false, 500, "Unable to process deposit", nil
+		}
+
+		return true, 200, "Deposit processed", toStruct(deposit)
+	}
+
+	// only a result that is not for a deposit is for a withdrawal, anything else is retried
+	if err != sql.ErrNoRows {
+
+		log.Printf("error getting M-Pesa deposit %s %s ", in.RefId, err.Error())
+		return false, 500, "Unable to process status result", nil
+	}
+
+	return StkWithdrawNotification(db, in)
+}
+
+// StkRegisterUrl records a callback url registered with safaricom. Without a url it returns the
+// latest url registered for each action so they can be audited and registered again
+func StkRegisterUrl(db *sql.DB, in *pbWallet.StkRegisterUrlRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	if in.Url != "" {
+
+		if in.Action == "" {
+
+			return false, 400, "Action is required", nil
+		}
+
+		_, err := db.Exec("INSERT INTO mpesa_callback_urls (client_id, action, url, created_at) VALUES (?,?,?,NOW())", in.ClientId, in.Action, in.Url)
+		if err != nil {
+
+			log.Printf("error saving M-Pesa callback url for client %d %s ", in.ClientId, err.Error())
+			return false, 500, "Unable to save callback url", nil
+		}
+	}
+
+	rows, err := db.Query("SELECT u.id, u.client_id, u.action, u.url, u.created_at FROM mpesa_callback_urls u "+
+		" INNER JOIN (SELECT action, MAX(id) AS id FROM mpesa_callback_urls WHERE client_id = ? GROUP BY action) latest ON latest.id = u.id "+
+		" ORDER BY u.action", in.ClientId)
+
+	if err != nil {
+
+		log.Printf("error getting M-Pesa callback urls for client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to get callback urls", nil
+	}
+
+	defer rows.Close()
+
+	urls := map[string]interface{}{}
+	for rows.Next() {
+
+		var u models.MpesaCallbackUrl
+		if err := rows.Scan(&u.ID, &u.ClientID, &u.Action, &u.Url, &u.CreatedAt); err != nil {
+
+			log.Printf("error scanning M-Pesa callback url %s ", err.Error())
+			continue
+		}
+
+		urls[u.Action] = map[string]interface{}{"url": u.Url, "registeredAt": u.CreatedAt}
+	}
+
+	if in.Url != "" {
+
+		return true, 200, "Callback url saved", toStruct(urls)
+	}
+
+	return true, 200, "Callback urls fetched", toStruct(urls)
+}
+
+func applyMpesaWithdrawal(db *sql.DB, withdrawal *models.Withdrawal, receipt string) error {
+
+	if receipt == "" {
+
+		_, err := failWithdrawal(db, withdrawal, "FAILED", "M-Pesa payout failed")
+		return err
+	}
+
+	completed, err := completeWithdrawal(db, withdrawal, "COMPLETED")
+	if err != nil || !completed {
+
+		return err
+	}
+
+	_, err = db.Exec("UPDATE withdrawals SET provider = ?, provider_reference = ? WHERE id = ?", mpesaProvider, receipt, withdrawal.ID)
+	return err
+}
+
+// findMpesaWithdrawal finds a withdrawal of a client by the reference we sent with the B2C request.
+// Withdrawals routed to another provider are never matched
+func findMpesaWithdrawal(db *sql.DB, clientId int32, refId string) (*models.Withdrawal, error) {
+
+	withdrawal, err := scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE client_id = ? AND withdrawal_code = ? "+
+		" AND (provider IS NULL OR provider = ?)", clientId, refId, mpesaProvider))
+
+	if err == sql.ErrNoRows {
+
+		return scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE client_id = ? AND provider = ? AND provider_reference = ?",
+			clientId, mpesaProvider, refId))
+	}
+
+	return withdrawal, err
+}
+
+func findMpesaDeposit(db *sql.DB, clientId int32, refId, receipt string) (*models.Deposit, error) {
+
+	if receipt != "" {
+
+		deposit, err := scanDeposit(db.QueryRow("SELECT "+depositColumns+" FROM deposits WHERE client_id = ? AND provider = ? AND reference = ?",
+			clientId, mpesaProvider, receipt))
+
+		if err != sql.ErrNoRows {
+
+			return deposit, err
+		}
+	}
+
+	return scanDeposit(db.QueryRow("SELECT "+depositColumns+" FROM deposits WHERE client_id = ? AND provider = ? AND provider_reference = ?",
+		clientId, mpesaProvider, refId))
+}
+
+// findWalletByMsisdn matches a phone number in local or international format of the client's
+// markets to a player's username
+func findWalletByMsisdn(db *sql.DB, clientId int32, msisdn string, markets []mpesaCountry) (int64, string, error) {
+
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, msisdn)
+
+	if len(digits) < 9 {
+
+		return 0, "", fmt.Errorf("invalid msisdn %s", msisdn)
+	}
+
+	local := digits[len(digits)-9:]
+	candidates := []interface{}{clientId, digits, "+" + digits, "0" + local}
+
+	for _, m := range markets {
+
+		candidates = append(candidates, m.dialCode+local, "+"+m.dialCode+local)
+	}
+
+	rows, err := db.Query("SELECT DISTINCT user_id, username FROM wallets WHERE client_id = ? AND username IN (?"+
+		strings.Repeat(",?", len(candidates)-2)+")", candidates...)
+	if err != nil {
+
+		return 0, "", err
+	}
+
+	defer rows.Close()
+
+	var userId int64
+	var username string
+	var matches int
+
+	for rows.Next() {
+
+		if err := rows.Scan(&userId, &username); err != nil {
+
+			return 0, "", err
+		}
+
+		matches++
+	}
+
+	if matches == 0 {
+
+		return 0, "", sql.ErrNoRows
+	}
+
+	if matches > 1 {
+
+		return 0, "", fmt.Errorf("msisdn %s matches %d players", msisdn, matches)
+	}
+
+	return userId, username, nil
+}
@@ -0,0 +1,684 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/pawapay"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const pawapayProvider = "pawapay"
+
+// pawapayDoer replaces the http client of pawapay clients when set, tests point it at a stub
+var pawapayDoer pawapay.Doer
+
+func pawapayClient(db *sql.DB, clientId int32) (*pawapay.Client, error) {
+
+	pm, err := getPaymentMethod(db, clientId, pawapayProvider)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return newPawapayClient(pm), nil
+}
+
+// newPawapayClient returns a client for a decrypted pawapay payment method
+func newPawapayClient(pm *models.PaymentMethod) *pawapay.Client {
+
+	client := pawapay.NewClient(pm.BaseURL, pm.SecretKey)
+	if pawapayDoer != nil {
+
+		client.HTTPClient = pawapayDoer
+	}
+
+	return client
+}
+
+// CreatePawapay initiates a pawapay deposit or payout for a player. Players on mobile money clients
+// register with their phone number, so the wallet username is used as the MSISDN
+func CreatePawapay(db *sql.DB, in *pbWallet.CreatePawapayRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("Pawapay %s for user %d in client %d ", in.Action, in.UserId, in.ClientId)
+
+	if in.Amount <= 0 {
+
+		return false, 400, "Invalid amount", nil
+	}
+
+	currency := pawapay.Currency(in.Operator)
+	if currency == "" {
+
+		return false, 400, "Unsupported operator", nil
+	}
+
+	// money taken in a currency the client has not enabled could never be credited to a wallet
+	if _, err := resolveCurrency(db, in.ClientId, currency); err != nil {
+
+		log.Printf("error resolving currency %s of client %d %s", currency, in.ClientId, err.Error())
+		return false, 400, fmt.Sprintf("%s is not enabled for this client", currency), nil
+	}
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		log.Printf("error getting pawapay settings for client %d %s ", in.ClientId, err.Error())
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	if err := checkPaymentLimits(db, in.ClientId, pawapayProvider, float64(in.Amount)); err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	username, err := getWalletUsername(db, in.ClientId, in.UserId)
+	if err != nil {
+
+		log.Printf("error getting user wallet with id %d  %s", in.UserId, err.Error())
+		return false, 404, "User not found", nil
+	}
+
+	msisdn := strings.TrimPrefix(username, "+")
+
+	switch strings.ToLower(in.Action) {
+	case "deposit":
+		return createPawapayDeposit(db, client, in, username, msisdn, currency)
+	case "payout", "withdraw", "withdrawal":
+		return createPawapayPayout(db, client, in, username, msisdn, currency)
+	default:
+		return false, 400, "Invalid action", nil
+	}
+}
+
+func createPawapayDeposit(db *sql.DB, client *pawapay.Client, in *pbWallet.CreatePawapayRequest, username, msisdn, currency string) (bool, int32, string, *structpb.Struct) {
+
+	depositId := in.GetDepositId()
+	if depositId == "" {
+
+		depositId = pawapay.NewID()
+	}
+
+	deposit := &models.Deposit{
+		ClientID:  int64(in.ClientId),
+		UserID:    int64(in.UserId),
+		Username:  username,
+		Provider:  pawapayProvider,
+		Reference: depositId,
+		Amount:    float64(in.Amount),
+		Currency:  currency,
+		Msisdn:    msisdn,
+		Source:    in.Source,
+	}
+
+	if err := createDeposit(db, deposit); err != nil {
+
+		return false, 500, "Unable to save deposit", nil
+	}
+
+	res, err := client.InitiateDeposit(pawapay.DepositRequest{
+		DepositID:            depositId,
+		Amount:               fmt.Sprintf("%d", in.Amount),
+		Currency:             currency,
+		Correspondent:        in.Operator,
+		Payer:                pawapay.Party{Type: "MSISDN", Address: pawapay.Address{Value: msisdn}},
+		CustomerTimestamp:    pawapay.Timestamp(time.Now()),
+		StatementDescription: "Wallet deposit",
+	})
+
+	if err != nil {
+
+		log.Printf("error initiating pawapay deposit %s %s ", depositId, err.Error())
+		_, _ = failDeposit(db, deposit, "ERROR")
+		return false, 500, "Unable to initiate deposit", nil
+	}
+
+	if res.Status == pawapay.StatusRejected {
+
+		_, _ = failDeposit(db, deposit, res.Status)
+		return false, 400, rejectionMessage(res), nil
+	}
+
+	setDepositProviderStatus(db, deposit, res.Status)
+
+	return true, 200, "Deposit initiated", toStruct(map[string]interface{}{
+		"depositId": depositId,
+		"status":    res.Status,
+		"amount":    in.Amount,
+		"currency":  currency,
+	})
+}
+
+func createPawapayPayout(db *sql.DB, client *pawapay.Client, in *pbWallet.CreatePawapayRequest, username, msisdn, currency string) (bool, int32, string, *structpb.Struct) {
+
+	if err := validatePawapayPayout(client, msisdn, in.Operator, float64(in.Amount)); err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	payoutId := pawapay.NewID()
+
+	withdrawal := &models.Withdrawal{
+		ClientID:          int64(in.ClientId),
+		UserID:            int64(in.UserId),
+		Username:          username,
+		Amount:            float64(in.Amount),
+		Currency:          currency,
+		Country:           pawapay.CountryOf(in.Operator),
+		Msisdn:            msisdn,
+		Provider:          pawapayProvider,
+		ProviderReference: payoutId,
+		Source:            in.Source,
+	}
+
+	if status, message, err := createWithdrawal(db, withdrawal); err != nil {
+
+		return false, status, message, nil
+	}
+
+	res, err := client.InitiatePayout(pawapay.PayoutRequest{
+		PayoutID:             payoutId,
+		Amount:               fmt.Sprintf("%d", in.Amount),
+		Currency:             currency,
+		Correspondent:        in.Operator,
+		Recipient:            pawapay.Party{Type: "MSISDN", Address: pawapay.Address{Value: msisdn}},
+		CustomerTimestamp:    pawapay.Timestamp(time.Now()),
+		StatementDescription: "Wallet withdrawal",
+	})
+
+	if err != nil {
+
+		// the payout may still have reached pawapay, leave it pending for fetch to re-drive
+		log.Printf("error initiating pawapay payout %s %s ", payoutId, err.Error())
+		return false, 500, "Payout submitted but not confirmed", toStruct(map[string]interface{}{"payoutId": payoutId})
+	}
+
+	if res.Status == pawapay.StatusRejected {
+
+		if _, err := failWithdrawal(db, withdrawal, res.Status, rejectionMessage(res)); err != nil {
+
+			log.Printf("error failing pawapay payout %s %s ", payoutId, err.Error())
+		}
+
+		return false, 400, rejectionMessage(res), nil
+	}
+
+	setWithdrawalProviderStatus(db, withdrawal, res.Status)
+
+	return true, 200, "Payout initiated", toStruct(map[string]interface{}{
+		"payoutId":       payoutId,
+		"withdrawalCode": withdrawal.WithdrawalCode,
+		"status":         res.Status,
+		"amount":         in.Amount,
+		"currency":       currency,
+	})
+}
+
+// CreateBulkPawapay splits a withdrawal above the operator limit into one payout per amount. The player is
+// debited once for the total and each payout that fails is refunded on its own
+func CreateBulkPawapay(db *sql.DB, in *pbWallet.CreateBulkPawapayRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	log.Printf("Pawapay bulk payout of %d parts for user %d in client %d ", len(in.Amount), in.UserId, in.ClientId)
+
+	if len(in.Amount) == 0 {
+
+		return false, 400, "No payout amounts", nil
+	}
+
+	var total float64
+	parts := make([]float64, 0, len(in.Amount))
+
+	for _, amount := range in.Amount {
+
+		if amount <= 0 {
+
+			return false, 400, "Invalid amount", nil
+		}
+
+		total += float64(amount)
+		parts = append(parts, float64(amount))
+	}
+
+	currency := pawapay.Currency(in.Operator)
+	if currency == "" {
+
+		return false, 400, "Unsupported operator", nil
+	}
+
+	// money taken in a currency the client has not enabled could never be credited to a wallet
+	if _, err := resolveCurrency(db, in.ClientId, currency); err != nil {
+
+		log.Printf("error resolving currency %s of client %d %s", currency, in.ClientId, err.Error())
+		return false, 400, fmt.Sprintf("%s is not enabled for this client", currency), nil
+	}
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		log.Printf("error getting pawapay settings for client %d %s ", in.ClientId, err.Error())
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	if err := checkPaymentLimits(db, in.ClientId, pawapayProvider, parts...); err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	username, err := getWalletUsername(db, in.ClientId, in.UserId)
+	if err != nil {
+
+		log.Printf("error getting user wallet with id %d  %s", in.UserId, err.Error())
+		return false, 404, "User not found", nil
+	}
+
+	msisdn := strings.TrimPrefix(username, "+")
+
+	amounts := make([]float64, len(in.Amount))
+	for i, amount := range in.Amount {
+
+		amounts[i] = float64(amount)
+	}
+
+	if err := validatePawapayPayout(client, msisdn, in.Operator, amounts...); err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	withdrawal := &models.Withdrawal{
+		ClientID: int64(in.ClientId),
+		UserID:   int64(in.UserId),
+		Username: username,
+		Amount:   total,
+		Currency: currency,
+		Country:  pawapay.CountryOf(in.Operator),
+		Msisdn:   msisdn,
+		Provider: pawapayProvider,
+		Source:   in.Source,
+	}
+
+	if status, message, err := createWithdrawal(db, withdrawal); err != nil {
+
+		return false, status, message, nil
+	}
+
+	payouts := make([]*models.WithdrawalPayout, 0, len(in.Amount))
+	requests := make([]pawapay.PayoutRequest, 0, len(in.Amount))
+
+	for _, amount := range in.Amount {
+
+		payout := &models.WithdrawalPayout{
+			WithdrawalID: withdrawal.ID,
+			Provider:     pawapayProvider,
+			PayoutID:     pawapay.NewID(),
+			Amount:       float64(amount),
+		}
+
+		if err := createWithdrawalPayout(db, payout); err != nil {
+
+			// nothing has been sent yet, so the parts saved so far and the whole withdrawal can be reversed
+			abandonWithdrawalPayouts(db, withdrawal.ID, "Unable to save payouts")
+			_, _ = failWithdrawal(db, withdrawal, "ERROR", "Unable to save payouts")
+			return false, 500, "Unable to save payouts", nil
+		}
+
+		payouts = append(payouts, payout)
+		requests = append(requests, pawapay.PayoutRequest{
+			PayoutID:             payout.PayoutID,
+			Amount:               fmt.Sprintf("%d", amount),
+			Currency:             currency,
+			Correspondent:        in.Operator,
+			Recipient:            pawapay.Party{Type: "MSISDN", Address: pawapay.Address{Value: msisdn}},
+			CustomerTimestamp:    pawapay.Timestamp(time.Now()),
+			StatementDescription: "Wallet withdrawal",
+		})
+	}
+
+	responses, err := client.InitiateBulkPayout(requests)
+	if err != nil {
+
+		// the payouts may still have reached pawapay, leave them pending for fetch to re-drive
+		log.Printf("error initiating pawapay bulk payout %s %s ", withdrawal.WithdrawalCode, err.Error())
+		return false, 500, "Payouts submitted but not confirmed", toStructList(payouts)
+	}
+
+	byId := make(map[string]pawapay.InitiateResponse, len(responses))
+	for _, res := range responses {
+
+		byId[res.PayoutID] = res
+	}
+
+	results := make([]map[string]interface{}, 0, len(payouts))
+	accepted := 0
+
+	for _, payout := range payouts {
+
+		res, ok := byId[payout.PayoutID]
+		result := map[string]interface{}{
+			"withdrawalCode": withdrawal.WithdrawalCode,
+			"payoutId":       payout.PayoutID,
+			"amount":         payout.Amount,
+			"status":         res.Status,
+			"message":        "Payout initiated",
+		}
+
+		switch {
+		case !ok:
+			result["message"] = "Payout not confirmed"
+		case res.Status == pawapay.StatusRejected:
+			if _, err := failWithdrawalPayout(db, payout, res.Status, rejectionMessage(&res)); err != nil {
+
+				log.Printf("error failing pawapay payout %s %s ", payout.PayoutID, err.Error())
+			}
+
+			result["message"] = rejectionMessage(&res)
+		default:
+			accepted++
+			setWithdrawalPayoutProviderStatus(db, payout, res.Status)
+		}
+
+		results = append(results, result)
+	}
+
+	if accepted == 0 {
+
+		return false, 400, "Payouts rejected", toStructList(results)
+	}
+
+	return true, 200, fmt.Sprintf("%d of %d payouts initiated", accepted, len(payouts)), toStructList(results)
+}
+
+// PawapayCallback applies a deposit or payout callback to the matching record
+func PawapayCallback(db *sql.DB, in *pbWallet.PawapayRequest) (success bool, message string) {
+
+	log.Printf("Pawapay callback %s status %s ", in.DepositId, in.Status)
+
+	success, message = applyPawapayCallback(db, in)
+	logCallback(db, in.ClientId, pawapayProvider, in.DepositId, "callback", in.Status, "", message)
+
+	return success, message
+}
+
+// applyPawapayCallback never trusts the status in the callback. It finds the client the deposit or payout
+// belongs to and applies the status pawapay reports for it
+func applyPawapayCallback(db *sql.DB, in *pbWallet.PawapayRequest) (bool, string) {
+
+	clientId, isDeposit, err := pawapayOwner(db, in.DepositId)
+	if err == sql.ErrNoRows {
+
+		return false, "Transaction not found"
+	}
+
+	if err != nil {
+
+		log.Printf("error getting pawapay transaction %s %s ", in.DepositId, err.Error())
+		return false, "Unable to process callback"
+	}
+
+	client, err := pawapayClient(db, clientId)
+	if err != nil {
+
+		log.Printf("error getting pawapay settings for client %d %s ", clientId, err.Error())
+		return false, "Pawapay is not configured for this client"
+	}
+
+	var transactions []pawapay.Transaction
+	if isDeposit {
+
+		transactions, err = client.CheckDeposit(in.DepositId)
+	} else {
+
+		transactions, err = client.CheckPayout(in.DepositId)
+	}
+
+	if err != nil {
+
+		log.Printf("error verifying pawapay transaction %s %s ", in.DepositId, err.Error())
+		return false, "Unable to verify transaction"
+	}
+
+	if len(transactions) == 0 {
+
+		return false, "Transaction not found"
+	}
+
+	for _, trx := range transactions {
+
+		if trx.Status != in.Status {
+
+			log.Printf("pawapay callback %s says %s, pawapay reports %s ", in.DepositId, in.Status, trx.Status)
+		}
+
+		if err := redrivePawapay(db, trx); err != nil {
+
+			log.Printf("error applying pawapay callback %s %s ", in.DepositId, err.Error())
+			return false, "Unable to process transaction"
+		}
+	}
+
+	if isDeposit {
+
+		return true, "Deposit processed"
+	}
+
+	return true, "Payout processed"
+}
+
+// pawapayOwner returns the client of a pawapay deposit or payout id and whether it is a deposit
+func pawapayOwner(db *sql.DB, id string) (int32, bool, error) {
+
+	if deposit, err := getDepositByReference(db, pawapayProvider, id); err == nil {
+
+		return int32(deposit.ClientID), true, nil
+	} else if err != sql.ErrNoRows {
+
+		return 0, false, err
+	}
+
+	withdrawal, err := getWithdrawalByProviderReference(db, pawapayProvider, id)
+	if err == sql.ErrNoRows {
+
+		payout, err := getWithdrawalPayout(db, pawapayProvider, id)
+		if err != nil {
+
+			return 0, false, err
+		}
+
+		withdrawal, err = getWithdrawalByID(db, payout.WithdrawalID)
+		if err != nil {
+
+			return 0, false, err
+		}
+	} else if err != nil {
+
+		return 0, false, err
+	}
+
+	return int32(withdrawal.ClientID), false, nil
+}
+
+// pawapayOutcome maps a pawapay status onto our record statuses. Anything not final leaves the
+// record pending
+func pawapayOutcome(status string) int64 {
+
+	switch status {
+	case pawapay.StatusCompleted:
+		return models.StatusCompleted
+	case pawapay.StatusFailed, pawapay.StatusRejected:
+		return models.StatusFailed
+	default:
+		return models.StatusPending
+	}
+}
+
+func applyPawapayDeposit(db *sql.DB, deposit *models.Deposit, status string) error {
+
+	var err error
+
+	switch pawapayOutcome(status) {
+	case models.StatusCompleted:
+		_, err = completeDeposit(db, deposit, status)
+	case models.StatusFailed:
+		_, err = failDeposit(db, deposit, status)
+	default:
+		setDepositProviderStatus(db, deposit, status)
+	}
+
+	return err
+}
+
+// applyPawapayPayout applies a payout status to the withdrawal it belongs to, or to the part of a
+// split withdrawal when the payout was created in bulk
+func applyPawapayPayout(db *sql.DB, payoutId, status, reason string) error {
+
+	withdrawal, err := getWithdrawalByProviderReference(db, pawapayProvider, payoutId)
+	if err == sql.ErrNoRows {
+
+		payout, err := getWithdrawalPayout(db, pawapayProvider, payoutId)
+		if err != nil {
+
+			return err
+		}
+
+		return applyPawapayBulkPayout(db, payout, status, reason)
+	}
+
+	if err != nil {
+
+		return err
+	}
+
+	switch pawapayOutcome(status) {
+	case models.StatusCompleted:
+		_, err = completeWithdrawal(db, withdrawal, status)
+	case models.StatusFailed:
+		_, err = failWithdrawal(db, withdrawal, status, reason)
+	default:
+		setWithdrawalProviderStatus(db, withdrawal, status)
+	}
+
+	return err
+}
+
+func applyPawapayBulkPayout(db *sql.DB, payout *models.WithdrawalPayout, status, reason string) error {
+
+	var err error
+
+	switch pawapayOutcome(status) {
+	case models.StatusCompleted:
+		_, err = completeWithdrawalPayout(db, payout, status)
+	case models.StatusFailed:
+		_, err = failWithdrawalPayout(db, payout, status, reason)
+	default:
+		setWithdrawalPayoutProviderStatus(db, payout, status)
+	}
+
+	return err
+}
+
+// FetchPawapay returns the pawapay view of a deposit or payout and applies any final status we missed
+func FetchPawapay(db *sql.DB, in *pbWallet.FetchPawapayRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	var transactions []pawapay.Transaction
+
+	switch strings.ToLower(in.Action) {
+	case "deposit", "deposits":
+		transactions, err = client.CheckDeposit(in.ActionId)
+	case "payout", "payouts":
+		transactions, err = client.CheckPayout(in.ActionId)
+	default:
+		return false, 400, "Invalid action", nil
+	}
+
+	if err != nil {
+
+		log.Printf("error fetching pawapay %s %s %s ", in.Action, in.ActionId, err.Error())
+		return false, 500, "Unable to fetch transaction", nil
+	}
+
+	for _, trx := range transactions {
+
+		if err := redrivePawapay(db, trx); err != nil {
+
+			log.Printf("error re-driving pawapay transaction %s %s ", trx.ID(), err.Error())
+		}
+	}
+
+	return true, 200, "Transaction fetched", toStructList(transactions)
+}
+
+func redrivePawapay(db *sql.DB, trx pawapay.Transaction) error {
+
+	if trx.DepositID != "" {
+
+		deposit, err := getDepositByReference(db, pawapayProvider, trx.DepositID)
+		if err != nil {
+
+			return err
+		}
+
+		return applyPawapayDeposit(db, deposit, trx.Status)
+	}
+
+	var reason string
+	if trx.FailureReason != nil {
+
+		reason = trx.FailureReason.Message
+	}
+
+	return applyPawapayPayout(db, trx.PayoutID, trx.Status, reason)
+}
+
+// ResendPawapayCallback asks pawapay to send the callback of a deposit or payout again
+func ResendPawapayCallback(db *sql.DB, in *pbWallet.FetchPawapayRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	var res *pawapay.InitiateResponse
+
+	switch strings.ToLower(in.Action) {
+	case "deposit", "deposits":
+		res, err = client.ResendDepositCallback(in.ActionId)
+	case "payout", "payouts":
+		res, err = client.ResendPayoutCallback(in.ActionId)
+	default:
+		return false, 400, "Invalid action", nil
+	}
+
+	if err != nil {
+
+		log.Printf("error resending pawapay callback %s %s %s ", in.Action, in.ActionId, err.Error())
+		return false, 500, "Unable to resend callback", nil
+	}
+
+	if res.Status != pawapay.StatusAccepted {
+
+		return false, 400, rejectionMessage(res), toStruct(res)
+	}
+
+	return true, 200, "Callback resent", toStruct(res)
+}
+
+func rejectionMessage(res *pawapay.InitiateResponse) string {
+
+	if res.RejectionReason != nil && res.RejectionReason.Message != "" {
+
+		return res.RejectionReason.Message
+	}
+
+	return fmt.Sprintf("Request %s", strings.ToLower(res.Status))
+}
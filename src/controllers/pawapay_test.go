@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"net/http"
+	"strings"
+	"testing"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/pawapay"
+)
+
+func TestPawapayOutcome(t *testing.T) {
+
+	cases := map[string]int64{
+		pawapay.StatusCompleted:        models.StatusCompleted,
+		pawapay.StatusFailed:           models.StatusFailed,
+		pawapay.StatusRejected:         models.StatusFailed,
+		pawapay.StatusAccepted:         models.StatusPending,
+		pawapay.StatusSubmitted:        models.StatusPending,
+		pawapay.StatusEnqueued:         models.StatusPending,
+		pawapay.StatusDuplicateIgnored: models.StatusPending,
+		"":                             models.StatusPending,
+	}
+
+	for status, want := range cases {
+
+		if got := pawapayOutcome(status); got != want {
+
+			t.Errorf("pawapayOutcome(%q) = %d, want %d", status, got, want)
+		}
+	}
+}
+
+var (
+	paymentMethodFields = strings.Split(strings.ReplaceAll(paymentMethodColumns, " ", ""), ",")
+	depositFields       = strings.Split(strings.ReplaceAll(depositColumns, " ", ""), ",")
+	withdrawalFields    = strings.Split(strings.ReplaceAll(withdrawalColumns, " ", ""), ",")
+)
+
+// pawapayCallbackDB returns a database holding the pawapay settings of client 1 and a stub answering
+// for pawapay. The base URL is unique per test so cached responses never leak between tests
+func pawapayCallbackDB(t *testing.T) (*sql.DB, *fakeDB, *pawapay.StubDoer) {
+
+	db, fake := newFakeDB(t)
+
+	fake.On("FROM payment_methods", paymentMethodFields, []driver.Value{
+		int64(1), int64(1), "Pawapay", pawapayProvider, "token", nil, nil, "https://" + t.Name() + ".stub", int64(1), int64(1),
+		0.0, 0.0, int64(0), nil, nil,
+	})
+
+	stub := pawapay.NewStubDoer()
+	pawapayDoer = stub
+	t.Cleanup(func() { pawapayDoer = nil })
+
+	return db, fake, stub
+}
+
+func pendingDeposit(reference string) []driver.Value {
+
+	return []driver.Value{
+		int64(7), int64(1), int64(9), "player", pawapayProvider, reference, reference, 100.0, "UGX", "256700000000", "mobile",
+		int64(models.StatusPending), "ACCEPTED", nil, "2024-01-01 00:00:00", "2024-01-01 00:00:00",
+	}
+}
+
+func pendingWithdrawal(reference string) []driver.Value {
+
+	return []driver.Value{
+		int64(8), int64(1), int64(9), "player", "W-1", 100.0, "UGX", nil, nil, nil, nil,
+		nil, "256700000000", pawapayProvider, reference, "mobile", int64(models.StatusPending), "ACCEPTED", nil, nil,
+		"2024-01-01 00:00:00", "2024-01-01 00:00:00",
+	}
+}
+
+func TestPawapayCallbackUsesProviderStatus(t *testing.T) {
+
+	db, fake, stub := pawapayCallbackDB(t)
+	fake.On("FROM deposits WHERE provider = ? AND reference = ?", depositFields, pendingDeposit("d-1"))
+	stub.On(http.MethodGet, "/deposits/d-1", 200, `[{"depositId":"d-1","status":"FAILED","currency":"UGX"}]`)
+
+	ok, message := applyPawapayCallback(db, &pbWallet.PawapayRequest{DepositId: "d-1", Status: pawapay.StatusCompleted})
+	if !ok {
+
+		t.Fatalf("unexpected failure %s", message)
+	}
+
+	updates := fake.Ran("UPDATE deposits SET status = ?")
+	if len(updates) != 1 || updates[0].args[0] != int64(models.StatusFailed) {
+
+		t.Fatalf("expected the deposit to be failed, got %+v", updates)
+	}
+
+	if credits := fake.Ran("INSERT INTO transactions"); len(credits) != 0 {
+
+		t.Fatalf("expected no credit, got %+v", credits)
+	}
+}
+
+func TestPawapayCallbackUnknownTransaction(t *testing.T) {
+
+	db, _, stub := pawapayCallbackDB(t)
+
+	ok, message := applyPawapayCallback(db, &pbWallet.PawapayRequest{DepositId: "d-1", Status: pawapay.StatusCompleted})
+	if ok || message != "Transaction not found" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	if len(stub.Requests) != 0 {
+
+		t.Fatalf("expected no call to pawapay, got %d", len(stub.Requests))
+	}
+}
+
+func TestPawapayCallbackNotAtProvider(t *testing.T) {
+
+	db, fake, stub := pawapayCallbackDB(t)
+	fake.On("FROM deposits WHERE provider = ? AND reference = ?", depositFields, pendingDeposit("d-1"))
+	stub.On(http.MethodGet, "/deposits/d-1", 200, `[]`)
+
+	ok, message := applyPawapayCallback(db, &pbWallet.PawapayRequest{DepositId: "d-1", Status: pawapay.StatusCompleted})
+	if ok || message != "Transaction not found" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	if updates := fake.Ran("UPDATE deposits"); len(updates) != 0 {
+
+		t.Fatalf("expected the deposit untouched, got %+v", updates)
+	}
+}
+
+func TestPawapayPayoutCallback(t *testing.T) {
+
+	db, fake, stub := pawapayCallbackDB(t)
+	fake.On("FROM withdrawals WHERE provider = ? AND provider_reference = ?", withdrawalFields, pendingWithdrawal("p-1"))
+	stub.On(http.MethodGet, "/payouts/p-1", 200, `[{"payoutId":"p-1","status":"COMPLETED","currency":"UGX"}]`)
+
+	ok, message := applyPawapayCallback(db, &pbWallet.PawapayRequest{DepositId: "p-1", Status: pawapay.StatusCompleted})
+	if !ok || message != "Payout processed" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	updates := fake.Ran("UPDATE withdrawals SET status = ?")
+	if len(updates) != 1 || updates[0].args[0] != int64(models.StatusCompleted) {
+
+		t.Fatalf("expected the withdrawal to be completed, got %+v", updates)
+	}
+}
+
+func TestPawapayPayoutStaysPending(t *testing.T) {
+
+	db, fake, stub := pawapayCallbackDB(t)
+	fake.On("FROM withdrawals WHERE provider = ? AND provider_reference = ?", withdrawalFields, pendingWithdrawal("p-1"))
+	stub.On(http.MethodGet, "/payouts/p-1", 200, `[{"payoutId":"p-1","status":"SUBMITTED","currency":"UGX"}]`)
+
+	ok, message := applyPawapayCallback(db, &pbWallet.PawapayRequest{DepositId: "p-1", Status: pawapay.StatusFailed})
+	if !ok {
+
+		t.Fatalf("unexpected failure %s", message)
+	}
+
+	if updates := fake.Ran("UPDATE withdrawals SET status = ?"); len(updates) != 0 {
+
+		t.Fatalf("expected the withdrawal to stay pending, got %+v", updates)
+	}
+
+	updates := fake.Ran("UPDATE withdrawals SET provider_status = ?")
+	if len(updates) != 1 || updates[0].args[0] != pawapay.StatusSubmitted {
+
+		t.Fatalf("expected the provider status to be recorded, got %+v", updates)
+	}
+}
+
+// openWallet gives every player an open main wallet holding a balance, so credits and debits go through
+func openWallet(fake *fakeDB, balance float64) {
+
+	fake.On(walletColumns+" FROM wallets WHERE", strings.Split(strings.ReplaceAll(walletColumns, " ", ""), ","),
+		[]driver.Value{balance, balance, 0.0, 0.0, 0.0, 0.0, "", int64(1)})
+
+	fake.On("SELECT status FROM wallets", []string{"status"}, []driver.Value{int64(1)})
+
+	fake.On("FROM wallet_types", []string{"id", "client_id", "name", "title", "balance_column", "withdrawable", "bonus", "can_go_negative", "status"},
+		[]driver.Value{int64(1), int64(0), models.MainWallet, "Main", "available_balance", true, false, false, int64(1)})
+}
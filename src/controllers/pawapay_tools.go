@@ -0,0 +1,194 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/providers/pawapay"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PawapayBalances returns the float held with pawapay across all countries, or in one when a country is given
+func PawapayBalances(db *sql.DB, in *pbWallet.PawapayCountryRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	var balances []pawapay.Balance
+
+	if in.Country != "" {
+
+		balances, err = client.CountryBalances(strings.ToUpper(in.Country))
+
+	} else {
+
+		balances, err = client.Balances()
+	}
+
+	if err != nil {
+
+		log.Printf("error fetching pawapay balances for client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch balances", nil
+	}
+
+	return true, 200, "Balances fetched", toStructList(balances)
+}
+
+// PawapayActiveConf returns the correspondents that are live for the client, optionally for one country
+func PawapayActiveConf(db *sql.DB, in *pbWallet.PawapayCountryRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	conf, err := client.ActiveConf()
+	if err != nil {
+
+		log.Printf("error fetching pawapay active configuration for client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch active configuration", nil
+	}
+
+	if in.Country != "" {
+
+		filtered := *conf
+		filtered.Countries = nil
+
+		for _, country := range conf.Countries {
+
+			if strings.EqualFold(country.Country, in.Country) {
+
+				filtered.Countries = append(filtered.Countries, country)
+			}
+		}
+
+		conf = &filtered
+	}
+
+	return true, 200, "Active configuration fetched", toStruct(conf)
+}
+
+// PawapayPredictCorrespondent returns the operator a phone number belongs to
+func PawapayPredictCorrespondent(db *sql.DB, in *pbWallet.PawapayPredCorrRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	prediction, err := client.PredictCorrespondent(strings.TrimPrefix(in.PhoneNumber, "+"))
+	if err != nil {
+
+		log.Printf("error predicting correspondent for %s %s ", in.PhoneNumber, err.Error())
+		return false, 500, "Unable to predict correspondent", nil
+	}
+
+	return true, 200, "Correspondent predicted", toStruct(prediction)
+}
+
+// PawapayToolkit serves the pawapay lookups used by operations by action name
+func PawapayToolkit(db *sql.DB, in *pbWallet.PawapayToolkitRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	client, err := pawapayClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Pawapay is not configured for this client", nil
+	}
+
+	switch strings.ToLower(in.Action) {
+	case "availability":
+		countries, err := client.Availability()
+		if err != nil {
+
+			log.Printf("error fetching pawapay availability %s ", err.Error())
+			return false, 500, "Unable to fetch availability", nil
+		}
+
+		return true, 200, "Availability fetched", toStructList(countries)
+
+	case "active-conf", "active_conf":
+		conf, err := client.ActiveConf()
+		if err != nil {
+
+			log.Printf("error fetching pawapay active configuration %s ", err.Error())
+			return false, 500, "Unable to fetch active configuration", nil
+		}
+
+		return true, 200, "Active configuration fetched", toStructList(conf.Countries)
+
+	case "balances", "wallet-balances":
+		balances, err := client.Balances()
+		if err != nil {
+
+			log.Printf("error fetching pawapay balances %s ", err.Error())
+			return false, 500, "Unable to fetch balances", nil
+		}
+
+		return true, 200, "Balances fetched", toStructList(balances)
+
+	default:
+		return false, 400, "Invalid action", nil
+	}
+}
+
+// validatePawapayPayout rejects payouts pawapay would refuse: a phone number on another operator, an
+// operator that is not live for payouts, or amounts outside its limits. Lookups that fail do not block
+// the payout, pawapay still validates it
+func validatePawapayPayout(client *pawapay.Client, msisdn, operator string, amounts ...float64) error {
+
+	prediction, err := client.PredictCorrespondent(msisdn)
+	if err != nil {
+
+		log.Printf("error predicting correspondent for %s %s ", msisdn, err.Error())
+
+	} else if prediction.Correspondent != "" && prediction.Correspondent != operator {
+
+		return fmt.Errorf("Phone number belongs to %s, not %s", prediction.Correspondent, operator)
+	}
+
+	conf, err := client.ActiveConf()
+	if err != nil {
+
+		log.Printf("error fetching pawapay active configuration %s ", err.Error())
+		return nil
+	}
+
+	correspondent, ok := conf.Correspondent(operator)
+	if !ok {
+
+		return fmt.Errorf("Operator %s is not supported", operator)
+	}
+
+	limits, ok := correspondent.Operation("PAYOUT")
+	if !ok {
+
+		return fmt.Errorf("Operator %s does not support payouts", operator)
+	}
+
+	minLimit, _ := strconv.ParseFloat(limits.MinTransactionLimit, 64)
+	maxLimit, _ := strconv.ParseFloat(limits.MaxTransactionLimit, 64)
+
+	for _, amount := range amounts {
+
+		if minLimit > 0 && amount < minLimit {
+
+			return fmt.Errorf("Amount is below the operator minimum of %s", limits.MinTransactionLimit)
+		}
+
+		if maxLimit > 0 && amount > maxLimit {
+
+			return fmt.Errorf("Amount exceeds the operator limit of %s", limits.MaxTransactionLimit)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/zoroplay/go-wallet-service/encryption"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const paymentMethodColumns = "id, client_id, title, provider, secret_key, public_key, merchant_id, base_url, status, for_disbursement, " +
+	"min_amount, max_amount, display_order, country, bank_codes"
+
+func scanPaymentMethod(row rowScanner) (*models.PaymentMethod, error) {
+
+	var pm models.PaymentMethod
+	var secretKey, publicKey, merchantId, baseUrl, country, bankCodes sql.NullString
+
+	err := row.Scan(&pm.ID, &pm.ClientID, &pm.Title, &pm.Provider, &secretKey, &publicKey, &merchantId, &baseUrl, &pm.Status, &pm.ForDisbursement,
+		&pm.MinAmount, &pm.MaxAmount, &pm.DisplayOrder, &country, &bankCodes)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	pm.SecretKey = secretKey.String
+	pm.PublicKey = publicKey.String
+	pm.MerchantID = merchantId.String
+	pm.BaseURL = baseUrl.String
+	pm.Country = country.String
+	pm.BankCodes = bankCodes.String
+
+	return &pm, nil
+}
+
+// getPaymentMethod returns the active configuration of a provider for a client with its secret decrypted
+func getPaymentMethod(db *sql.DB, clientId int32, provider string) (*models.PaymentMethod, error) {
+
+	pm, err := scanPaymentMethod(db.QueryRow("SELECT "+paymentMethodColumns+" FROM payment_methods WHERE client_id = ? AND provider = ? AND status = 1 "+
+		" ORDER BY display_order, id DESC LIMIT 1", clientId, provider))
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	if pm.SecretKey != "" && !encryption.IsEncrypted(pm.SecretKey) {
+
+		encryptPaymentMethodSecret(db, pm)
+	}
+
+	pm, err = decryptPaymentMethod(pm)
+	if err != nil {
+
+		log.Printf("error decrypting %s secret for client %d %s ", provider, clientId, err.Error())
+		return nil, err
+	}
+
+	return pm, nil
+}
+
+// encryptPaymentMethodSecret encrypts a secret saved before secrets were encrypted, the first time it is read
+func encryptPaymentMethodSecret(db *sql.DB, pm *models.PaymentMethod) {
+
+	encrypted, err := encryption.Encrypt(pm.SecretKey)
+	if err != nil {
+
+		log.Printf("error encrypting secret of payment method %d %s ", pm.ID, err.Error())
+		return
+	}
+
+	_, err = db.Exec("UPDATE payment_methods SET secret_key = ? WHERE id = ? AND secret_key = ?", encrypted, pm.ID, pm.SecretKey)
+	if err != nil {
+
+		log.Printf("error saving encrypted secret of payment method %d %s ", pm.ID, err.Error())
+	}
+}
+
+// decryptPaymentMethod returns a copy of a payment method with its secret decrypted
+func decryptPaymentMethod(pm *models.PaymentMethod) (*models.PaymentMethod, error) {
+
+	decrypted := *pm
+
+	secretKey, err := encryption.Decrypt(pm.SecretKey)
+	if err != nil {
+
+		return nil, err
+	}
+
+	decrypted.SecretKey = secretKey
+	return &decrypted, nil
+}
+
+// checkPaymentLimits checks an amount against the min and max configured on a client's payment method
+func checkPaymentLimits(db *sql.DB, clientId int32, provider string, amounts ...float64) error {
+
+	var minAmount, maxAmount float64
+
+	err := db.QueryRow("SELECT min_amount, max_amount FROM payment_methods WHERE client_id = ? AND provider = ? AND status = 1 "+
+		" ORDER BY display_order, id DESC LIMIT 1", clientId, provider).Scan(&minAmount, &maxAmount)
+
+	if err != nil {
+
+		return err
+	}
+
+	for _, amount := range amounts {
+
+		if minAmount > 0 && amount < minAmount {
+
+			return fmt.Errorf("Minimum amount is %.2f", minAmount)
+		}
+
+		if maxAmount > 0 && amount > maxAmount {
+
+			return fmt.Errorf("Maximum amount is %.2f", maxAmount)
+		}
+	}
+
+	return nil
+}
+
+// toPaymentMethod converts a payment method for callers. Secrets never leave the service
+func toPaymentMethod(pm *models.PaymentMethod) *pbWallet.PaymentMethod {
+
+	return &pbWallet.PaymentMethod{
+		Id:              int32(pm.ID),
+		Title:           pm.Title,
+		Provider:        pm.Provider,
+		PublicKey:       pm.PublicKey,
+		MerchantId:      pm.MerchantID,
+		BaseUrl:         pm.BaseURL,
+		Status:          int32(pm.Status),
+		ForDisbursement: int32(pm.ForDisbursement),
+		MinAmount:       pm.MinAmount,
+		MaxAmount:       pm.MaxAmount,
+		DisplayOrder:    int32(pm.DisplayOrder),
+		Country:         pm.Country,
+		BankCodes:       pm.BankCodes,
+	}
+}
+
+// SavePaymentMethod creates a payment method, or updates it when an id is given. The secret key is
+// encrypted before it is saved. On update every field that is not sent keeps its value, blank title,
+// provider and secret key count as not sent
+func SavePaymentMethod(db *sql.DB, in *pbWallet.PaymentMethodRequest) (success bool, status int32, message string, data *pbWallet.PaymentMethod) {
+
+	log.Printf("Saving %s payment method for client %d ", in.Provider, in.ClientId)
+
+	if in.Id == 0 && (in.Provider == "" || in.Title == "") {
+
+		return false, 400, "Title and provider are required", nil
+	}
+
+	if in.GetMinAmount() < 0 || in.GetMaxAmount() < 0 || (in.GetMaxAmount() > 0 && in.GetMinAmount() > in.GetMaxAmount()) {
+
+		return false, 400, "Invalid amount limits", nil
+	}
+
+	secretKey, err := encryption.Encrypt(in.SecretKey)
+	if err != nil {
+
+		log.Printf("error encrypting payment method secret %s ", err.Error())
+		return false, 500, "Unable to save payment method", nil
+	}
+
+	id := int64(in.Id)
+
+	if id == 0 {
+
+		res, err := db.Exec("INSERT INTO payment_methods (client_id, title, provider, secret_key, public_key, merchant_id, base_url, status, for_disbursement, "+
+			" min_amount, max_amount, display_order, country, bank_codes, created_at) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,NOW())",
+			in.ClientId, in.Title, strings.ToLower(in.Provider), nullString(secretKey), nullString(in.GetPublicKey()), nullString(in.GetMerchantId()),
+			nullString(in.GetBaseUrl()), in.GetStatus(), in.GetForDisbursement(), in.GetMinAmount(), in.GetMaxAmount(), in.GetDisplayOrder(),
+			nullString(strings.ToUpper(in.GetCountry())), nullString(in.GetBankCodes()))
+
+		if err != nil {
+
+			log.Printf("error saving payment method %s ", err.Error())
+			return false, 500, "Unable to save payment method", nil
+		}
+
+		id, _ = res.LastInsertId()
+
+	} else {
+
+		existing, err := scanPaymentMethod(db.QueryRow("SELECT "+paymentMethodColumns+" FROM payment_methods WHERE id = ? AND client_id = ?", id, in.ClientId))
+		if err != nil {
+
+			return false, 404, "Payment method not found", nil
+		}
+
+		if secretKey == "" {
+
+			secretKey = existing.SecretKey
+		}
+
+		title, provider := in.Title, strings.ToLower(in.Provider)
+		if title == "" {
+
+			title = existing.Title
+		}
+
+		if provider == "" {
+
+			provider = existing.Provider
+		}
+
+		publicKey, merchantId, baseUrl := existing.PublicKey, existing.MerchantID, existing.BaseURL
+		if in.PublicKey != nil {
+
+			publicKey = in.GetPublicKey()
+		}
+
+		if in.MerchantId != nil {
+
+			merchantId = in.GetMerchantId()
+		}
+
+		if in.BaseUrl != nil {
+
+			baseUrl = in.GetBaseUrl()
+		}
+
+		methodStatus, forDisbursement := existing.Status, existing.ForDisbursement
+		if in.Status != nil {
+
+			methodStatus = int64(in.GetStatus())
+		}
+
+		if in.ForDisbursement != nil {
+
+			forDisbursement = int64(in.GetForDisbursement())
+		}
+
+		minAmount, maxAmount, displayOrder := existing.MinAmount, existing.MaxAmount, existing.DisplayOrder
+		if in.MinAmount != nil {
+
+			minAmount = in.GetMinAmount()
+		}
+
+		if in.MaxAmount != nil {
+
+			maxAmount = in.GetMaxAmount()
+		}
+
+		if in.DisplayOrder != nil {
+
+			displayOrder = int64(in.GetDisplayOrder())
+		}
+
+		country, bankCodes := existing.Country, existing.BankCodes
+		if in.Country != nil {
+
+			country = strings.ToUpper(in.GetCountry())
+		}
+
+		if in.BankCodes != nil {
+
+			bankCodes = in.GetBankCodes()
+		}
+
+		_, err = db.Exec("UPDATE payment_methods SET title = ?, provider = ?, secret_key = ?, public_key = ?, merchant_id = ?, base_url = ?, status = ?, "+
+			" for_disbursement = ?, min_amount = ?, max_amount = ?, display_order = ?, country = ?, bank_codes = ? WHERE id = ? AND client_id = ?",
+			title, provider, nullString(secretKey), nullString(publicKey), nullString(merchantId), nullString(baseUrl), methodStatus,
+			forDisbursement, minAmount, maxAmount, displayOrder, nullString(country), nullString(bankCodes), id, in.ClientId)
+
+		if err != nil {
+
+			log.Printf("error updating payment method %d %s ", id, err.Error())
+			return false, 500, "Unable to save payment method", nil
+		}
+	}
+
+	pm, err := scanPaymentMethod(db.QueryRow("SELECT "+paymentMethodColumns+" FROM payment_methods WHERE id = ?", id))
+	if err != nil {
+
+		return false, 500, "Unable to fetch payment method", nil
+	}
+
+	return true, 200, "Payment method saved", toPaymentMethod(pm)
+}
+
+// GetPaymentMethods lists a client's payment methods in display order, without their secrets
+func GetPaymentMethods(db *sql.DB, in *pbWallet.GetPaymentMethodRequest) (success bool, status int32, message string, data []*pbWallet.PaymentMethod) {
+
+	query := "SELECT " + paymentMethodColumns + " FROM payment_methods WHERE client_id = ?"
+	args := []interface{}{in.ClientId}
+
+	if in.Status != nil {
+
+		query += " AND status = ?"
+		args = append(args, in.GetStatus())
+	}
+
+	if in.ForDisbursement != nil {
+
+		query += " AND for_disbursement = ?"
+		args = append(args, in.GetForDisbursement())
+	}
+
+	rows, err := db.Query(query+" ORDER BY display_order, id", args...)
+	if err != nil {
+
+		log.Printf("error getting payment methods for client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch payment methods", nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		pm, err := scanPaymentMethod(rows)
+		if err != nil {
+
+			log.Printf("error scanning payment method %s ", err.Error())
+			continue
+		}
+
+		data = append(data, toPaymentMethod(pm))
+	}
+
+	return true, 200, "Payment methods fetched", data
+}
+
+// DeletePaymentMethod removes a client's payment method
+func DeletePaymentMethod(db *sql.DB, in *pbWallet.PaymentMethodRequest) (success bool, status int32, message string, data *pbWallet.PaymentMethod) {
+
+	pm, err := scanPaymentMethod(db.QueryRow("SELECT "+paymentMethodColumns+" FROM payment_methods WHERE id = ? AND client_id = ?", in.Id, in.ClientId))
+	if err != nil {
+
+		return false, 404, "Payment method not found", nil
+	}
+
+	_, err = db.Exec("DELETE FROM payment_methods WHERE id = ? AND client_id = ?", in.Id, in.ClientId)
+	if err != nil {
+
+		log.Printf("error deleting payment method %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to delete payment method", nil
+	}
+
+	return true, 200, "Payment method deleted", toPaymentMethod(pm)
+}
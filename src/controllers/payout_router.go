@@ -0,0 +1,451 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// provider health is judged on the payout attempts of the last healthWindow minutes. A provider with
+// at least healthMinAttempts attempts and a success rate under healthMinRate is routed around
+const (
+	healthWindow      = 60
+	healthMinAttempts = 5
+	healthMinRate     = 0.5
+)
+
+// payout attempt statuses
+const (
+	attemptAccepted    = "ACCEPTED"
+	attemptRejected    = "REJECTED"
+	attemptUnconfirmed = "UNCONFIRMED"
+	attemptCompleted   = "COMPLETED"
+	attemptFailed      = "FAILED"
+)
+
+// routingFailed is the provider status of a withdrawal no provider accepted, routing that of one being
+// routed, which no other request may route or reject meanwhile
+const (
+	routingFailed = "ROUTING_FAILED"
+	routing       = "ROUTING"
+)
+
+type providerHealth struct {
+	Attempts    int64
+	SuccessRate float64
+	LatencyMs   float64
+}
+
+func (h providerHealth) healthy() bool {
+
+	return h.Attempts < healthMinAttempts || h.SuccessRate >= healthMinRate
+}
+
+// getProviderHealth returns the recent success rate and latency of each provider a client pays out through
+func getProviderHealth(db *sql.DB, clientId int64) map[string]providerHealth {
+
+	health := map[string]providerHealth{}
+
+	rows, err := db.Query("SELECT provider, COUNT(*), AVG(status IN (?, ?)), AVG(latency_ms) FROM payout_attempts "+
+		" WHERE client_id = ? AND created_at > NOW() - INTERVAL ? MINUTE GROUP BY provider",
+		attemptAccepted, attemptCompleted, clientId, healthWindow)
+
+	if err != nil {
+
+		log.Printf("error getting payout provider health for client %d %s ", clientId, err.Error())
+		return health
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		var provider string
+		var h providerHealth
+
+		if err := rows.Scan(&provider, &h.Attempts, &h.SuccessRate, &h.LatencyMs); err != nil {
+
+			log.Printf("error scanning payout provider health %s ", err.Error())
+			continue
+		}
+
+		health[provider] = h
+	}
+
+	return health
+}
+
+// payoutCandidates returns the client's disbursement methods that can pay a withdrawal, best first:
+// healthy providers before failing ones, then by display order, success rate and latency
+func payoutCandidates(db *sql.DB, w *models.Withdrawal) ([]*models.PaymentMethod, error) {
+
+	kind := payoutBank
+	if w.BankCode == "" {
+
+		kind = payoutMobile
+	}
+
+	rows, err := db.Query("SELECT "+paymentMethodColumns+" FROM payment_methods WHERE client_id = ? AND status = 1 AND for_disbursement = 1",
+		w.ClientID)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var candidates []*models.PaymentMethod
+
+	for rows.Next() {
+
+		pm, err := scanPaymentMethod(rows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		if provider, ok := paymentProviders[pm.Provider]; !ok || provider.payoutKind != kind {
+
+			continue
+		}
+
+		if (pm.MinAmount > 0 && w.Amount < pm.MinAmount) || (pm.MaxAmount > 0 && w.Amount > pm.MaxAmount) {
+
+			continue
+		}
+
+		if pm.Country != "" && w.Country != "" && !strings.EqualFold(pm.Country, w.Country) {
+
+			continue
+		}
+
+		if kind == payoutBank && pm.BankCodes != "" && !containsCode(pm.BankCodes, w.BankCode) {
+
+			continue
+		}
+
+		candidates = append(candidates, pm)
+	}
+
+	if err := rows.Err(); err != nil {
+
+		return nil, err
+	}
+
+	health := getProviderHealth(db, w.ClientID)
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+
+		a, b := health[candidates[i].Provider], health[candidates[j].Provider]
+
+		if a.healthy() != b.healthy() {
+
+			return a.healthy()
+		}
+
+		if candidates[i].DisplayOrder != candidates[j].DisplayOrder {
+
+			return candidates[i].DisplayOrder < candidates[j].DisplayOrder
+		}
+
+		if a.SuccessRate != b.SuccessRate && a.Attempts >= healthMinAttempts && b.Attempts >= healthMinAttempts {
+
+			return a.SuccessRate > b.SuccessRate
+		}
+
+		return a.LatencyMs < b.LatencyMs
+	})
+
+	return candidates, nil
+}
+
+func containsCode(list, code string) bool {
+
+	for _, c := range strings.Split(list, ",") {
+
+		if strings.TrimSpace(c) == code {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// routePayout sends a pending withdrawal to the best disbursement provider, failing over to the next
+// when a provider rejects it. It stops at the first provider whose outcome is unknown, since trying
+// another could pay the player twice. Every try is kept in payout_attempts
+func routePayout(db *sql.DB, w *models.Withdrawal) ([]models.PayoutAttempt, error) {
+
+	candidates, err := payoutCandidates(db, w)
+	if err != nil {
+
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+
+		setWithdrawalProviderStatus(db, w, routingFailed)
+		w.ProviderStatus = routingFailed
+		return nil, fmt.Errorf("No disbursement provider can pay this withdrawal")
+	}
+
+	var attempts []models.PayoutAttempt
+
+	for _, pm := range candidates {
+
+		pm, err := decryptPaymentMethod(pm)
+		if err != nil {
+
+			log.Printf("error decrypting %s secret %s ", pm.Provider, err.Error())
+			continue
+		}
+
+		start := time.Now()
+		result, err := paymentProviders[pm.Provider].disburse(db, pm, w)
+		latency := time.Since(start).Milliseconds()
+
+		attempt := models.PayoutAttempt{
+			WithdrawalID:    w.ID,
+			PaymentMethodID: pm.ID,
+			Provider:        pm.Provider,
+			LatencyMs:       latency,
+		}
+
+		if result != nil {
+
+			attempt.ProviderReference = result.Reference
+			attempt.Message = result.Message
+		}
+
+		switch {
+		case err != nil:
+			attempt.Status = attemptUnconfirmed
+			attempt.Message = err.Error()
+		case result.Rejected:
+			attempt.Status = attemptRejected
+		case result.Completed:
+			attempt.Status = attemptCompleted
+		default:
+			attempt.Status = attemptAccepted
+		}
+
+		saveAttempt(db, w, &attempt)
+		attempts = append(attempts, attempt)
+
+		if attempt.Status == attemptRejected {
+
+			log.Printf("withdrawal %s rejected by %s, trying next provider: %s ", w.WithdrawalCode, pm.Provider, attempt.Message)
+			continue
+		}
+
+		_, err = db.Exec("UPDATE withdrawals SET provider = ?, provider_reference = ?, provider_status = ? WHERE id = ?",
+			pm.Provider, nullString(attempt.ProviderReference), attempt.Status, w.ID)
+
+		if err != nil {
+
+			log.Printf("error saving payout provider of withdrawal %s %s ", w.WithdrawalCode, err.Error())
+		}
+
+		w.Provider = pm.Provider
+		w.ProviderReference = attempt.ProviderReference
+		w.ProviderStatus = attempt.Status
+
+		if attempt.Status == attemptCompleted {
+
+			_, err = completeWithdrawal(db, w, attempt.Status)
+			return attempts, err
+		}
+
+		return attempts, nil
+	}
+
+	setWithdrawalProviderStatus(db, w, routingFailed)
+	w.ProviderStatus = routingFailed
+	return attempts, fmt.Errorf("All disbursement providers rejected this withdrawal")
+}
+
+func saveAttempt(db *sql.DB, w *models.Withdrawal, a *models.PayoutAttempt) {
+
+	if len(a.Message) > 255 {
+
+		a.Message = a.Message[:255]
+	}
+
+	res, err := db.Exec("INSERT INTO payout_attempts (client_id, withdrawal_id, payment_method_id, provider, provider_reference, status, message, latency_ms, created_at) "+
+		" VALUES (?,?,?,?,?,?,?,?,NOW())", w.ClientID, w.ID, a.PaymentMethodID, a.Provider, nullString(a.ProviderReference), a.Status, nullString(a.Message), a.LatencyMs)
+
+	if err != nil {
+
+		log.Printf("error saving payout attempt for withdrawal %s %s ", w.WithdrawalCode, err.Error())
+		return
+	}
+
+	a.ID, _ = res.LastInsertId()
+}
+
+// settleAttempt records the final outcome of the attempt that paid a withdrawal, so it counts towards the provider's health
+func settleAttempt(db *sql.DB, w *models.Withdrawal, status string) {
+
+	if w.ProviderReference == "" {
+
+		return
+	}
+
+	_, err := db.Exec("UPDATE payout_attempts SET status = ? WHERE withdrawal_id = ? AND provider_reference = ?", status, w.ID, w.ProviderReference)
+	if err != nil {
+
+		log.Printf("error settling payout attempt of withdrawal %s %s ", w.WithdrawalCode, err.Error())
+	}
+}
+
+func getPayoutAttempts(db *sql.DB, withdrawalId int64) []models.PayoutAttempt {
+
+	rows, err := db.Query("SELECT id, withdrawal_id, payment_method_id, provider, provider_reference, status, message, latency_ms, created_at "+
+		" FROM payout_attempts WHERE withdrawal_id = ? ORDER BY id", withdrawalId)
+
+	if err != nil {
+
+		log.Printf("error getting payout attempts of withdrawal %d %s ", withdrawalId, err.Error())
+		return nil
+	}
+
+	defer rows.Close()
+
+	var attempts []models.PayoutAttempt
+
+	for rows.Next() {
+
+		var a models.PayoutAttempt
+		var reference, message sql.NullString
+
+		if err := rows.Scan(&a.ID, &a.WithdrawalID, &a.PaymentMethodID, &a.Provider, &reference, &a.Status, &message, &a.LatencyMs, &a.CreatedAt); err != nil {
+
+			log.Printf("error scanning payout attempt %s ", err.Error())
+			continue
+		}
+
+		a.ProviderReference = reference.String
+		a.Message = message.String
+		attempts = append(attempts, a)
+	}
+
+	return attempts
+}
+
+// UpdateWithdrawal lets an admin approve a pending withdrawal, which routes it to a disbursement
+// provider, retry one no provider accepted, or reject it and refund the player
+func UpdateWithdrawal(db *sql.DB, in *pbWallet.UpdateWithdrawalRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("%s withdrawal %d in client %d ", in.Action, in.WithdrawalId, in.ClientId)
+
+	w, err := getWithdrawalByID(db, int64(in.WithdrawalId))
+	if err != nil || w.ClientID != int64(in.ClientId) {
+
+		return false, 404, "Withdrawal not found", nil
+	}
+
+	if w.Status != models.StatusPending {
+
+		return false, 400, "Withdrawal has already been processed", nil
+	}
+
+	_, err = db.Exec("UPDATE withdrawals SET updated_by = ?, comment = ? WHERE id = ?", nullString(in.UpdatedBy), nullString(in.Comment), w.ID)
+	if err != nil {
+
+		log.Printf("error updating withdrawal %d %s ", w.ID, err.Error())
+	}
+
+	switch strings.ToLower(in.Action) {
+	case "approve", "retry":
+		// claim the withdrawal so two approvals cannot pay it out twice
+		res, err := db.Exec("UPDATE withdrawals SET provider_status = ? WHERE id = ? AND status = ? "+
+			" AND ((provider IS NULL AND provider_status IS NULL) OR provider_status = ?)", routing, w.ID, models.StatusPending, routingFailed)
+
+		if err != nil {
+
+			log.Printf("error claiming withdrawal %d for payout %s ", w.ID, err.Error())
+			return false, 500, "Unable to send withdrawal for payout", nil
+		}
+
+		if n, _ := res.RowsAffected(); n != 1 {
+
+			return false, 400, "Withdrawal has already been sent for payout", nil
+		}
+
+		w.ProviderStatus = routing
+
+		_, err = routePayout(db, w)
+		if err != nil && w.ProviderStatus == routing {
+
+			setWithdrawalProviderStatus(db, w, routingFailed)
+		}
+
+		response := toStruct(map[string]interface{}{
+			"withdrawal": w,
+			"attempts":   getPayoutAttempts(db, w.ID),
+		})
+
+		if err != nil {
+
+			return false, 400, err.Error(), response
+		}
+
+		return true, 200, "Withdrawal sent for payout", response
+
+	case "reject", "decline", "cancel":
+		rejected, err := rejectWithdrawal(db, w, in.Comment)
+		if err != nil {
+
+			log.Printf("error rejecting withdrawal %d %s ", w.ID, err.Error())
+			return false, 500, "Unable to reject withdrawal", nil
+		}
+
+		if !rejected {
+
+			return false, 400, "Withdrawal has already been sent for payout", nil
+		}
+
+		return true, 200, "Withdrawal rejected", toStruct(w)
+
+	default:
+		return false, 400, "Invalid action", nil
+	}
+}
+
+// rejectWithdrawal fails a withdrawal no provider holds and refunds the player. The check and the update
+// are one statement, so an approval routing the withdrawal at the same time either wins or is refused
+func rejectWithdrawal(db *sql.DB, w *models.Withdrawal, comment string) (bool, error) {
+
+	res, err := db.Exec("UPDATE withdrawals SET status = ?, provider_status = ?, comment = ? WHERE id = ? AND status = ? "+
+		" AND (provider_status IS NULL OR provider_status = ?)", models.StatusFailed, "REJECTED", comment, w.ID, models.StatusPending, routingFailed)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	if n, _ := res.RowsAffected(); n != 1 {
+
+		return false, nil
+	}
+
+	w.Status = models.StatusFailed
+	w.ProviderStatus = "REJECTED"
+	w.Comment = comment
+
+	if !refundWithdrawal(db, w) {
+
+		return true, fmt.Errorf("withdrawal %s was rejected but refund was not credited", w.WithdrawalCode)
+	}
+
+	return true, nil
+}
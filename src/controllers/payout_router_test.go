@@ -0,0 +1,323 @@
+package controllers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/pawapay"
+)
+
+// stubPayouts registers disbursement providers answering with the given functions, in display order,
+// and returns a database holding them as client 1's payout methods and a log of the providers called
+func stubPayouts(t *testing.T, disburse ...func() (*payoutResult, error)) (*sql.DB, *fakeDB, *[]string) {
+
+	db, fake := newFakeDB(t)
+
+	var called []string
+	var methods [][]driver.Value
+
+	for i, fn := range disburse {
+
+		name := []string{"stub-first", "stub-second", "stub-third"}[i]
+		fn := fn
+
+		paymentProviders[name] = paymentProvider{payoutKind: payoutMobile, disburse: func(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) (*payoutResult, error) {
+
+			called = append(called, pm.Provider)
+			return fn()
+		}}
+
+		t.Cleanup(func() { delete(paymentProviders, name) })
+
+		methods = append(methods, []driver.Value{
+			int64(i + 1), int64(1), name, name, "secret", nil, nil, nil, int64(1), int64(1),
+			0.0, 0.0, int64(i + 1), nil, nil,
+		})
+	}
+
+	fake.On("FROM payment_methods WHERE client_id = ? AND status = 1 AND for_disbursement = 1", paymentMethodFields, methods...)
+
+	return db, fake, &called
+}
+
+func rejected() (*payoutResult, error) {
+
+	return &payoutResult{Status: "REJECTED", Message: "not today", Rejected: true}, nil
+}
+
+func accepted() (*payoutResult, error) {
+
+	return &payoutResult{Reference: "ref-1", Status: "ACCEPTED"}, nil
+}
+
+func unconfirmed() (*payoutResult, error) {
+
+	return &payoutResult{Reference: "ref-1", Status: "UNCONFIRMED"}, errors.New("timeout")
+}
+
+// unroutedWithdrawal is a pending mobile withdrawal of player 9 no provider holds yet
+func unroutedWithdrawal() []driver.Value {
+
+	return []driver.Value{
+		int64(8), int64(1), int64(9), "player", "W-1", 100.0, "", nil, nil, nil, nil,
+		nil, "256700000000", nil, nil, "mobile", int64(models.StatusPending), nil, nil, nil,
+		"2024-01-01 00:00:00", "2024-01-01 00:00:00",
+	}
+}
+
+func TestRoutePayoutFailsOver(t *testing.T) {
+
+	db, fake, called := stubPayouts(t, rejected, accepted)
+
+	w := &models.Withdrawal{ID: 8, ClientID: 1, WithdrawalCode: "W-1", Amount: 100, Status: models.StatusPending}
+
+	attempts, err := routePayout(db, w)
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(*called) != 2 || len(attempts) != 2 || attempts[0].Status != attemptRejected || attempts[1].Status != attemptAccepted {
+
+		t.Fatalf("expected the second provider to take the payout, got %v %+v", *called, attempts)
+	}
+
+	routed := fake.Ran("UPDATE withdrawals SET provider = ?")
+	if len(routed) != 1 || routed[0].args[0] != "stub-second" || w.Provider != "stub-second" {
+
+		t.Fatalf("expected the withdrawal routed to the second provider, got %+v", routed)
+	}
+}
+
+func TestRoutePayoutStopsOnUnknownOutcome(t *testing.T) {
+
+	db, fake, called := stubPayouts(t, unconfirmed, accepted)
+
+	w := &models.Withdrawal{ID: 8, ClientID: 1, WithdrawalCode: "W-1", Amount: 100, Status: models.StatusPending}
+
+	attempts, err := routePayout(db, w)
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(*called) != 1 || len(attempts) != 1 || attempts[0].Status != attemptUnconfirmed {
+
+		t.Fatalf("expected no failover after an unknown outcome, got %v %+v", *called, attempts)
+	}
+
+	routed := fake.Ran("UPDATE withdrawals SET provider = ?")
+	if len(routed) != 1 || routed[0].args[0] != "stub-first" {
+
+		t.Fatalf("expected the withdrawal left with the first provider, got %+v", routed)
+	}
+}
+
+func TestRoutePayoutAllRejected(t *testing.T) {
+
+	db, fake, called := stubPayouts(t, rejected, rejected)
+
+	w := &models.Withdrawal{ID: 8, ClientID: 1, WithdrawalCode: "W-1", Amount: 100, Status: models.StatusPending}
+
+	if _, err := routePayout(db, w); err == nil {
+
+		t.Fatalf("expected an error when every provider rejects")
+	}
+
+	if len(*called) != 2 {
+
+		t.Fatalf("expected both providers tried, got %v", *called)
+	}
+
+	failed := fake.Ran("UPDATE withdrawals SET provider_status = ?")
+	if len(failed) != 1 || failed[0].args[0] != routingFailed {
+
+		t.Fatalf("expected the withdrawal marked as not routed, got %+v", failed)
+	}
+}
+
+func TestUpdateWithdrawalApprove(t *testing.T) {
+
+	db, fake, called := stubPayouts(t, accepted)
+	fake.On("FROM withdrawals WHERE id = ?", withdrawalFields, unroutedWithdrawal())
+
+	ok, status, message, _ := UpdateWithdrawal(db, &pbWallet.UpdateWithdrawalRequest{ClientId: 1, WithdrawalId: 8, Action: "approve"})
+	if !ok || status != 200 {
+
+		t.Fatalf("unexpected result %d %s", status, message)
+	}
+
+	claims := fake.Ran("UPDATE withdrawals SET provider_status = ? WHERE id = ? AND status = ?")
+	if len(claims) != 1 || claims[0].args[0] != routing {
+
+		t.Fatalf("expected the withdrawal claimed before routing, got %+v", claims)
+	}
+
+	if len(*called) != 1 {
+
+		t.Fatalf("expected one provider called, got %v", *called)
+	}
+}
+
+func TestUpdateWithdrawalApproveTwice(t *testing.T) {
+
+	db, fake, called := stubPayouts(t, accepted)
+	fake.On("FROM withdrawals WHERE id = ?", withdrawalFields, unroutedWithdrawal())
+
+	// another approval claimed it first
+	fake.Affects("UPDATE withdrawals SET provider_status = ? WHERE id = ? AND status = ?", 0)
+
+	ok, status, _, _ := UpdateWithdrawal(db, &pbWallet.UpdateWithdrawalRequest{ClientId: 1, WithdrawalId: 8, Action: "approve"})
+	if ok || status != 400 {
+
+		t.Fatalf("expected the second approval refused, got %v %d", ok, status)
+	}
+
+	if len(*called) != 0 {
+
+		t.Fatalf("expected no provider called, got %v", *called)
+	}
+}
+
+func TestUpdateWithdrawalApproveAllRejected(t *testing.T) {
+
+	db, fake, _ := stubPayouts(t, rejected)
+	fake.On("FROM withdrawals WHERE id = ?", withdrawalFields, unroutedWithdrawal())
+
+	ok, status, _, _ := UpdateWithdrawal(db, &pbWallet.UpdateWithdrawalRequest{ClientId: 1, WithdrawalId: 8, Action: "approve"})
+	if ok || status != 400 {
+
+		t.Fatalf("expected the approval to fail, got %v %d", ok, status)
+	}
+
+	updates := fake.Ran("UPDATE withdrawals SET provider_status = ?")
+	if len(updates) != 2 || updates[1].args[0] != routingFailed {
+
+		t.Fatalf("expected the claim released as not routed, got %+v", updates)
+	}
+
+	if refunds := fake.Ran("INSERT INTO transactions"); len(refunds) != 0 {
+
+		t.Fatalf("expected the withdrawal left pending for a retry, got %+v", refunds)
+	}
+}
+
+func TestUpdateWithdrawalReject(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	openWallet(fake, 0)
+	fake.On("FROM withdrawals WHERE id = ?", withdrawalFields, unroutedWithdrawal())
+
+	ok, status, message, _ := UpdateWithdrawal(db, &pbWallet.UpdateWithdrawalRequest{ClientId: 1, WithdrawalId: 8, Action: "reject", Comment: "fraud"})
+	if !ok || status != 200 {
+
+		t.Fatalf("unexpected result %d %s", status, message)
+	}
+
+	rejects := fake.Ran("UPDATE withdrawals SET status = ?")
+	if len(rejects) != 1 || rejects[0].args[0] != int64(models.StatusFailed) || rejects[0].args[5] != routingFailed {
+
+		t.Fatalf("expected one conditional reject, got %+v", rejects)
+	}
+
+	refunds := fake.Ran("INSERT INTO transactions")
+	if len(refunds) != 1 || refunds[0].args[5] != models.SubjectWithdrawalRefund {
+
+		t.Fatalf("expected the player refunded, got %+v", refunds)
+	}
+}
+
+func TestUpdateWithdrawalRejectWhileRouting(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	openWallet(fake, 0)
+	fake.On("FROM withdrawals WHERE id = ?", withdrawalFields, unroutedWithdrawal())
+
+	// an approval claimed the withdrawal after it was read
+	fake.Affects("UPDATE withdrawals SET status = ?", 0)
+
+	ok, status, _, _ := UpdateWithdrawal(db, &pbWallet.UpdateWithdrawalRequest{ClientId: 1, WithdrawalId: 8, Action: "reject"})
+	if ok || status != 400 {
+
+		t.Fatalf("expected the reject refused, got %v %d", ok, status)
+	}
+
+	if refunds := fake.Ran("INSERT INTO transactions"); len(refunds) != 0 {
+
+		t.Fatalf("expected no refund, got %+v", refunds)
+	}
+}
+
+func TestPawapayDisburseMinorUnits(t *testing.T) {
+
+	cases := []struct {
+		correspondent string
+		currency      string
+		minorUnits    int64
+		amount        float64
+		sent          string
+	}{
+		{"MTN_MOMO_ZMB", "ZMW", 2, 100.5, `"amount":"100.50"`},
+		{"MTN_MOMO_UGA", "UGX", 0, 100, `"amount":"100"`},
+		{"MTN_MOMO_UGA", "UGX", 0, 100.5, ""},
+	}
+
+	for _, c := range cases {
+
+		t.Run(fmt.Sprintf("%v %s", c.amount, c.currency), func(t *testing.T) {
+
+			db, fake := newFakeDB(t)
+			fake.On("FROM client_currencies", []string{"client_id", "currency", "name", "minor_units", "is_default"},
+				[]driver.Value{int64(1), c.currency, c.currency, c.minorUnits, true})
+
+			stub := pawapay.NewStubDoer()
+			stub.On(http.MethodPost, "/v1/predict-correspondent", 200, `{"correspondent":"`+c.correspondent+`"}`)
+			stub.On(http.MethodPost, "/payouts", 200, `{"status":"ACCEPTED"}`)
+			pawapayDoer = stub
+
+			pm := &models.PaymentMethod{Provider: pawapayProvider, BaseURL: "https://" + strings.ReplaceAll(t.Name(), "/", "-") + ".stub", SecretKey: "token"}
+			w := &models.Withdrawal{ID: 8, ClientID: 1, Amount: c.amount, Currency: c.currency, Msisdn: "256700000000"}
+
+			result, err := pawapayDisburse(db, pm, w)
+			pawapayDoer = nil
+
+			if err != nil {
+
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			var sent string
+			for _, req := range stub.Requests {
+
+				if req.URL.Path == "/payouts" {
+
+					body, _ := io.ReadAll(req.Body)
+					sent = string(body)
+				}
+			}
+
+			if c.sent == "" {
+
+				if !result.Rejected || sent != "" {
+
+					t.Fatalf("%v %s: expected the payout refused, got %+v %s", c.amount, c.currency, result, sent)
+				}
+
+				return
+			}
+
+			if result.Rejected || !strings.Contains(sent, c.sent) {
+
+				t.Fatalf("%v %s: expected %s sent, got %+v %s", c.amount, c.currency, c.sent, result, sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// erasures lists the personal data anonymised for a player, per table. Amounts, dates and statuses
+// are kept for financial reporting
+var erasures = []struct {
+	table string
+	query string
+}{
+	{"wallets", "UPDATE wallets SET username = ?, status = ? WHERE client_id = ? AND user_id = ?"},
+	{"transactions", "UPDATE transactions SET username = ? WHERE client_id = ? AND user_id = ?"},
+	{"deposits", "UPDATE deposits SET username = ?, msisdn = NULL WHERE client_id = ? AND user_id = ?"},
+	{"withdrawals", "UPDATE withdrawals SET username = ?, account_number = NULL, account_name = NULL, msisdn = NULL WHERE client_id = ? AND user_id = ?"},
+	{"virtual_accounts", "UPDATE virtual_accounts SET username = ?, account_name = NULL, status = 0 WHERE client_id = ? AND user_id = ?"},
+	{"withdrawal_accounts", "DELETE FROM withdrawal_accounts WHERE client_id = ? AND user_id = ?"},
+	{"player_names", "DELETE FROM player_names WHERE client_id = ? AND user_id = ?"},
+	// provider notifications carry names, phone numbers and accounts, they are found by the references
+	// of the player's deposits, withdrawals and payouts
+	{"callback_logs", "UPDATE callback_logs SET body = NULL WHERE client_id = ? AND body IS NOT NULL AND reference IN (" +
+		" SELECT reference FROM deposits WHERE client_id = ? AND user_id = ? " +
+		" UNION SELECT provider_reference FROM deposits WHERE client_id = ? AND user_id = ? AND provider_reference IS NOT NULL " +
+		" UNION SELECT withdrawal_code FROM withdrawals WHERE client_id = ? AND user_id = ? " +
+		" UNION SELECT provider_reference FROM withdrawals WHERE client_id = ? AND user_id = ? AND provider_reference IS NOT NULL " +
+		" UNION SELECT p.payout_id FROM withdrawal_payouts p JOIN withdrawals w ON w.id = p.withdrawal_id WHERE w.client_id = ? AND w.user_id = ?)"},
+}
+
+// DeletePlayerData closes a player's wallet and anonymises their username, bank accounts, phone
+// numbers and the provider notifications about their payments, keeping an erasure record of what was
+// changed. It refuses while the player has money in any wallet or a withdrawal waiting to be paid
+func DeletePlayerData(db *sql.DB, in *pbWallet.DeletePlayerDataRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("Deleting data of user %d in client %d ", in.Id, in.ClientId)
+
+	if in.ClientId == 0 {
+
+		return false, 400, "Client is required", nil
+	}
+
+	clientId := int64(in.ClientId)
+
+	tx, err := db.Begin()
+	if err != nil {
+
+		log.Printf("error starting erasure of user %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to delete player data", nil
+	}
+
+	defer tx.Rollback()
+
+	// the wallets, sub wallets and withdrawals are locked until the erasure commits, so no credit or
+	// withdrawal can slip in between the checks and the anonymisation. Balance changes waiting on the
+	// lock find the wallet closed afterwards and change nothing
+	var wallets, walletStatus int64
+	var balance, available, trust, sportBonus, virtualBonus, casinoBonus sql.NullFloat64
+
+	// a player may hold wallets in several currencies, all of them must be empty
+	err = tx.QueryRow("SELECT COUNT(*), COALESCE(MAX(status), 0), SUM(ABS(balance)), SUM(ABS(available_balance)), SUM(ABS(trust_balance)), SUM(ABS(sport_bonus_balance)), "+
+		" SUM(ABS(virtual_bonus_balance)), SUM(ABS(casino_bonus_balance)) FROM wallets WHERE client_id = ? AND user_id = ? FOR UPDATE", clientId, in.Id).
+		Scan(&wallets, &walletStatus, &balance, &available, &trust, &sportBonus, &virtualBonus, &casinoBonus)
+
+	if err != nil {
+
+		log.Printf("error getting wallet of user %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to fetch wallet", nil
+	}
+
+	if wallets == 0 {
+
+		return false, 404, "User not found", nil
+	}
+
+	if walletStatus == models.WalletClosed {
+
+		return false, 400, "Player data has already been deleted", nil
+	}
+
+	if balance.Float64 != 0 || available.Float64 != 0 || trust.Float64 != 0 || sportBonus.Float64 != 0 || virtualBonus.Float64 != 0 || casinoBonus.Float64 != 0 {
+
+		return false, 400, "Player still has a balance, it must be paid out or cleared first", nil
+	}
+
+	var subBalances int64
+	err = tx.QueryRow("SELECT COUNT(*) FROM sub_wallets WHERE client_id = ? AND user_id = ? AND balance <> 0 FOR UPDATE", clientId, in.Id).Scan(&subBalances)
+	if err != nil {
+
+		log.Printf("error getting sub wallets of user %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to fetch wallet", nil
+	}
+
+	if subBalances > 0 {
+
+		return false, 400, "Player still has a balance, it must be paid out or cleared first", nil
+	}
+
+	var pending int64
+	err = tx.QueryRow("SELECT COUNT(*) FROM withdrawals WHERE client_id = ? AND user_id = ? AND status = ? FOR UPDATE", clientId, in.Id, models.StatusPending).Scan(&pending)
+	if err != nil {
+
+		log.Printf("error getting pending withdrawals of user %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to check pending withdrawals", nil
+	}
+
+	if pending > 0 {
+
+		return false, 400, "Player has a pending withdrawal", nil
+	}
+
+	erasure := &models.Erasure{
+		ClientID:           clientId,
+		UserID:             int64(in.Id),
+		AnonymisedUsername: fmt.Sprintf("deleted-%d", in.Id),
+		Rows:               map[string]int64{},
+		CreatedAt:          time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	for _, e := range erasures {
+
+		var args []interface{}
+
+		switch e.table {
+		case "wallets":
+			args = []interface{}{erasure.AnonymisedUsername, models.WalletClosed, clientId, in.Id}
+		case "withdrawal_accounts", "player_names":
+			args = []interface{}{clientId, in.Id}
+		case "callback_logs":
+			args = []interface{}{clientId, clientId, in.Id, clientId, in.Id, clientId, in.Id, clientId, in.Id, clientId, in.Id}
+		default:
+			args = []interface{}{erasure.AnonymisedUsername, clientId, in.Id}
+		}
+
+		res, err := tx.Exec(e.query, args...)
+		if err != nil {
+
+			log.Printf("error anonymising %s of user %d %s ", e.table, in.Id, err.Error())
+			return false, 500, "Unable to delete player data", nil
+		}
+
+		erasure.Rows[e.table], _ = res.RowsAffected()
+	}
+
+	summary, _ := json.Marshal(erasure.Rows)
+
+	res, err := tx.Exec("INSERT INTO player_erasures (client_id, user_id, anonymised_username, summary, created_at) VALUES (?,?,?,?,NOW())",
+		clientId, in.Id, erasure.AnonymisedUsername, string(summary))
+
+	if err != nil {
+
+		log.Printf("error saving erasure of user %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to delete player data", nil
+	}
+
+	erasure.ID, _ = res.LastInsertId()
+
+	if err := tx.Commit(); err != nil {
+
+		log.Printf("error committing erasure of user %d %s ", in.Id, err.Error())
+		return false, 500, "Unable to delete player data", nil
+	}
+
+	return true, 200, "Player data deleted", toStruct(erasure)
+}
@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"database/sql"
+	"log"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+// addPlayerTotals adds a wallet transaction to the player's totals of the day and to their wallet
+// profile in the currency of the transaction. The segmentation queries and the wallet profile read
+// these totals instead of scanning transactions
+func addPlayerTotals(db *sql.DB, clientId, userId int32, currency, trxType, subject string, amount float64) {
+
+	var column string
+	var count int
+
+	defer addPlayerStats(db, clientId, userId, currency, trxType, subject, amount)
+
+	switch {
+	case subject == models.SubjectBet && trxType == "debit":
+		column, count = "bet", 1
+	case (subject == models.SubjectBetCancelled || subject == models.SubjectBetRefund) && trxType == "credit":
+		column, count, amount = "bet", -1, -amount
+	case subject == models.SubjectDeposit && trxType == "credit":
+		column, count = "deposit", 1
+	case subject == models.SubjectWithdrawal && trxType == "debit":
+		column, count = "withdrawal", 1
+	case subject == models.SubjectWithdrawalRefund:
+		column, count, amount = "withdrawal", -1, -amount
+	default:
+		return
+	}
+
+	_, err := db.Exec("INSERT INTO player_daily_totals (client_id, user_id, date, currency, "+column+"_total, "+column+"_count) VALUES (?,?,CURDATE(),?,?,?) "+
+		" ON DUPLICATE KEY UPDATE "+column+"_total = "+column+"_total + VALUES("+column+"_total), "+column+"_count = "+column+"_count + VALUES("+column+"_count)",
+		clientId, userId, currency, amount, count)
+
+	if err != nil {
+
+		log.Printf("error updating daily totals of user %d %s ", userId, err.Error())
+	}
+}
+
+// addPlayerStats keeps the running totals, last deposit and withdrawal and activity dates of a player
+// in one currency
+func addPlayerStats(db *sql.DB, clientId, userId int32, currency, trxType, subject string, amount float64) {
+
+	var update string
+	var args []interface{}
+
+	switch {
+	case subject == models.SubjectDeposit && trxType == "credit":
+		update = ", total_deposits = total_deposits + ?, deposit_count = deposit_count + 1, last_deposit_date = NOW(), last_deposit_amount = ? "
+		args = []interface{}{amount, amount}
+	case subject == models.SubjectWithdrawal && trxType == "debit":
+		update = ", total_withdrawals = total_withdrawals + ?, withdrawal_count = withdrawal_count + 1, last_withdrawal_date = NOW(), last_withdrawal_amount = ? "
+		args = []interface{}{amount, amount}
+	case subject == models.SubjectWithdrawalRefund:
+		update = ", total_withdrawals = total_withdrawals - ?, withdrawal_count = withdrawal_count - 1 "
+		args = []interface{}{amount}
+	}
+
+	args = append([]interface{}{clientId, userId, currency}, args...)
+
+	_, err := db.Exec("INSERT INTO player_wallet_stats (client_id, user_id, currency, first_activity_date, last_activity_date) VALUES (?,?,?,NOW(),NOW()) "+
+		" ON DUPLICATE KEY UPDATE last_activity_date = NOW() "+update, args...)
+
+	if err != nil {
+
+		log.Printf("error updating wallet stats of user %d %s ", userId, err.Error())
+	}
+}
+
+// playerRanges returns the players whose total of a column between two dates in the client's default
+// currency satisfies having, with the balance of their default currency wallet
+func playerRanges(db *sql.DB, column string, clientId int32, startDate, endDate, having string, args ...interface{}) ([]models.PlayerRange, error) {
+
+	currency, err := resolveCurrency(db, clientId, "")
+	if err != nil {
+
+		return nil, err
+	}
+
+	args = append([]interface{}{clientId, currency.Code, startDate, endDate}, args...)
+
+	rows, err := db.Query("SELECT t.user_id, t.total, t.count, COALESCE(wallets.available_balance, 0) FROM ("+
+		" SELECT client_id, user_id, SUM("+column+"_total) total, SUM("+column+"_count) count FROM player_daily_totals "+
+		" WHERE client_id = ? AND currency = ? AND date BETWEEN ? AND ? GROUP BY client_id, user_id HAVING "+having+
+		" ) t LEFT JOIN wallets ON wallets.user_id = t.user_id AND wallets.client_id = t.client_id AND "+defaultCurrencyWallet+" ORDER BY t.total DESC", args...)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var players []models.PlayerRange
+	for rows.Next() {
+
+		var p models.PlayerRange
+		if err := rows.Scan(&p.UserID, &p.Total, &p.Count, &p.Balance); err != nil {
+
+			return nil, err
+		}
+
+		players = append(players, p)
+	}
+
+	return players, rows.Err()
+}
+
+// FetchBetRange returns the players who staked between minAmount and maxAmount in a period
+func FetchBetRange(db *sql.DB, in *pbWallet.FetchBetRangeRequest) (success bool, status int32, message string, data []*pbWallet.FetchBetRangeResponse_Data) {
+
+	players, err := playerRanges(db, "bet", in.ClientId, in.StartDate, in.EndDate, "total > 0 AND total BETWEEN ? AND ?", in.MinAmount, in.MaxAmount)
+	if err != nil {
+
+		log.Printf("error getting bet range %s ", err.Error())
+		return false, 500, "Unable to fetch players", nil
+	}
+
+	data = []*pbWallet.FetchBetRangeResponse_Data{}
+	for _, p := range players {
+
+		data = append(data, &pbWallet.FetchBetRangeResponse_Data{
+			UserId:  int32(p.UserID),
+			Total:   float32(p.Total),
+			Count:   float32(p.Count),
+			Balance: float32(p.Balance),
+		})
+	}
+
+	return true, 200, "", data
+}
+
+// FetchDepositRange returns the players who deposited between minAmount and maxAmount in a period
+func FetchDepositRange(db *sql.DB, in *pbWallet.FetchDepositRangeRequest) (success bool, status int32, message string, data []*pbWallet.FetchDepositRangeResponse_Data) {
+
+	players, err := playerRanges(db, "deposit", in.ClientId, in.StartDate, in.EndDate, "total > 0 AND total BETWEEN ? AND ?", in.MinAmount, in.MaxAmount)
+	if err != nil {
+
+		log.Printf("error getting deposit range %s ", err.Error())
+		return false, 500, "Unable to fetch players", nil
+	}
+
+	data = []*pbWallet.FetchDepositRangeResponse_Data{}
+	for _, p := range players {
+
+		data = append(data, &pbWallet.FetchDepositRangeResponse_Data{
+			UserId:  int32(p.UserID),
+			Total:   float32(p.Total),
+			Balance: float32(p.Balance),
+		})
+	}
+
+	return true, 200, "", data
+}
+
+// FetchDepositCount returns the players who deposited at least count times in a period
+func FetchDepositCount(db *sql.DB, in *pbWallet.FetchDepositCountRequest) (success bool, status int32, message string, data []*pbWallet.FetchDepositCountResponse_Data) {
+
+	players, err := playerRanges(db, "deposit", in.ClientId, in.StartDate, in.EndDate, "count > 0 AND count >= ?", in.Count)
+	if err != nil {
+
+		log.Printf("error getting deposit count %s ", err.Error())
+		return false, 500, "Unable to fetch players", nil
+	}
+
+	data = []*pbWallet.FetchDepositCountResponse_Data{}
+	for _, p := range players {
+
+		data = append(data, &pbWallet.FetchDepositCountResponse_Data{
+			UserId:  int32(p.UserID),
+			Total:   float32(p.Total),
+			Balance: float32(p.Balance),
+		})
+	}
+
+	return true, 200, "", data
+}
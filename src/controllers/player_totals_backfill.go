@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	playerTotalsBackfillChunk = 5000
+	playerTotalsBackfillPause = time.Second
+)
+
+// backfillCurrency is the currency of a transaction. Transactions made before the client set up
+// currencies have none, they were in the wallet that moved to the client's first currency
+const backfillCurrency = "COALESCE(NULLIF(transactions.currency, ''), (SELECT c.currency FROM client_currencies c " +
+	" WHERE c.client_id = transactions.client_id ORDER BY c.id LIMIT 1), '')"
+
+// RecordPlayerTotalsCutoff records the last transaction made before this service started keeping the
+// running player totals, which is where the backfill stops. It must run before the service takes
+// requests; once recorded the cutoff is kept, so instances started later leave it as it is
+func RecordPlayerTotalsCutoff(db *sql.DB) error {
+
+	_, err := db.Exec("UPDATE player_totals_backfill SET cutoff_id = (SELECT COALESCE(MAX(id), 0) FROM transactions) WHERE id = 1 AND cutoff_id IS NULL")
+
+	return err
+}
+
+// BackfillPlayerTotals adds the transactions made before the running player totals existed. It works
+// through them in id chunks, so the transactions table is never scanned in one statement, and keeps
+// its progress in player_totals_backfill so a restart carries on where it stopped
+func BackfillPlayerTotals(db *sql.DB) {
+
+	for {
+
+		done, err := backfillPlayerTotalsChunk(db, playerTotalsBackfillChunk)
+		if err != nil {
+
+			log.Printf("error backfilling player totals %s ", err.Error())
+			return
+		}
+
+		if done {
+
+			return
+		}
+
+		time.Sleep(playerTotalsBackfillPause)
+	}
+}
+
+// backfillPlayerTotalsChunk adds the next chunk of transactions to the player totals and records the
+// progress in the same transaction. The progress row is locked, so instances running the backfill at
+// the same time never add a chunk twice. It returns true once every transaction up to the cutoff is in
+func backfillPlayerTotalsChunk(db *sql.DB, size int64) (bool, error) {
+
+	tx, err := db.Begin()
+	if err != nil {
+
+		return false, err
+	}
+
+	defer tx.Rollback()
+
+	var lastId int64
+	var cutoff sql.NullInt64
+	var completedAt sql.NullString
+
+	err = tx.QueryRow("SELECT last_id, cutoff_id, completed_at FROM player_totals_backfill WHERE id = 1 FOR UPDATE").Scan(&lastId, &cutoff, &completedAt)
+	if err == sql.ErrNoRows || completedAt.Valid {
+
+		return true, nil
+	}
+
+	if err != nil {
+
+		return false, err
+	}
+
+	if !cutoff.Valid {
+
+		return false, fmt.Errorf("the running totals cutoff has not been recorded")
+	}
+
+	cutoffId := cutoff.Int64
+
+	to := lastId + size
+	if to > cutoffId {
+
+		to = cutoffId
+	}
+
+	statements := []string{
+		"INSERT INTO player_daily_totals (client_id, user_id, date, currency, bet_total, bet_count, deposit_total, deposit_count, withdrawal_total, withdrawal_count) " +
+			" SELECT client_id, user_id, DATE(created_at), " + backfillCurrency + " cur, " +
+			" SUM(CASE WHEN subject = 'Bet Deposit' AND tranasaction_type = 'debit' THEN amount " +
+			"   WHEN subject IN ('Bet Cancelled', 'Bet Refund') AND tranasaction_type = 'credit' THEN -amount ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Bet Deposit' AND tranasaction_type = 'debit' THEN 1 " +
+			"   WHEN subject IN ('Bet Cancelled', 'Bet Refund') AND tranasaction_type = 'credit' THEN -1 ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN amount ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN 1 ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN amount WHEN subject = 'Withdrawal Refund' THEN -amount ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN 1 WHEN subject = 'Withdrawal Refund' THEN -1 ELSE 0 END) " +
+			" FROM transactions WHERE id > ? AND id <= ? AND status = 1 " +
+			" AND subject IN ('Bet Deposit', 'Bet Cancelled', 'Bet Refund', 'Deposit', 'Withdrawal', 'Withdrawal Refund') " +
+			" GROUP BY client_id, user_id, DATE(created_at), cur " +
+			" ON DUPLICATE KEY UPDATE bet_total = bet_total + VALUES(bet_total), bet_count = bet_count + VALUES(bet_count), " +
+			" deposit_total = deposit_total + VALUES(deposit_total), deposit_count = deposit_count + VALUES(deposit_count), " +
+			" withdrawal_total = withdrawal_total + VALUES(withdrawal_total), withdrawal_count = withdrawal_count + VALUES(withdrawal_count)",
+
+		"INSERT INTO player_wallet_stats (client_id, user_id, currency, total_deposits, deposit_count, total_withdrawals, withdrawal_count, first_activity_date, last_activity_date) " +
+			" SELECT client_id, user_id, " + backfillCurrency + " cur, " +
+			" SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN amount ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN 1 ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN amount WHEN subject = 'Withdrawal Refund' THEN -amount ELSE 0 END), " +
+			" SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN 1 WHEN subject = 'Withdrawal Refund' THEN -1 ELSE 0 END), " +
+			" MIN(created_at), MAX(created_at) " +
+			" FROM transactions WHERE id > ? AND id <= ? AND status = 1 GROUP BY client_id, user_id, cur " +
+			" ON DUPLICATE KEY UPDATE total_deposits = total_deposits + VALUES(total_deposits), deposit_count = deposit_count + VALUES(deposit_count), " +
+			" total_withdrawals = total_withdrawals + VALUES(total_withdrawals), withdrawal_count = withdrawal_count + VALUES(withdrawal_count), " +
+			" first_activity_date = LEAST(COALESCE(first_activity_date, VALUES(first_activity_date)), VALUES(first_activity_date)), " +
+			" last_activity_date = GREATEST(COALESCE(last_activity_date, VALUES(last_activity_date)), VALUES(last_activity_date))",
+
+		// chunks go up in id, so a later deposit or withdrawal replaces the last one unless it was made after
+		// the running totals took over
+		"UPDATE player_wallet_stats s JOIN (SELECT client_id, user_id, " + backfillCurrency + " cur, MAX(id) id FROM transactions " +
+			" WHERE id > ? AND id <= ? AND status = 1 AND subject = 'Deposit' AND tranasaction_type = 'credit' GROUP BY client_id, user_id, cur) m " +
+			" ON m.client_id = s.client_id AND m.user_id = s.user_id AND m.cur = s.currency JOIN transactions t ON t.id = m.id " +
+			" SET s.last_deposit_date = t.created_at, s.last_deposit_amount = t.amount " +
+			" WHERE s.last_deposit_date IS NULL OR s.last_deposit_date <= t.created_at",
+
+		"UPDATE player_wallet_stats s JOIN (SELECT client_id, user_id, " + backfillCurrency + " cur, MAX(id) id FROM transactions " +
+			" WHERE id > ? AND id <= ? AND status = 1 AND subject = 'Withdrawal' AND tranasaction_type = 'debit' GROUP BY client_id, user_id, cur) m " +
+			" ON m.client_id = s.client_id AND m.user_id = s.user_id AND m.cur = s.currency JOIN transactions t ON t.id = m.id " +
+			" SET s.last_withdrawal_date = t.created_at, s.last_withdrawal_amount = t.amount " +
+			" WHERE s.last_withdrawal_date IS NULL OR s.last_withdrawal_date <= t.created_at",
+	}
+
+	if to > lastId {
+
+		for _, statement := range statements {
+
+			if _, err = tx.Exec(statement, lastId, to); err != nil {
+
+				return false, err
+			}
+		}
+	}
+
+	done := to >= cutoffId
+
+	_, err = tx.Exec("UPDATE player_totals_backfill SET last_id = ?, completed_at = IF(?, NOW(), NULL) WHERE id = 1", to, done)
+	if err != nil {
+
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+
+		return false, err
+	}
+
+	if done {
+
+		log.Printf("player totals backfilled up to transaction %d ", cutoffId)
+	}
+
+	return done, nil
+}
@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+)
+
+func TestBackfillPlayerTotalsChunk(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("FROM player_totals_backfill", []string{"last_id", "cutoff_id", "completed_at"}, []driver.Value{int64(10000), int64(12000), nil})
+
+	done, err := backfillPlayerTotalsChunk(db, 5000)
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if !done {
+
+		t.Fatalf("expected the backfill to finish at the cutoff")
+	}
+
+	for _, s := range fake.Ran("FROM transactions") {
+
+		if s.args[0] != int64(10000) || s.args[1] != int64(12000) {
+
+			t.Fatalf("expected the chunk to stop at the cutoff, got %v", s.args)
+		}
+	}
+
+	progress := fake.Ran("UPDATE player_totals_backfill")
+	if len(progress) != 1 || progress[0].args[0] != int64(12000) || progress[0].args[1] != true {
+
+		t.Fatalf("expected progress recorded at the cutoff, got %v", progress)
+	}
+}
+
+func TestBackfillPlayerTotalsCompleted(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("FROM player_totals_backfill", []string{"last_id", "cutoff_id", "completed_at"}, []driver.Value{int64(12000), int64(12000), "2026-01-01 00:00:00"})
+
+	done, err := backfillPlayerTotalsChunk(db, 5000)
+	if err != nil || !done {
+
+		t.Fatalf("expected a completed backfill, got %v %v", done, err)
+	}
+
+	if len(fake.Ran("FROM transactions")) != 0 {
+
+		t.Fatalf("expected no transactions read after completion")
+	}
+}
+
+func TestBackfillPlayerTotalsWithoutCutoff(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("FROM player_totals_backfill", []string{"last_id", "cutoff_id", "completed_at"}, []driver.Value{int64(0), nil, nil})
+
+	done, err := backfillPlayerTotalsChunk(db, 5000)
+	if err == nil || done {
+
+		t.Fatalf("expected the backfill to wait for the cutoff, got %v %v", done, err)
+	}
+
+	if len(fake.Ran("FROM transactions")) != 0 || len(fake.Ran("UPDATE player_totals_backfill")) != 0 {
+
+		t.Fatalf("expected nothing backfilled before the cutoff is recorded")
+	}
+}
+
+func TestRecordPlayerTotalsCutoffKeepsRecordedCutoff(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+
+	if err := RecordPlayerTotalsCutoff(db); err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	recorded := fake.Ran("UPDATE player_totals_backfill SET cutoff_id")
+	if len(recorded) != 1 || !strings.Contains(recorded[0].query, "cutoff_id IS NULL") {
+
+		t.Fatalf("expected the cutoff recorded only when unset, got %v", recorded)
+	}
+}
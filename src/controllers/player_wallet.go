@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"database/sql"
+	"log"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+func getPlayerStats(db *sql.DB, clientId, userId int32, currency string) (*models.PlayerStats, error) {
+
+	var s models.PlayerStats
+	var lastDeposit, lastWithdrawal, firstActivity, lastActivity sql.NullString
+
+	err := db.QueryRow("SELECT total_deposits, deposit_count, last_deposit_date, last_deposit_amount, total_withdrawals, withdrawal_count, "+
+		" last_withdrawal_date, last_withdrawal_amount, first_activity_date, last_activity_date FROM player_wallet_stats WHERE client_id = ? AND user_id = ? AND currency = ?",
+		clientId, userId, currency).Scan(&s.TotalDeposits, &s.DepositCount, &lastDeposit, &s.LastDepositAmount, &s.TotalWithdrawals, &s.WithdrawalCount,
+		&lastWithdrawal, &s.LastWithdrawalAmount, &firstActivity, &lastActivity)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	s.LastDepositDate = lastDeposit.String
+	s.LastWithdrawalDate = lastWithdrawal.String
+	s.FirstActivityDate = firstActivity.String
+	s.LastActivityDate = lastActivity.String
+
+	return &s, nil
+}
+
+// GetPlayerWalletData returns the wallet profile of a player in the client's default currency for risk
+// and support screens
+func GetPlayerWalletData(db *sql.DB, in *pbWallet.GetBalanceRequest) *pbWallet.PlayerWalletData {
+
+	data := &pbWallet.PlayerWalletData{}
+
+	currency, err := resolveCurrency(db, in.ClientId, "")
+	if err != nil {
+
+		log.Printf("error resolving currency of client %d %s", in.ClientId, err.Error())
+		return data
+	}
+
+	err = db.QueryRow("SELECT available_balance, sport_bonus_balance FROM wallets WHERE user_id = ? AND client_id = ? AND "+defaultCurrencyWallet, in.UserId, in.ClientId).
+		Scan(&data.SportBalance, &data.SportBonusBalance)
+
+	if err != nil {
+
+		log.Printf("error getting wallet of user %d %s ", in.UserId, err.Error())
+		return data
+	}
+
+	stats, err := getPlayerStats(db, in.ClientId, in.UserId, currency.Code)
+	if err != nil && err != sql.ErrNoRows {
+
+		log.Printf("error getting wallet stats of user %d %s ", in.UserId, err.Error())
+	}
+
+	if stats != nil {
+
+		data.TotalDeposits = float32(stats.TotalDeposits)
+		data.NoOfDeposits = int32(stats.DepositCount)
+		data.LastDepositDate = stats.LastDepositDate
+		data.LastDepositAmount = float32(stats.LastDepositAmount)
+		data.TotalWithdrawals = float32(stats.TotalWithdrawals)
+		data.NoOfWithdrawals = int32(stats.WithdrawalCount)
+		data.LastWithdrawalDate = stats.LastWithdrawalDate
+		data.LastWithdrawalAmount = float32(stats.LastWithdrawalAmount)
+		data.FirstActivityDate = stats.FirstActivityDate
+		data.LastActivityDate = stats.LastActivityDate
+
+		if stats.DepositCount > 0 {
+
+			data.AvgDeposits = float32(stats.TotalDeposits / float64(stats.DepositCount))
+		}
+
+		if stats.WithdrawalCount > 0 {
+
+			data.AvgWithdrawals = float32(stats.TotalWithdrawals / float64(stats.WithdrawalCount))
+		}
+	}
+
+	err = db.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM withdrawals WHERE client_id = ? AND user_id = ? AND status = ? AND currency IN ('', ?)",
+		in.ClientId, in.UserId, models.StatusPending, currency.Code).Scan(&data.PendingWithdrawals)
+
+	if err != nil {
+
+		log.Printf("error getting pending withdrawals of user %d %s ", in.UserId, err.Error())
+	}
+
+	return data
+}
+
+// FetchPlayerDeposit returns what a player of a client deposited between two dates in the default
+// currency in Balance, with the available balance of their default currency wallet
+func FetchPlayerDeposit(db *sql.DB, in *pbWallet.FetchPlayerDepositRequest) (success bool, status int32, message string, data *pbWallet.Wallet) {
+
+	if in.ClientId == 0 {
+
+		return false, 400, "Client is required", nil
+	}
+
+	currency, err := resolveCurrency(db, in.ClientId, "")
+	if err != nil {
+
+		log.Printf("error resolving currency of client %d %s", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch deposits", nil
+	}
+
+	var total float64
+
+	err = db.QueryRow("SELECT COALESCE(SUM(deposit_total), 0) FROM player_daily_totals WHERE client_id = ? AND user_id = ? AND currency = ? AND date BETWEEN ? AND ?",
+		in.ClientId, in.UserId, currency.Code, in.StartDate, in.EndDate).Scan(&total)
+
+	if err != nil {
+
+		log.Printf("error getting deposits of user %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to fetch deposits", nil
+	}
+
+	data = &pbWallet.Wallet{UserId: in.UserId, Balance: total}
+
+	err = db.QueryRow("SELECT available_balance FROM wallets WHERE user_id = ? AND client_id = ? AND "+defaultCurrencyWallet, in.UserId, in.ClientId).
+		Scan(&data.AvailableBalance)
+	if err != nil {
+
+		log.Printf("error getting wallet of user %d %s ", in.UserId, err.Error())
+	}
+
+	return true, 200, "Deposits retrieved", data
+}
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/pawapay"
+	"github.com/zoroplay/go-wallet-service/providers/paystack"
+	"github.com/zoroplay/go-wallet-service/providers/wayaquick"
+)
+
+// payout kinds a disbursement provider can pay to
+const (
+	payoutBank   = "bank"
+	payoutMobile = "mobile"
+)
+
+// payoutResult is what a provider said about a payout it was sent. Rejected payouts were refused
+// outright and can be routed elsewhere
+type payoutResult struct {
+	Reference string
+	Status    string
+	Message   string
+	Rejected  bool
+	Completed bool
+}
+
+// paymentProvider is what the service can do with a provider. Providers are looked up by the
+// provider name saved on payment methods, deposits and withdrawals
+type paymentProvider struct {
+	// payoutKind is the kind of payout the provider disburses, empty when it does not disburse
+	payoutKind string
+	// disburse sends a withdrawal. An error means the outcome is unknown and the payout must not be retried elsewhere
+	disburse func(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) (*payoutResult, error)
+	// verifyDeposit asks the provider for the outcome of a pending deposit and applies it
+	verifyDeposit func(db *sql.DB, pm *models.PaymentMethod, d *models.Deposit) error
+	// verifyPayout asks the provider for the outcome of a payout it accepted and applies it
+	verifyPayout func(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) error
+}
+
+var paymentProviders = map[string]paymentProvider{
+	paystackProvider:  {payoutKind: payoutBank, disburse: paystackDisburse, verifyPayout: paystackVerifyPayout},
+	pawapayProvider:   {payoutKind: payoutMobile, disburse: pawapayDisburse, verifyDeposit: pawapayVerifyDeposit, verifyPayout: pawapayVerifyPayout},
+	wayaquickProvider: {verifyDeposit: wayaquickVerifyDeposit},
+}
+
+func paystackDisburse(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) (*payoutResult, error) {
+
+	client := paystack.NewClient(pm.BaseURL, pm.SecretKey)
+
+	currency := w.Currency
+	if currency == "" {
+
+		currency = "NGN"
+	}
+
+	recipient, err := client.CreateRecipient(w.AccountName, w.AccountNumber, w.BankCode, currency)
+	if err != nil {
+
+		// nothing was sent, so another provider can be tried
+		return &payoutResult{Status: "REJECTED", Message: err.Error(), Rejected: true}, nil
+	}
+
+	reference := fmt.Sprintf("%s-%d", w.WithdrawalCode, time.Now().Unix())
+
+	transfer, err := client.InitiateTransfer(recipient.RecipientCode, reference, "Wallet withdrawal", int64(math.Round(w.Amount*100)))
+	if err != nil {
+
+		return &payoutResult{Reference: reference, Status: "UNCONFIRMED"}, err
+	}
+
+	status := strings.ToUpper(transfer.Status)
+
+	return &payoutResult{
+		Reference: reference,
+		Status:    status,
+		Rejected:  status == "FAILED",
+		Completed: status == "SUCCESS",
+	}, nil
+}
+
+// paystackVerifyPayout applies the state paystack reports for a transfer. Reversed transfers never
+// reached the player, so they are refunded like failed ones
+func paystackVerifyPayout(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) error {
+
+	transfer, err := paystack.NewClient(pm.BaseURL, pm.SecretKey).VerifyTransfer(w.ProviderReference)
+	if err != nil {
+
+		return err
+	}
+
+	status := strings.ToUpper(transfer.Status)
+
+	switch status {
+	case "SUCCESS":
+		_, err = completeWithdrawal(db, w, status)
+	case "FAILED", "REVERSED":
+		_, err = failWithdrawal(db, w, status, "Paystack transfer "+strings.ToLower(status))
+	default:
+		if status != w.ProviderStatus {
+
+			setWithdrawalProviderStatus(db, w, status)
+		}
+	}
+
+	return err
+}
+
+func pawapayDisburse(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) (*payoutResult, error) {
+
+	client := newPawapayClient(pm)
+
+	prediction, err := client.PredictCorrespondent(w.Msisdn)
+	if err != nil || prediction.Correspondent == "" {
+
+		return &payoutResult{Status: "REJECTED", Message: "Unable to resolve the operator of the phone number", Rejected: true}, nil
+	}
+
+	if w.Currency != "" && w.Currency != pawapay.Currency(prediction.Correspondent) {
+
+		return &payoutResult{Status: "REJECTED", Message: fmt.Sprintf("%s does not pay out in %s", prediction.Correspondent, w.Currency), Rejected: true}, nil
+	}
+
+	// pawapay takes the amount as sent, so it has to fit the currency's minor units
+	currency, err := resolveCurrency(db, int32(w.ClientID), w.Currency)
+	if err != nil {
+
+		return &payoutResult{Status: "REJECTED", Message: "Currency not supported", Rejected: true}, nil
+	}
+
+	amount, ok := currencyAmount(w.Amount, currency)
+	if !ok {
+
+		return &payoutResult{Status: "REJECTED", Message: fmt.Sprintf("Amount has more decimals than %s allows", currency.Code), Rejected: true}, nil
+	}
+
+	if err := validatePawapayPayout(client, w.Msisdn, prediction.Correspondent, amount); err != nil {
+
+		return &payoutResult{Status: "REJECTED", Message: err.Error(), Rejected: true}, nil
+	}
+
+	payoutId := pawapay.NewID()
+
+	res, err := client.InitiatePayout(pawapay.PayoutRequest{
+		PayoutID:             payoutId,
+		Amount:               strconv.FormatFloat(amount, 'f', currency.MinorUnits, 64),
+		Currency:             pawapay.Currency(prediction.Correspondent),
+		Correspondent:        prediction.Correspondent,
+		Recipient:            pawapay.Party{Type: "MSISDN", Address: pawapay.Address{Value: w.Msisdn}},
+		CustomerTimestamp:    pawapay.Timestamp(time.Now()),
+		StatementDescription: "Wallet withdrawal",
+	})
+
+	if err != nil {
+
+		return &payoutResult{Reference: payoutId, Status: "UNCONFIRMED"}, err
+	}
+
+	if res.Status == pawapay.StatusRejected {
+
+		return &payoutResult{Reference: payoutId, Status: res.Status, Message: rejectionMessage(res), Rejected: true}, nil
+	}
+
+	return &payoutResult{Reference: payoutId, Status: res.Status}, nil
+}
+
+func pawapayVerifyPayout(db *sql.DB, pm *models.PaymentMethod, w *models.Withdrawal) error {
+
+	transactions, err := newPawapayClient(pm).CheckPayout(w.ProviderReference)
+	if err != nil {
+
+		return err
+	}
+
+	for _, trx := range transactions {
+
+		if err := redrivePawapay(db, trx); err != nil {
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func pawapayVerifyDeposit(db *sql.DB, pm *models.PaymentMethod, d *models.Deposit) error {
+
+	client := newPawapayClient(pm)
+
+	transactions, err := client.CheckDeposit(d.Reference)
+	if err != nil {
+
+		return err
+	}
+
+	if len(transactions) == 0 {
+
+		// pawapay never received the deposit, so the player was not charged
+		_, err = failDeposit(db, d, "NOT_FOUND")
+		return err
+	}
+
+	return applyPawapayDeposit(db, d, transactions[0].Status)
+}
+
+func wayaquickVerifyDeposit(db *sql.DB, pm *models.PaymentMethod, d *models.Deposit) error {
+
+	if d.ProviderReference == "" {
+
+		return fmt.Errorf("deposit has no wayaquick reference")
+	}
+
+	client := wayaquick.NewClient(pm.BaseURL, pm.SecretKey, pm.PublicKey, pm.MerchantID)
+	return verifyWayaQuickDeposit(db, client, d)
+}
@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"database/sql"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+// sweepBatch is the most deposits of one provider verified per sweep, sweepMaxAttempts how many times a
+// deposit is checked before the sweep leaves it to the provider's callback or an admin
+const (
+	sweepBatch       = 100
+	sweepMaxAttempts = 20
+)
+
+var (
+	sweepMu    sync.Mutex
+	sweepStats = map[string]*models.SweepStat{}
+)
+
+// ReconcilePendingDeposits asks each provider for the outcome of deposits that have been pending longer
+// than olderThanMinutes, crediting or failing them, and counts what was fixed per provider
+func ReconcilePendingDeposits(db *sql.DB, olderThanMinutes int) {
+
+	for provider, p := range paymentProviders {
+
+		if p.verifyDeposit == nil {
+
+			continue
+		}
+
+		deposits, err := getPendingDeposits(db, provider, olderThanMinutes, sweepMaxAttempts, sweepBatch)
+		if err != nil {
+
+			log.Printf("error getting pending %s deposits %s ", provider, err.Error())
+			continue
+		}
+
+		if len(deposits) == 0 {
+
+			continue
+		}
+
+		var checked, credited, failed, errored int64
+		methods := map[int64]*models.PaymentMethod{}
+
+		for _, deposit := range deposits {
+
+			pm, ok := methods[deposit.ClientID]
+			if !ok {
+
+				pm, err = getPaymentMethod(db, int32(deposit.ClientID), provider)
+				if err != nil {
+
+					log.Printf("%s is not configured for client %d ", provider, deposit.ClientID)
+				}
+
+				methods[deposit.ClientID] = pm
+			}
+
+			if pm == nil {
+
+				continue
+			}
+
+			checked++
+
+			err := p.verifyDeposit(db, pm, deposit)
+
+			if deposit.Status == models.StatusPending && markDepositChecked(db, deposit) >= sweepMaxAttempts {
+
+				log.Printf("giving up on pending %s deposit %s after %d checks ", provider, deposit.Reference, sweepMaxAttempts)
+			}
+
+			if err != nil {
+
+				log.Printf("error verifying %s deposit %s %s ", provider, deposit.Reference, err.Error())
+				errored++
+				continue
+			}
+
+			switch deposit.Status {
+			case models.StatusCompleted:
+				credited++
+			case models.StatusFailed:
+				failed++
+			}
+		}
+
+		log.Printf("deposit sweep %s: checked %d, credited %d, failed %d, errors %d ", provider, checked, credited, failed, errored)
+		recordSweep(provider, checked, credited, failed, errored)
+	}
+}
+
+// ReconcilePendingPayouts asks each provider for the outcome of payouts it accepted that have been
+// pending longer than olderThanMinutes, completing them or refunding the player
+func ReconcilePendingPayouts(db *sql.DB, olderThanMinutes int) {
+
+	for provider, p := range paymentProviders {
+
+		if p.verifyPayout == nil {
+
+			continue
+		}
+
+		rows, err := db.Query("SELECT "+withdrawalColumns+" FROM withdrawals WHERE provider = ? AND status = ? AND provider_reference IS NOT NULL "+
+			" AND updated_at < NOW() - INTERVAL ? MINUTE ORDER BY updated_at LIMIT ?", provider, models.StatusPending, olderThanMinutes, sweepBatch)
+
+		if err != nil {
+
+			log.Printf("error getting pending %s payouts %s ", provider, err.Error())
+			continue
+		}
+
+		var withdrawals []*models.Withdrawal
+		for rows.Next() {
+
+			w, err := scanWithdrawal(rows)
+			if err != nil {
+
+				log.Printf("error scanning withdrawal %s ", err.Error())
+				continue
+			}
+
+			withdrawals = append(withdrawals, w)
+		}
+
+		rows.Close()
+
+		methods := map[int64]*models.PaymentMethod{}
+		var checked, errored int64
+
+		for _, w := range withdrawals {
+
+			pm, ok := methods[w.ClientID]
+			if !ok {
+
+				pm, err = getPaymentMethod(db, int32(w.ClientID), provider)
+				if err != nil {
+
+					log.Printf("%s is not configured for client %d ", provider, w.ClientID)
+				}
+
+				methods[w.ClientID] = pm
+			}
+
+			if pm == nil {
+
+				continue
+			}
+
+			checked++
+
+			if err := p.verifyPayout(db, pm, w); err != nil {
+
+				log.Printf("error verifying %s payout %s %s ", provider, w.WithdrawalCode, err.Error())
+				errored++
+			}
+		}
+
+		if checked > 0 {
+
+			log.Printf("payout sweep %s: checked %d, errors %d ", provider, checked, errored)
+		}
+	}
+}
+
+func recordSweep(provider string, checked, credited, failed, errored int64) {
+
+	sweepMu.Lock()
+	defer sweepMu.Unlock()
+
+	stat, ok := sweepStats[provider]
+	if !ok {
+
+		stat = &models.SweepStat{Provider: provider}
+		sweepStats[provider] = stat
+	}
+
+	stat.Checked += checked
+	stat.Credited += credited
+	stat.Failed += failed
+	stat.Errors += errored
+	stat.LastRun = time.Now().Format("2006-01-02 15:04:05")
+}
+
+// DepositSweepStats returns the totals of the deposit sweep per provider since the service started
+func DepositSweepStats() []models.SweepStat {
+
+	sweepMu.Lock()
+	defer sweepMu.Unlock()
+
+	stats := make([]models.SweepStat, 0, len(sweepStats))
+	for _, stat := range sweepStats {
+
+		stats = append(stats, *stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Provider < stats[j].Provider })
+
+	return stats
+}
@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// subjects of the shop legs of deposits and withdrawals handled at the counter
+const (
+	subjectShopDeposit         = "Shop Deposit"
+	subjectShopDepositReversal = "Shop Deposit Reversal"
+	subjectShopWithdrawal      = "Shop Withdrawal"
+)
+
+// ProcessShopDeposit takes cash for a player at the counter. The shop wallet of the branch (userId) is
+// debited, the player (id) is credited through a shop deposit, and the shop leg is tagged on the till
+// once both went through
+func ProcessShopDeposit(db *sql.DB, in *pbWallet.ProcessRetailTransaction) (success bool, status int32, message string, data *structpb.Struct) {
+
+	if in.Id == 0 || in.UserId == 0 {
+
+		return false, 400, "Player and shop are required", nil
+	}
+
+	if in.GetAmount() <= 0 {
+
+		return false, 400, "Amount must be greater than zero", nil
+	}
+
+	currency, err := resolveCurrency(db, in.ClientId, "")
+	if err != nil {
+
+		log.Printf("error resolving currency of client %d %s", in.ClientId, err.Error())
+		return false, 400, "Currency not supported", nil
+	}
+
+	amount := fmt.Sprintf("%.2f", in.GetAmount())
+	value, _ := strconv.ParseFloat(amount, 64)
+
+	ok, status, message, _, shopTrxNo := debitUser(db, &pbWallet.DebitUserRequest{
+		UserId:      in.UserId,
+		ClientId:    in.ClientId,
+		Amount:      amount,
+		Source:      "shop",
+		Description: fmt.Sprintf("Deposit for player %d", in.Id),
+		Wallet:      models.MainWallet,
+		Subject:     subjectShopDeposit,
+		Channel:     "shop",
+		Currency:    &currency.Code,
+	})
+
+	if !ok {
+
+		return false, status, message, nil
+	}
+
+	deposit := &models.Deposit{
+		ClientID:  int64(in.ClientId),
+		UserID:    int64(in.Id),
+		Username:  in.GetUsername(),
+		Provider:  "shop",
+		Reference: shopTrxNo,
+		Amount:    value,
+		Currency:  currency.Code,
+		Source:    "shop",
+		Status:    models.StatusPending,
+	}
+
+	if err = createDeposit(db, deposit); err == nil {
+
+		_, err = completeDeposit(db, deposit, "PAID")
+	}
+
+	if err != nil {
+
+		log.Printf("error crediting shop deposit %s to player %d %s ", shopTrxNo, in.Id, err.Error())
+
+		// give the shop its money back, the till never saw the deposit
+		ok, _, _, _, _ = creditUser(db, &pbWallet.CreditUserRequest{
+			UserId:      in.UserId,
+			ClientId:    in.ClientId,
+			Amount:      amount,
+			Source:      "shop",
+			Description: fmt.Sprintf("Reversal of deposit %s", shopTrxNo),
+			Wallet:      models.MainWallet,
+			Subject:     subjectShopDepositReversal,
+			Channel:     "shop",
+			Currency:    &currency.Code,
+		})
+
+		if !ok {
+
+			log.Printf("error reversing shop deposit %s of branch %d ", shopTrxNo, in.UserId)
+		}
+
+		return false, 500, "Unable to credit player", nil
+	}
+
+	tagTillTransaction(db, in.ClientId, in.UserId, shopTrxNo, subjectShopDeposit, "shop", deposit.Amount)
+
+	return true, 200, "Deposit successful", toStruct(deposit)
+}
+
+// ProcessShopWithdrawal pays out a pending withdrawal (id) in cash at the counter. The withdrawal is
+// claimed so no provider can pay it as well, the shop wallet of the branch (userId) is credited with the
+// cash it paid, less any charge, and that credit is tagged on the till
+func ProcessShopWithdrawal(db *sql.DB, in *pbWallet.ProcessRetailTransaction) (success bool, status int32, message string, data *structpb.Struct) {
+
+	w, err := scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE id = ? AND client_id = ?", in.Id, in.ClientId))
+	if err != nil {
+
+		if err != sql.ErrNoRows {
+
+			log.Printf("error getting withdrawal %d %s ", in.Id, err.Error())
+		}
+
+		return false, 404, "Withdrawal not found", nil
+	}
+
+	paid := math.Round((w.Amount-float64(in.GetWithdrawalCharge()))*100) / 100
+	if paid <= 0 {
+
+		return false, 400, "Withdrawal charge exceeds the amount", nil
+	}
+
+	// only a withdrawal no provider has picked up can be paid at the counter
+	res, err := db.Exec("UPDATE withdrawals SET status = ?, provider = 'shop', provider_status = 'PAID', updated_by = ? "+
+		" WHERE id = ? AND status = ? AND provider IS NULL AND provider_status IS NULL",
+		models.StatusCompleted, in.GetUsername(), w.ID, models.StatusPending)
+
+	if err != nil {
+
+		log.Printf("error claiming withdrawal %d %s ", w.ID, err.Error())
+		return false, 500, "Unable to process withdrawal", nil
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, 400, "Withdrawal has already been processed", nil
+	}
+
+	ok, status, message, _, shopTrxNo := creditUser(db, &pbWallet.CreditUserRequest{
+		UserId:      in.UserId,
+		ClientId:    in.ClientId,
+		Amount:      fmt.Sprintf("%.2f", paid),
+		Source:      "shop",
+		Description: fmt.Sprintf("Payout of withdrawal %s", w.WithdrawalCode),
+		Wallet:      models.MainWallet,
+		Subject:     subjectShopWithdrawal,
+		Channel:     "shop",
+		Currency:    &w.Currency,
+	})
+
+	if !ok {
+
+		// leave it for another counter or a provider
+		_, err = db.Exec("UPDATE withdrawals SET status = ?, provider = NULL, provider_status = NULL WHERE id = ?", models.StatusPending, w.ID)
+		if err != nil {
+
+			log.Printf("error releasing withdrawal %d %s ", w.ID, err.Error())
+		}
+
+		return false, status, message, nil
+	}
+
+	tagTillTransaction(db, in.ClientId, in.UserId, shopTrxNo, subjectShopWithdrawal, "shop", paid)
+
+	w.Status = models.StatusCompleted
+	w.Provider = "shop"
+	w.ProviderStatus = "PAID"
+
+	return true, 200, "Withdrawal paid", toStruct(w)
+}
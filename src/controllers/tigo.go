@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const tigoProvider = "tigo"
+
+// TigoWebhook applies a Tigo deposit or disbursement outcome to the record with the given reference.
+// The body must be signed with the client's Tigo secret, and the reference and outcome are read from
+// it rather than from the unsigned request fields. Records only move out of pending once, so repeated
+// notifications are acknowledged without effect
+func TigoWebhook(db *sql.DB, in *pbWallet.TigoWebhookRequest) (success bool, message string) {
+
+	log.Printf("Tigo webhook %s event %s ", in.Reference, in.Event)
+
+	success, message, status := applyTigoWebhook(db, in)
+	logCallback(db, in.ClientId, tigoProvider, in.Reference, in.Event, status, in.Body, message)
+
+	return success, message
+}
+
+// tigoNotification is the part of a Tigo webhook body we act on. Tigo sends the outcome either as a
+// boolean or as a status word
+type tigoNotification struct {
+	ReferenceID string          `json:"ReferenceID"`
+	Status      json.RawMessage `json:"Status"`
+}
+
+// parseTigoNotification reads the reference and whether the payment went through from a webhook body
+func parseTigoNotification(body string) (string, bool, error) {
+
+	var n tigoNotification
+	if err := json.Unmarshal([]byte(body), &n); err != nil {
+
+		return "", false, err
+	}
+
+	if n.ReferenceID == "" {
+
+		return "", false, fmt.Errorf("no reference in tigo notification")
+	}
+
+	var paid bool
+	if err := json.Unmarshal(n.Status, &paid); err == nil {
+
+		return n.ReferenceID, paid, nil
+	}
+
+	var word string
+	if err := json.Unmarshal(n.Status, &word); err != nil {
+
+		return "", false, fmt.Errorf("no status in tigo notification %s", n.ReferenceID)
+	}
+
+	switch strings.ToUpper(word) {
+	case "SUCCESS", "SUCCESSFUL", "COMPLETED", "TRUE":
+		return n.ReferenceID, true, nil
+	case "FAIL", "FAILED", "FAILURE", "REJECTED", "FALSE":
+		return n.ReferenceID, false, nil
+	default:
+		return "", false, fmt.Errorf("unknown status %s in tigo notification %s", word, n.ReferenceID)
+	}
+}
+
+func applyTigoWebhook(db *sql.DB, in *pbWallet.TigoWebhookRequest) (bool, string, string) {
+
+	pm, err := getPaymentMethod(db, in.ClientId, tigoProvider)
+	if err != nil {
+
+		log.Printf("error getting tigo settings for client %d %s ", in.ClientId, err.Error())
+		return false, "Tigo is not configured for this client", ""
+	}
+
+	if !validTigoSignature(pm.SecretKey, in.Body, in.Signature) {
+
+		log.Printf("rejected tigo webhook %s for client %d ", in.Reference, in.ClientId)
+		return false, "Invalid signature", ""
+	}
+
+	reference, paid, err := parseTigoNotification(in.Body)
+	if err != nil {
+
+		log.Printf("error reading tigo webhook for client %d %s ", in.ClientId, err.Error())
+		return false, "Invalid notification", ""
+	}
+
+	// the reference of the request is not signed, it has to be the one in the body
+	if in.Reference != "" && in.Reference != reference {
+
+		log.Printf("rejected tigo webhook %s for client %d, the body is for %s ", in.Reference, in.ClientId, reference)
+		return false, "Invalid notification", ""
+	}
+
+	status := "FAILED"
+	if paid {
+
+		status = "COMPLETED"
+	}
+
+	event := strings.ToLower(in.Event)
+	if !strings.Contains(event, "disburse") && !strings.Contains(event, "payout") && !strings.Contains(event, "withdraw") {
+
+		deposit, err := getDepositByReference(db, tigoProvider, reference)
+		if err == sql.ErrNoRows || (err == nil && deposit.ClientID != int64(in.ClientId)) {
+
+			return false, "Transaction not found", status
+		}
+
+		if err != nil {
+
+			log.Printf("error getting tigo deposit %s %s ", reference, err.Error())
+			return false, "Unable to process notification", status
+		}
+
+		success, message := applyTigoDeposit(db, deposit, paid, status)
+		return success, message, status
+	}
+
+	withdrawal, err := scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE client_id = ? AND provider = ? "+
+		" AND (withdrawal_code = ? OR provider_reference = ?) ORDER BY id DESC LIMIT 1", in.ClientId, tigoProvider, reference, reference))
+
+	if err == sql.ErrNoRows {
+
+		return false, "Transaction not found", status
+	}
+
+	if err != nil {
+
+		log.Printf("error getting tigo withdrawal %s %s ", reference, err.Error())
+		return false, "Unable to process notification", status
+	}
+
+	success, message := applyTigoDisbursement(db, withdrawal, paid, status)
+	return success, message, status
+}
+
+// validTigoSignature checks the hex HMAC-SHA256 of a webhook body under the client's secret
+func validTigoSignature(secret, body, signature string) bool {
+
+	if secret == "" || signature == "" {
+
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(signature), "sha256="))
+	if err != nil {
+
+		return false
+	}
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func applyTigoDeposit(db *sql.DB, deposit *models.Deposit, paid bool, status string) (bool, string) {
+
+	if deposit.Status != models.StatusPending {
+
+		return true, "Transaction already processed"
+	}
+
+	if !paid {
+
+		if _, err := failDeposit(db, deposit, status); err != nil {
+
+			log.Printf("error failing tigo deposit %s %s ", deposit.Reference, err.Error())
+			return false, "Unable to process deposit"
+		}
+
+		return true, "Deposit failed"
+	}
+
+	credited, err := completeDeposit(db, deposit, status)
+	if err != nil {
+
+		log.Printf("error crediting tigo deposit %s %s ", deposit.Reference, err.Error())
+		return false, "Unable to credit deposit"
+	}
+
+	if !credited {
+
+		return true, "Transaction already processed"
+	}
+
+	return true, "Deposit credited"
+}
+
+func applyTigoDisbursement(db *sql.DB, withdrawal *models.Withdrawal, paid bool, status string) (bool, string) {
+
+	if withdrawal.Status != models.StatusPending {
+
+		return true, "Transaction already processed"
+	}
+
+	if paid {
+
+		if _, err := completeWithdrawal(db, withdrawal, status); err != nil {
+
+			log.Printf("error completing tigo disbursement %s %s ", withdrawal.WithdrawalCode, err.Error())
+			return false, "Unable to process disbursement"
+		}
+
+		return true, "Disbursement completed"
+	}
+
+	refunded, err := failWithdrawal(db, withdrawal, status, "Tigo disbursement failed")
+	if err != nil {
+
+		log.Printf("error refunding tigo disbursement %s %s ", withdrawal.WithdrawalCode, err.Error())
+		return false, "Unable to refund disbursement"
+	}
+
+	if !refunded {
+
+		return true, "Transaction already processed"
+	}
+
+	return true, "Disbursement failed and refunded"
+}
@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"testing"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const tigoSecret = "tigo-secret"
+
+func signTigo(body string) string {
+
+	mac := hmac.New(sha256.New, []byte(tigoSecret))
+	mac.Write([]byte(body))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// tigoDB returns a database holding the tigo settings of client 1
+func tigoDB(t *testing.T) (*sql.DB, *fakeDB) {
+
+	db, fake := newFakeDB(t)
+
+	fake.On("FROM payment_methods", paymentMethodFields, []driver.Value{
+		int64(2), int64(1), "Tigo", tigoProvider, tigoSecret, nil, nil, nil, int64(1), int64(1),
+		0.0, 0.0, int64(0), nil, nil,
+	})
+
+	return db, fake
+}
+
+func tigoRequest(event, reference, body string) *pbWallet.TigoWebhookRequest {
+
+	return &pbWallet.TigoWebhookRequest{ClientId: 1, Reference: reference, Event: event, Body: body, Signature: signTigo(body)}
+}
+
+func TestValidTigoSignature(t *testing.T) {
+
+	body := `{"ReferenceID":"T-1","Status":true}`
+
+	cases := []struct {
+		secret, body, signature string
+		valid                   bool
+	}{
+		{tigoSecret, body, signTigo(body), true},
+		{tigoSecret, body, "sha256=" + signTigo(body), true},
+		{tigoSecret, `{"ReferenceID":"T-1","Status":false}`, signTigo(body), false},
+		{"other", body, signTigo(body), false},
+		{tigoSecret, body, "", false},
+		{"", body, signTigo(body), false},
+		{tigoSecret, body, "not-hex", false},
+	}
+
+	for i, c := range cases {
+
+		if got := validTigoSignature(c.secret, c.body, c.signature); got != c.valid {
+
+			t.Errorf("case %d: expected %v, got %v", i, c.valid, got)
+		}
+	}
+}
+
+func TestParseTigoNotification(t *testing.T) {
+
+	cases := []struct {
+		body      string
+		reference string
+		paid      bool
+		valid     bool
+	}{
+		{`{"ReferenceID":"T-1","Status":true}`, "T-1", true, true},
+		{`{"ReferenceID":"T-1","Status":false}`, "T-1", false, true},
+		{`{"referenceId":"T-1","status":"SUCCESS"}`, "T-1", true, true},
+		{`{"ReferenceID":"T-1","Status":"FAILED"}`, "T-1", false, true},
+		{`{"ReferenceID":"T-1","Status":"PENDING"}`, "", false, false},
+		{`{"ReferenceID":"T-1"}`, "", false, false},
+		{`{"Status":true}`, "", false, false},
+		{`not json`, "", false, false},
+	}
+
+	for _, c := range cases {
+
+		reference, paid, err := parseTigoNotification(c.body)
+		if (err == nil) != c.valid || reference != c.reference || paid != c.paid {
+
+			t.Errorf("%s: expected %s %v %v, got %s %v %v", c.body, c.reference, c.paid, c.valid, reference, paid, err)
+		}
+	}
+}
+
+func TestTigoDepositCompleted(t *testing.T) {
+
+	db, fake := tigoDB(t)
+	openWallet(fake, 0)
+	fake.On("FROM deposits WHERE provider = ? AND reference = ?", depositFields, pendingDeposit("T-1"))
+
+	// the unsigned status says failed, the signed body says paid
+	in := tigoRequest("deposit", "T-1", `{"ReferenceID":"T-1","Status":true}`)
+	in.Status = false
+
+	ok, message := TigoWebhook(db, in)
+	if !ok || message != "Deposit credited" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	updates := fake.Ran("UPDATE deposits SET status = ?")
+	if len(updates) != 1 || updates[0].args[0] != int64(models.StatusCompleted) {
+
+		t.Fatalf("expected the deposit to be completed, got %+v", updates)
+	}
+
+	credits := fake.Ran("INSERT INTO transactions")
+	if len(credits) != 1 || credits[0].args[5] != models.SubjectDeposit {
+
+		t.Fatalf("expected the deposit to be credited, got %+v", credits)
+	}
+}
+
+func TestTigoDepositFailed(t *testing.T) {
+
+	db, fake := tigoDB(t)
+	fake.On("FROM deposits WHERE provider = ? AND reference = ?", depositFields, pendingDeposit("T-1"))
+
+	in := tigoRequest("deposit", "T-1", `{"ReferenceID":"T-1","Status":"FAILED"}`)
+	in.Status = true
+
+	ok, message := TigoWebhook(db, in)
+	if !ok || message != "Deposit failed" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	updates := fake.Ran("UPDATE deposits SET status = ?")
+	if len(updates) != 1 || updates[0].args[0] != int64(models.StatusFailed) {
+
+		t.Fatalf("expected the deposit to be failed, got %+v", updates)
+	}
+
+	if credits := fake.Ran("INSERT INTO transactions"); len(credits) != 0 {
+
+		t.Fatalf("expected no credit, got %+v", credits)
+	}
+}
+
+func TestTigoDepositOfAnotherClient(t *testing.T) {
+
+	db, fake := tigoDB(t)
+
+	row := pendingDeposit("T-1")
+	row[1] = int64(2)
+	fake.On("FROM deposits WHERE provider = ? AND reference = ?", depositFields, row)
+
+	ok, message := TigoWebhook(db, tigoRequest("deposit", "T-1", `{"ReferenceID":"T-1","Status":true}`))
+	if ok || message != "Transaction not found" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	if updates := fake.Ran("UPDATE deposits"); len(updates) != 0 {
+
+		t.Fatalf("expected the deposit untouched, got %+v", updates)
+	}
+}
+
+func TestTigoDisbursementCompleted(t *testing.T) {
+
+	db, fake := tigoDB(t)
+	fake.On("FROM withdrawals WHERE client_id = ? AND provider = ?", withdrawalFields, pendingWithdrawal("T-2"))
+
+	ok, message := TigoWebhook(db, tigoRequest("disbursement", "T-2", `{"ReferenceID":"T-2","Status":true}`))
+	if !ok || message != "Disbursement completed" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	updates := fake.Ran("UPDATE withdrawals SET status = ?")
+	if len(updates) != 1 || updates[0].args[0] != int64(models.StatusCompleted) {
+
+		t.Fatalf("expected the withdrawal to be completed, got %+v", updates)
+	}
+
+	if credits := fake.Ran("INSERT INTO transactions"); len(credits) != 0 {
+
+		t.Fatalf("expected no refund, got %+v", credits)
+	}
+}
+
+func TestTigoDisbursementFailedRefunds(t *testing.T) {
+
+	db, fake := tigoDB(t)
+	openWallet(fake, 0)
+	fake.On("FROM withdrawals WHERE client_id = ? AND provider = ?", withdrawalFields, pendingWithdrawal("T-2"))
+
+	ok, message := TigoWebhook(db, tigoRequest("disbursement", "T-2", `{"ReferenceID":"T-2","Status":false}`))
+	if !ok || message != "Disbursement failed and refunded" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	updates := fake.Ran("UPDATE withdrawals SET status = ?")
+	if len(updates) != 1 || updates[0].args[0] != int64(models.StatusFailed) {
+
+		t.Fatalf("expected the withdrawal to be failed, got %+v", updates)
+	}
+
+	refunds := fake.Ran("INSERT INTO transactions")
+	if len(refunds) != 1 || refunds[0].args[5] != models.SubjectWithdrawalRefund {
+
+		t.Fatalf("expected the withdrawal to be refunded, got %+v", refunds)
+	}
+}
+
+func TestTigoWebhookRejected(t *testing.T) {
+
+	body := `{"ReferenceID":"T-1","Status":true}`
+
+	cases := map[string]*pbWallet.TigoWebhookRequest{
+		"bad signature":   {ClientId: 1, Reference: "T-1", Event: "deposit", Body: body, Signature: signTigo(`{"ReferenceID":"T-1","Status":false}`)},
+		"other reference": tigoRequest("deposit", "T-9", body),
+		"unreadable body": tigoRequest("deposit", "T-1", `{"ReferenceID":"T-1"}`),
+	}
+
+	for name, in := range cases {
+
+		t.Run(name, func(t *testing.T) {
+
+			db, fake := tigoDB(t)
+			fake.On("FROM deposits", depositFields, pendingDeposit("T-1"))
+			fake.On("FROM withdrawals", withdrawalFields, pendingWithdrawal("T-1"))
+
+			if ok, message := TigoWebhook(db, in); ok {
+
+				t.Fatalf("expected the webhook to be rejected, got %s", message)
+			}
+
+			if updates := append(fake.Ran("UPDATE deposits"), fake.Ran("UPDATE withdrawals")...); len(updates) != 0 {
+
+				t.Fatalf("expected nothing applied, got %+v", updates)
+			}
+		})
+	}
+}
+
+func TestTigoWebhookNotConfigured(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("FROM deposits", depositFields, pendingDeposit("T-1"))
+
+	body := `{"ReferenceID":"T-1","Status":true}`
+
+	ok, message := TigoWebhook(db, tigoRequest("deposit", "T-1", body))
+	if ok || message != "Tigo is not configured for this client" {
+
+		t.Fatalf("unexpected result %v %s", ok, message)
+	}
+
+	if updates := fake.Ran("UPDATE deposits"); len(updates) != 0 {
+
+		t.Fatalf("expected nothing applied, got %+v", updates)
+	}
+}
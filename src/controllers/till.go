@@ -0,0 +1,387 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const tillSessionColumns = "id, client_id, branch_id, cashier_id, opening_float, expected_cash, counted_cash, variance, status, handed_over_from, " +
+	"handed_over_to, comment, opened_at, closed_at"
+
+// cashbook subjects tagged on till sessions, next to the shop subjects of the sales report
+var tillCashbookSubjects = map[string]string{
+	"Cash In":  models.CashIn,
+	"Cash Out": models.CashOut,
+	"Expense":  "expense",
+}
+
+// tillCategory returns the till category of a shop wallet transaction and the cash it moved in or out
+// of the till, or false when the transaction does not involve the till. Selling at the counter and cash
+// from head office put cash in, payouts, cash handed to head office and expenses take it out
+func tillCategory(subject, source string, amount float64) (string, float64, bool) {
+
+	if category, ok := models.ShopSubjects[subject]; ok {
+
+		if category == models.NormalSales || category == models.OnlineSales {
+
+			return category, amount, true
+		}
+
+		return category, -amount, true
+	}
+
+	if category, ok := tillCashbookSubjects[subject]; ok && source == "cashbook" {
+
+		if category == models.CashIn {
+
+			return category, amount, true
+		}
+
+		return category, -amount, true
+	}
+
+	return "", 0, false
+}
+
+// tagWalletTransaction tags a wallet transaction on the till of the branch that made it. Only the main
+// wallet of a branch holds the till's money, and deposits and withdrawals handled at the counter are
+// tagged by the retail processing once both legs went through, so they are skipped here
+func tagWalletTransaction(db *sql.DB, clientId, userId int32, walletType models.WalletType, transactionNo, subject, source string, amount float64) {
+
+	if walletType.Name != models.MainWallet || subject == subjectShopDeposit || subject == subjectShopWithdrawal {
+
+		return
+	}
+
+	tagTillTransaction(db, clientId, userId, transactionNo, subject, source, amount)
+}
+
+// tagTillTransaction tags a shop wallet transaction with the till session open on the branch, if any
+func tagTillTransaction(db *sql.DB, clientId, branchId int32, transactionNo, subject, source string, amount float64) {
+
+	category, cash, ok := tillCategory(subject, source, amount)
+	if !ok {
+
+		return
+	}
+
+	_, err := db.Exec("INSERT INTO till_session_entries (session_id, transaction_no, subject, category, amount, cash, created_at) "+
+		" SELECT id, ?, ?, ?, ?, ?, NOW() FROM till_sessions WHERE client_id = ? AND open_branch_id = ?",
+		transactionNo, subject, category, amount, cash, clientId, branchId)
+
+	if err != nil {
+
+		log.Printf("error tagging transaction %s on till of branch %d %s ", transactionNo, branchId, err.Error())
+	}
+}
+
+func scanTillSession(row rowScanner) (*models.TillSession, error) {
+
+	var t models.TillSession
+	var expected, counted, variance sql.NullFloat64
+	var from, to sql.NullInt64
+	var comment, closedAt sql.NullString
+
+	err := row.Scan(&t.ID, &t.ClientID, &t.BranchID, &t.CashierID, &t.OpeningFloat, &expected, &counted, &variance, &t.Status, &from, &to,
+		&comment, &t.OpenedAt, &closedAt)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	t.ExpectedCash = expected.Float64
+	t.CountedCash = counted.Float64
+	t.Variance = variance.Float64
+	t.HandedOverFrom = from.Int64
+	t.HandedOverTo = to.Int64
+	t.Comment = comment.String
+	t.ClosedAt = closedAt.String
+
+	return &t, nil
+}
+
+func getTillSession(db *sql.DB, id int64) (*models.TillSession, error) {
+
+	return scanTillSession(db.QueryRow("SELECT "+tillSessionColumns+" FROM till_sessions WHERE id = ?", id))
+}
+
+func getOpenTillSession(db *sql.DB, clientId, branchId int64) (*models.TillSession, error) {
+
+	return scanTillSession(db.QueryRow("SELECT "+tillSessionColumns+" FROM till_sessions WHERE client_id = ? AND open_branch_id = ?", clientId, branchId))
+}
+
+// tillTotals adds up the tagged transactions of a session per category and works out the cash the
+// till should hold
+func tillTotals(db *sql.DB, t *models.TillSession) error {
+
+	rows, err := db.Query("SELECT category, SUM(amount), SUM(cash) FROM till_session_entries WHERE session_id = ? GROUP BY category", t.ID)
+	if err != nil {
+
+		return err
+	}
+
+	defer rows.Close()
+
+	t.Totals = map[string]float64{}
+	expected := t.OpeningFloat
+
+	for rows.Next() {
+
+		var category string
+		var amount, cash float64
+
+		if err := rows.Scan(&category, &amount, &cash); err != nil {
+
+			return err
+		}
+
+		t.Totals[category] = amount
+		expected += cash
+	}
+
+	if t.Status == models.TillOpen {
+
+		t.ExpectedCash = expected
+	}
+
+	return rows.Err()
+}
+
+func tillSessionData(db *sql.DB, t *models.TillSession) *structpb.Struct {
+
+	if err := tillTotals(db, t); err != nil {
+
+		log.Printf("error getting totals of till session %d %s ", t.ID, err.Error())
+	}
+
+	return toStruct(t)
+}
+
+func openTillSession(db *sql.DB, clientId, branchId, cashierId int64, openingFloat float64, handedOverFrom int64) (*models.TillSession, error) {
+
+	res, err := db.Exec("INSERT INTO till_sessions (client_id, branch_id, cashier_id, opening_float, status, open_branch_id, handed_over_from, opened_at) "+
+		" VALUES (?,?,?,?,?,?,?,NOW())", clientId, branchId, cashierId, openingFloat, models.TillOpen, branchId, nullInt(handedOverFrom))
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	id, _ := res.LastInsertId()
+	return getTillSession(db, id)
+}
+
+// closeTillSession closes an open session with the cash counted in the till and records the variance
+func closeTillSession(db *sql.DB, t *models.TillSession, counted float64, comment string) error {
+
+	if err := tillTotals(db, t); err != nil {
+
+		return err
+	}
+
+	variance := math.Round((counted-t.ExpectedCash)*100) / 100
+
+	res, err := db.Exec("UPDATE till_sessions SET status = ?, open_branch_id = NULL, expected_cash = ?, counted_cash = ?, variance = ?, comment = ?, "+
+		" closed_at = NOW() WHERE id = ? AND status = ?", models.TillClosed, t.ExpectedCash, counted, variance, nullString(comment), t.ID, models.TillOpen)
+
+	if err != nil {
+
+		return err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return sql.ErrNoRows
+	}
+
+	t.Status = models.TillClosed
+	t.CountedCash = counted
+	t.Variance = variance
+	t.Comment = comment
+
+	return nil
+}
+
+func nullInt(v int64) sql.NullInt64 {
+
+	return sql.NullInt64{Int64: v, Valid: v != 0}
+}
+
+// OpenTillSession starts a cashier's shift on a branch till with the float they counted. A branch has
+// one open till at a time, so everything the shop wallet handles belongs to the open session
+func OpenTillSession(db *sql.DB, in *pbWallet.TillSessionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("Opening till of branch %d for cashier %d ", in.BranchId, in.CashierId)
+
+	if in.Amount < 0 {
+
+		return false, 400, "Opening float cannot be negative", nil
+	}
+
+	if open, err := getOpenTillSession(db, int64(in.ClientId), int64(in.BranchId)); err == nil {
+
+		return false, 400, fmt.Sprintf("The till is already open for cashier %d, hand it over or close it first", open.CashierID), nil
+	}
+
+	t, err := openTillSession(db, int64(in.ClientId), int64(in.BranchId), int64(in.CashierId), in.Amount, 0)
+	if err != nil {
+
+		log.Printf("error opening till of branch %d %s ", in.BranchId, err.Error())
+		return false, 400, "Unable to open till, it may already be open", nil
+	}
+
+	return true, 201, "Till opened", tillSessionData(db, t)
+}
+
+// CloseTillSession ends the open session of a branch with the cash counted in the till
+func CloseTillSession(db *sql.DB, in *pbWallet.TillSessionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	t, err := getOpenTillSession(db, int64(in.ClientId), int64(in.BranchId))
+	if err != nil {
+
+		return false, 404, "The till is not open", nil
+	}
+
+	if in.SessionId != nil && int64(in.GetSessionId()) != t.ID {
+
+		return false, 400, "Session is not the open session of the till", nil
+	}
+
+	if err := closeTillSession(db, t, in.Amount, in.GetComment()); err != nil {
+
+		log.Printf("error closing till session %d %s ", t.ID, err.Error())
+		return false, 500, "Unable to close till", nil
+	}
+
+	return true, 200, "Till closed", tillSessionData(db, t)
+}
+
+// HandoverTillSession closes the open session with the cash counted by both cashiers and opens the
+// next cashier's session with that cash as its float
+func HandoverTillSession(db *sql.DB, in *pbWallet.TillSessionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	if in.GetToCashierId() == 0 {
+
+		return false, 400, "The cashier taking over is required", nil
+	}
+
+	t, err := getOpenTillSession(db, int64(in.ClientId), int64(in.BranchId))
+	if err != nil {
+
+		return false, 404, "The till is not open", nil
+	}
+
+	if err := closeTillSession(db, t, in.Amount, in.GetComment()); err != nil {
+
+		log.Printf("error closing till session %d %s ", t.ID, err.Error())
+		return false, 500, "Unable to close till", nil
+	}
+
+	next, err := openTillSession(db, t.ClientID, t.BranchID, int64(in.GetToCashierId()), in.Amount, t.ID)
+	if err != nil {
+
+		log.Printf("error opening till of branch %d after handover %s ", t.BranchID, err.Error())
+		return false, 500, "Till was closed but the next session could not be opened", tillSessionData(db, t)
+	}
+
+	_, err = db.Exec("UPDATE till_sessions SET handed_over_to = ? WHERE id = ?", next.ID, t.ID)
+	if err != nil {
+
+		log.Printf("error linking till session %d to %d %s ", t.ID, next.ID, err.Error())
+	}
+
+	t.HandedOverTo = next.ID
+
+	return true, 200, "Till handed over", toStruct(map[string]interface{}{
+		"closed": tillSessionData(db, t),
+		"opened": tillSessionData(db, next),
+	})
+}
+
+// GetTillSession returns a session with its totals, or the open session of the branch when no session is given
+func GetTillSession(db *sql.DB, in *pbWallet.TillSessionRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	var t *models.TillSession
+	var err error
+
+	if in.SessionId != nil {
+
+		t, err = getTillSession(db, int64(in.GetSessionId()))
+		if err == nil && t.ClientID != int64(in.ClientId) {
+
+			err = sql.ErrNoRows
+		}
+	} else {
+
+		t, err = getOpenTillSession(db, int64(in.ClientId), int64(in.BranchId))
+	}
+
+	if err != nil {
+
+		return false, 404, "Till session not found", nil
+	}
+
+	return true, 200, "Till session retrieved", tillSessionData(db, t)
+}
+
+// branchTillSessions returns the sessions of a branch opened on a day, with their totals
+func branchTillSessions(db *sql.DB, clientId, branchId int64, date string) ([]*models.TillSession, error) {
+
+	rows, err := db.Query("SELECT "+tillSessionColumns+" FROM till_sessions WHERE client_id = ? AND branch_id = ? "+
+		" AND opened_at >= ? AND opened_at < ? + INTERVAL 1 DAY ORDER BY id", clientId, branchId, date, date)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	var sessions []*models.TillSession
+	for rows.Next() {
+
+		t, err := scanTillSession(rows)
+		if err != nil {
+
+			rows.Close()
+			return nil, err
+		}
+
+		sessions = append(sessions, t)
+	}
+
+	rows.Close()
+
+	for _, t := range sessions {
+
+		if err := tillTotals(db, t); err != nil {
+
+			return nil, err
+		}
+	}
+
+	return sessions, nil
+}
+
+func ListTillSessions(db *sql.DB, in *pbWallet.BranchRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	date, err := reportDate(in.GetDate())
+	if err != nil {
+
+		return false, 400, "Invalid date, use YYYY-MM-DD", nil
+	}
+
+	sessions, err := branchTillSessions(db, int64(in.ClientId), int64(in.BranchId), date.Format("2006-01-02"))
+	if err != nil {
+
+		log.Printf("error getting till sessions of branch %d %s ", in.BranchId, err.Error())
+		return false, 500, "Unable to fetch till sessions", nil
+	}
+
+	return true, 200, "Till sessions retrieved", toStructList(sessions)
+}
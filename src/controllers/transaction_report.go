@@ -0,0 +1,265 @@
+package controllers
+
+import (
+	"database/sql"
+	"log"
+	"sort"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const transactionsPerPage = 50
+
+// transactionTypeCase returns the SQL expression giving the report type of a transaction from its
+// source and subject, and the condition matching money transactions with its arguments
+func transactionTypeCase() (string, []interface{}, string, []interface{}) {
+
+	bySubject := map[string][]interface{}{}
+	var moneySubjects []interface{}
+
+	for subject, t := range models.TransactionSubjects {
+
+		bySubject[t.Type] = append(bySubject[t.Type], subject)
+
+		if t.Class == models.MoneyTransaction {
+
+			moneySubjects = append(moneySubjects, subject)
+		}
+	}
+
+	sources := make([]string, 0, len(models.TransactionSources))
+	for source := range models.TransactionSources {
+
+		sources = append(sources, source)
+	}
+
+	sort.Strings(sources)
+
+	types := make([]string, 0, len(bySubject))
+	for t := range bySubject {
+
+		types = append(types, t)
+	}
+
+	sort.Strings(types)
+
+	expr := "CASE "
+	var args []interface{}
+	var systemSources []interface{}
+
+	for _, source := range sources {
+
+		expr += " WHEN source = ? THEN ? "
+		args = append(args, source, models.TransactionSources[source].Type)
+
+		if models.TransactionSources[source].Class == models.SystemTransaction {
+
+			systemSources = append(systemSources, source)
+		}
+	}
+
+	for _, t := range types {
+
+		expr += " WHEN subject IN (?" + strings.Repeat(",?", len(bySubject[t])-1) + ") THEN ? "
+		args = append(args, bySubject[t]...)
+		args = append(args, t)
+	}
+
+	for _, k := range models.TransactionKeywords {
+
+		expr += " WHEN subject LIKE ? THEN ? "
+		args = append(args, "%"+k.Keyword+"%", k.Type)
+	}
+
+	money := " COALESCE(subject, '') IN (?" + strings.Repeat(",?", len(moneySubjects)-1) + ") "
+	moneyArgs := moneySubjects
+
+	if len(systemSources) > 0 {
+
+		money += " AND COALESCE(source, '') NOT IN (?" + strings.Repeat(",?", len(systemSources)-1) + ") "
+		moneyArgs = append(moneyArgs, systemSources...)
+	}
+
+	return expr + " ELSE 'other' END", args, money, moneyArgs
+}
+
+// transactionReport lists the money or system transactions of a client with the totals per type of
+// the whole filtered set
+func transactionReport(db *sql.DB, class string, in *pbWallet.GetTransactionsRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	typeCase, typeArgs, money, moneyArgs := transactionTypeCase()
+
+	if class == models.SystemTransaction {
+
+		money = " NOT (" + money + ") "
+	}
+
+	where := " WHERE client_id = ? AND " + money
+	args := append([]interface{}{in.ClientId}, moneyArgs...)
+
+	if in.From != "" {
+
+		where += " AND created_at >= ? "
+		args = append(args, in.From)
+	}
+
+	if in.To != "" {
+
+		where += " AND created_at < ? + INTERVAL 1 DAY "
+		args = append(args, in.To)
+	}
+
+	if in.GetReferenceNo() != "" {
+
+		where += " AND transaction_no = ? "
+		args = append(args, in.GetReferenceNo())
+	}
+
+	if in.GetUsername() != "" {
+
+		where += " AND username = ? "
+		args = append(args, in.GetUsername())
+	}
+
+	if in.GetKeyword() != "" {
+
+		where += " AND (subject LIKE ? OR description LIKE ?) "
+		args = append(args, "%"+in.GetKeyword()+"%", "%"+in.GetKeyword()+"%")
+	}
+
+	// the type is either credit or debit, or one of the report types
+	switch t := strings.ToLower(in.GetTransactionType()); t {
+	case "":
+	case "credit", "debit":
+		where += " AND tranasaction_type = ? "
+		args = append(args, t)
+	default:
+		where += " AND " + typeCase + " = ? "
+		args = append(args, typeArgs...)
+		args = append(args, t)
+	}
+
+	// amounts in different currencies are never added together
+	rows, err := db.Query("SELECT "+typeCase+" t, currency, COUNT(*), COALESCE(SUM(CASE WHEN tranasaction_type = 'credit' THEN amount END), 0), "+
+		" COALESCE(SUM(CASE WHEN tranasaction_type = 'debit' THEN amount END), 0) FROM transactions "+where+" GROUP BY t, currency ORDER BY t, currency",
+		append(append([]interface{}{}, typeArgs...), args...)...)
+
+	if err != nil {
+
+		log.Printf("error getting %s transaction totals %s ", class, err.Error())
+		return false, 500, "Unable to fetch transactions", nil
+	}
+
+	totals := []models.TransactionTotal{}
+	var count int64
+
+	for rows.Next() {
+
+		var t models.TransactionTotal
+		if err := rows.Scan(&t.Type, &t.Currency, &t.Count, &t.Credit, &t.Debit); err != nil {
+
+			rows.Close()
+			log.Printf("error scanning %s transaction totals %s ", class, err.Error())
+			return false, 500, "Unable to fetch transactions", nil
+		}
+
+		count += t.Count
+		totals = append(totals, t)
+	}
+
+	rows.Close()
+
+	perPage := int64(in.GetLimit())
+	if perPage <= 0 {
+
+		perPage = transactionsPerPage
+	}
+
+	page := int64(in.Page)
+	if page < 1 {
+
+		page = 1
+	}
+
+	rows, err = db.Query("SELECT id, client_id, user_id, username, transaction_no, amount, tranasaction_type, subject, description, source, channel, "+
+		" balance, currency, COALESCE(exchange_rate, 0), status, "+typeCase+", created_at FROM transactions "+where+" ORDER BY id DESC LIMIT ? OFFSET ?",
+		append(append(append([]interface{}{}, typeArgs...), args...), perPage, (page-1)*perPage)...)
+
+	if err != nil {
+
+		log.Printf("error getting %s transactions %s ", class, err.Error())
+		return false, 500, "Unable to fetch transactions", nil
+	}
+
+	defer rows.Close()
+
+	transactions := []models.Transaction{}
+	for rows.Next() {
+
+		var t models.Transaction
+		var username, subject, description, source, channel sql.NullString
+
+		err := rows.Scan(&t.ID, &t.ClientID, &t.UserID, &username, &t.TransactionNo, &t.Amount, &t.TransactionType, &subject, &description,
+			&source, &channel, &t.Balance, &t.Currency, &t.ExchangeRate, &t.Status, &t.Type, &t.CreatedAt)
+
+		if err != nil {
+
+			log.Printf("error scanning %s transaction %s ", class, err.Error())
+			continue
+		}
+
+		t.Username = username.String
+		t.Subject = subject.String
+		t.Description = description.String
+		t.Source = source.String
+		t.Channel = channel.String
+
+		transactions = append(transactions, t)
+	}
+
+	lastPage := (count + perPage - 1) / perPage
+	if lastPage < 1 {
+
+		lastPage = 1
+	}
+
+	meta := map[string]interface{}{
+		"page":     page,
+		"perPage":  perPage,
+		"total":    count,
+		"lastPage": lastPage,
+		"nextPage": 0,
+		"prevPage": 0,
+	}
+
+	if page < lastPage {
+
+		meta["nextPage"] = page + 1
+	}
+
+	if page > 1 {
+
+		meta["prevPage"] = page - 1
+	}
+
+	return true, 200, "Transactions retrieved", toStruct(map[string]interface{}{
+		"data":   transactions,
+		"totals": totals,
+		"meta":   meta,
+	})
+}
+
+// GetMoneyTransaction lists real money transactions: deposits, withdrawals and their refunds
+func GetMoneyTransaction(db *sql.DB, in *pbWallet.GetTransactionsRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	return transactionReport(db, models.MoneyTransaction, in)
+}
+
+// GetSystemTransaction lists transactions inside the platform: bets, wins, bonuses, transfers and the rest
+func GetSystemTransaction(db *sql.DB, in *pbWallet.GetTransactionsRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	return transactionReport(db, models.SystemTransaction, in)
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+func TestShopLegsAreSystemTransactions(t *testing.T) {
+
+	_, _, _, moneyArgs := transactionTypeCase()
+
+	money := map[interface{}]bool{}
+	for _, arg := range moneyArgs {
+
+		money[arg] = true
+	}
+
+	for _, subject := range []string{subjectShopDeposit, subjectShopDepositReversal, subjectShopWithdrawal} {
+
+		if money[subject] {
+
+			t.Errorf("expected %s reported as a system transaction", subject)
+		}
+
+		if models.TransactionSubjects[subject].Type != "shop_transfer" {
+
+			t.Errorf("expected %s typed as a shop transfer, got %q", subject, models.TransactionSubjects[subject].Type)
+		}
+	}
+
+	if !money[models.SubjectDeposit] || !money[models.SubjectWithdrawal] {
+
+		t.Errorf("expected player deposits and withdrawals reported as money, got %v", moneyArgs)
+	}
+}
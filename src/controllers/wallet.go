@@ -14,293 +14,301 @@ import (
 )
 
 func CreditUser(db *sql.DB, in *pbWallet.CreditUserRequest) (success bool, status int32, message string, data *pbWallet.Wallet) {
-	var walletType string = "Main"
-	// var err error
-	_ = walletType
+
+	success, status, message, data, _ = creditUser(db, in)
+	return success, status, message, data
+}
+
+// creditUser credits the wallet and also returns the transaction number it recorded
+func creditUser(db *sql.DB, in *pbWallet.CreditUserRequest) (success bool, status int32, message string, data *pbWallet.Wallet, transactionNo string) {
+
 	log.Printf("Crediting user  %d in client %d ", in.UserId, in.ClientId)
 
-	var walletBalance string = "available_balance"
-	var balance float64 = 0.00
-	var updateQuery string
 	var userId = in.UserId
-	var row models.Wallet
 
-	amount, err := strconv.ParseFloat(in.Amount, 32)
+	amount, err := strconv.ParseFloat(in.Amount, 64)
 	if err != nil {
 
 		logrus.Panic(err)
 	}
 
-	queryString := fmt.Sprintf("SELECT balance, available_balance, sport_bonus_balance, " +
-		"virtual_bonus_balance, casino_bonus_balance, trust_balance " +
-		" FROM wallets " +
-		" WHERE user_id = ? ")
+	currency, err := resolveCurrency(db, in.ClientId, in.GetCurrency())
+	if err != nil {
+
+		log.Printf("error resolving currency of user %d %s", userId, err.Error())
+		return false, 400, "Currency not supported", nil, ""
+	}
+
+	amount, ok := currencyAmount(amount, currency)
+	if !ok {
+
+		return false, 400, fmt.Sprintf("Amount has more decimals than %s allows", currency.Code), nil, ""
+	}
+
+	// a player gets a wallet in a new currency with their first credit in it
+	row, err := getWallet(db, in.ClientId, userId, currency.Code)
+	if err == sql.ErrNoRows {
 
-	err = db.QueryRow(queryString, userId).Scan(&row.Balance, &row.AvailableBalance, &row.SportBonusBalance, &row.CasinoBonusBalance,
-		&row.VirtualBonusBalance, &row.TrustBalance)
+		if err = openCurrencyWallet(db, in.ClientId, userId, currency.Code); err == nil {
+
+			row, err = getWallet(db, in.ClientId, userId, currency.Code)
+		}
+	}
+
+	// closed wallets cannot be credited or debited
+	if err != nil || row.Status == models.WalletClosed {
+
+		log.Printf("error getting user wallet with id %d  %v", userId, err)
+		return false, 404, "User not found", nil, ""
+	}
+
+	walletType, err := getWalletType(db, in.ClientId, in.Wallet)
+	if err == errWalletTypeDisabled {
+
+		return false, 400, fmt.Sprintf("%s wallet is disabled", walletType.Title), nil, ""
+	}
 
 	if err != nil {
 
-		log.Printf("error getting user wallet with id %d  %s", userId, err.Error())
-		return false, 404, "User not found", nil
+		log.Printf("error getting wallet type %s of client %d %s", in.Wallet, in.ClientId, err.Error())
+		return false, 500, "Unable to update user wallet", nil, ""
 	}
 
-	switch in.Wallet {
-	case "sport-bonus":
-		walletBalance = "sport_bonus_balance"
-		walletType = "Sport Bonus"
-		break
-	case "virtual":
-		walletBalance = "virtual_bonus_balance"
-		walletType = "Virtual Bonus"
-		break
-	case "casino":
-		walletBalance = "casino_bonus_balance"
-		walletType = "Casino Bonus"
-		break
-	case "trust":
-		walletBalance = "trust_balance"
-		walletType = "Trust"
-		break
-	default:
-		walletBalance = "available_balance"
-		break
+	if err = checkWalletRules(walletType, "credit", in.Subject); err != nil {
+
+		return false, 400, err.Error(), nil, ""
 	}
 
 	// CRITICAL FIX: Use atomic SQL increment to prevent race conditions
 	// This ensures concurrent credits don't overwrite each other
-	// Instead of: Read balance -> Calculate -> Write (race condition!)
-	// We do: Atomically increment (safe for concurrency!)
-	updateQuery = fmt.Sprintf("UPDATE wallets SET %s = %s + %f WHERE user_id = %d AND client_id = %d",
-		walletBalance, walletBalance, amount, userId, in.ClientId)
+	_, err = adjustWalletBalance(db, in.ClientId, userId, currency.Code, walletType, amount)
+	if err == errWalletClosed {
 
-	_, err = db.Exec(updateQuery)
+		return false, 404, "User not found", nil, ""
+	}
 
 	if err != nil {
 
-		log.Printf("got error updating wallet\n%s\n%s", updateQuery, err.Error())
-		return false, 500, "Unable to update user wallet", nil
+		log.Printf("got error crediting %s wallet of user %d %s", walletType.Name, userId, err.Error())
+		return false, 500, "Unable to update user wallet", nil, ""
 	}
 
 	// Fetch updated balance after atomic increment
-	err = db.QueryRow(queryString, userId).Scan(&row.Balance, &row.AvailableBalance, &row.SportBonusBalance, &row.CasinoBonusBalance,
-		&row.VirtualBonusBalance, &row.TrustBalance)
-
+	row, err = getWallet(db, in.ClientId, userId, currency.Code)
 	if err != nil {
+
 		log.Printf("error fetching updated wallet with id %d  %s", userId, err.Error())
-		return false, 500, "Unable to fetch updated wallet", nil
+		return false, 500, "Unable to fetch updated wallet", nil, ""
 	}
 
-	// Get the updated balance for the correct wallet type
-	switch in.Wallet {
-	case "sport-bonus":
-		balance = row.SportBonusBalance
-	case "virtual":
-		balance = row.VirtualBonusBalance
-	case "casino":
-		balance = row.CasinoBonusBalance
-	case "trust":
-		balance = row.TrustBalance
-	default:
-		balance = row.AvailableBalance
+	balance, err := walletTypeBalance(db, in.ClientId, userId, walletType, row)
+	if err != nil {
+
+		log.Printf("error fetching updated %s wallet with id %d  %s", walletType.Name, userId, err.Error())
+		return false, 500, "Unable to fetch updated wallet", nil, ""
 	}
 
 	var transaction_no = generateTrxNo()
 
 	//save transactions
-	stmt, err := db.Prepare("INSERT INTO transactions (client_id,user_id,username,transaction_no,amount,tranasaction_type,subject,description,source,channel,balance, status, created_at) VALUE (?,?,?,?,?,'credit',?,?,?,?,?,1,NOW())")
+	stmt, err := db.Prepare("INSERT INTO transactions (client_id,user_id,username,transaction_no,amount,tranasaction_type,subject,description,source,channel,balance,currency, status, created_at) VALUE (?,?,?,?,?,'credit',?,?,?,?,?,?,1,NOW())")
 	if err != nil {
 		log.Printf("error preparing query %s ", err.Error())
-		return false, 500, "Error saving transaction", nil
+		return false, 500, "Error saving transaction", nil, ""
 	}
 	defer stmt.Close()
 
-	_, err = stmt.Exec(in.ClientId, in.UserId, in.Username, transaction_no, amount, in.Subject, in.Description, in.Source, in.Channel, balance)
+	_, err = stmt.Exec(in.ClientId, in.UserId, in.Username, transaction_no, amount, in.Subject, in.Description, in.Source, in.Channel, balance, currency.Code)
 	if err != nil {
 
 		log.Printf("error preparing query %s ", err.Error())
-		return false, 500, "Error saving transaction", nil
+		return false, 500, "Error saving transaction", nil, ""
 	}
 
-	var wallet = &pbWallet.Wallet{
-		UserId:              userId,
-		Balance:             balance,
-		AvailableBalance:    row.AvailableBalance,
-		TrustBalance:        row.TrustBalance,
-		SportBonusBalance:   row.SportBonusBalance,
-		VirtualBonusBalance: row.VirtualBonusBalance,
-		CasinoBonusBalance:  row.CasinoBonusBalance,
-	}
+	tagWalletTransaction(db, in.ClientId, in.UserId, walletType, transaction_no, in.Subject, in.Source, amount)
+	addPlayerTotals(db, in.ClientId, in.UserId, currency.Code, "credit", in.Subject, amount)
 
-	return true, 200, "Wallet Credited", wallet
+	return true, 200, "Wallet Credited", walletResponse(db, in.ClientId, userId, row, balance), transaction_no
 }
 
 func DebitUser(db *sql.DB, in *pbWallet.DebitUserRequest) (success bool, status int32, message string, data *pbWallet.Wallet) {
-	var walletType string = "Main"
-	// var err error
-	_ = walletType
+
+	success, status, message, data, _ = debitUser(db, in)
+	return success, status, message, data
+}
+
+// debitUser debits the wallet and also returns the transaction number it recorded
+func debitUser(db *sql.DB, in *pbWallet.DebitUserRequest) (success bool, status int32, message string, data *pbWallet.Wallet, transactionNo string) {
 
 	log.Printf("Debiting user  %d in client %d ", in.UserId, in.ClientId)
 
-	var walletBalance string = "available_balance"
-	var balance float64 = 0.00
-	var updateQuery string
 	var userId = in.UserId
-	var row models.Wallet
 
-	amount, err := strconv.ParseFloat(in.Amount, 32)
+	amount, err := strconv.ParseFloat(in.Amount, 64)
 	if err != nil {
 
 		logrus.Panic(err)
 	}
 
-	queryString := fmt.Sprintf("SELECT balance, available_balance, sport_bonus_balance, " +
-		"virtual_bonus_balance, casino_bonus_balance, trust_balance " +
-		" FROM wallets " +
-		" WHERE user_id = ? ")
+	currency, err := resolveCurrency(db, in.ClientId, in.GetCurrency())
+	if err != nil {
+
+		log.Printf("error resolving currency of user %d %s", userId, err.Error())
+		return false, 400, "Currency not supported", nil, ""
+	}
+
+	amount, ok := currencyAmount(amount, currency)
+	if !ok {
+
+		return false, 400, fmt.Sprintf("Amount has more decimals than %s allows", currency.Code), nil, ""
+	}
+
+	row, err := getWallet(db, in.ClientId, userId, currency.Code)
+	if err == sql.ErrNoRows && currency.Code != "" {
 
-	err = db.QueryRow(queryString, userId).Scan(&row.Balance, &row.AvailableBalance, &row.SportBonusBalance, &row.CasinoBonusBalance,
-		&row.VirtualBonusBalance, &row.TrustBalance)
+		// nothing was ever credited in this currency
+		return false, 400, "Insufficient balance", nil, ""
+	}
+
+	// closed wallets cannot be credited or debited
+	if err != nil || row.Status == models.WalletClosed {
+
+		log.Printf("error getting user wallet with id %d  %v", userId, err)
+		return false, 404, "User not found", nil, ""
+	}
+
+	walletType, err := getWalletType(db, in.ClientId, in.Wallet)
+	if err == errWalletTypeDisabled {
+
+		return false, 400, fmt.Sprintf("%s wallet is disabled", walletType.Title), nil, ""
+	}
 
 	if err != nil {
 
-		log.Printf("error getting user wallet with id %d  %s", userId, err.Error())
-		return false, 404, "User not found", nil
+		log.Printf("error getting wallet type %s of client %d %s", in.Wallet, in.ClientId, err.Error())
+		return false, 500, "Unable to update user wallet", nil, ""
 	}
 
-	// Check sufficient balance before debit
-	switch in.Wallet {
-	case "sport-bonus":
-		if row.SportBonusBalance < amount {
-			return false, 400, "Insufficient balance", nil
-		}
-		walletBalance = "sport_bonus_balance"
-		walletType = "Sport Bonus"
-		break
-	case "virtual":
-		if row.VirtualBonusBalance < amount {
-			return false, 400, "Insufficient balance", nil
-		}
-		walletBalance = "virtual_bonus_balance"
-		walletType = "Virtual Bonus"
-		break
-	case "casino":
-		if row.CasinoBonusBalance < amount {
-			return false, 400, "Insufficient balance", nil
-		}
-		walletBalance = "casino_bonus_balance"
-		walletType = "Casino Bonus"
-		break
-	case "trust":
-		if row.TrustBalance < amount {
-			return false, 400, "Insufficient balance", nil
-		}
-		walletBalance = "trust_balance"
-		walletType = "Trust"
-		break
-	default:
-		if row.AvailableBalance < amount {
-			return false, 400, "Insufficient balance", nil
-		}
-		walletBalance = "available_balance"
-		break
+	if err = checkWalletRules(walletType, "debit", in.Subject); err != nil {
+
+		return false, 400, err.Error(), nil, ""
 	}
 
 	// CRITICAL FIX: Use atomic SQL decrement to prevent race conditions
-	// Same fix as CreditUser - atomic operations prevent lost updates
-	updateQuery = fmt.Sprintf("UPDATE wallets SET %s = %s - %f WHERE user_id = %d AND client_id = %d",
-		walletBalance, walletBalance, amount, userId, in.ClientId)
+	// The balance check is part of the update, so concurrent debits cannot overdraw the wallet
+	ok, err = adjustWalletBalance(db, in.ClientId, userId, currency.Code, walletType, -amount)
+	if err == errWalletClosed {
 
-	_, err = db.Exec(updateQuery)
+		return false, 404, "User not found", nil, ""
+	}
 
 	if err != nil {
 
-		log.Printf("got error updating wallet\n%s\n%s", updateQuery, err.Error())
-		return false, 500, "Unable to update user wallet", nil
+		log.Printf("got error debiting %s wallet of user %d %s", walletType.Name, userId, err.Error())
+		return false, 500, "Unable to update user wallet", nil, ""
 	}
 
-	// Fetch updated balance after atomic decrement
-	err = db.QueryRow(queryString, userId).Scan(&row.Balance, &row.AvailableBalance, &row.SportBonusBalance, &row.CasinoBonusBalance,
-		&row.VirtualBonusBalance, &row.TrustBalance)
+	if !ok {
+
+		return false, 400, "Insufficient balance", nil, ""
+	}
 
+	// Fetch updated balance after atomic decrement
+	row, err = getWallet(db, in.ClientId, userId, currency.Code)
 	if err != nil {
+
 		log.Printf("error fetching updated wallet with id %d  %s", userId, err.Error())
-		return false, 500, "Unable to fetch updated wallet", nil
+		return false, 500, "Unable to fetch updated wallet", nil, ""
 	}
 
-	// Get the updated balance for the correct wallet type
-	switch in.Wallet {
-	case "sport-bonus":
-		balance = row.SportBonusBalance
-	case "virtual":
-		balance = row.VirtualBonusBalance
-	case "casino":
-		balance = row.CasinoBonusBalance
-	case "trust":
-		balance = row.TrustBalance
-	default:
-		balance = row.AvailableBalance
+	balance, err := walletTypeBalance(db, in.ClientId, userId, walletType, row)
+	if err != nil {
+
+		log.Printf("error fetching updated %s wallet with id %d  %s", walletType.Name, userId, err.Error())
+		return false, 500, "Unable to fetch updated wallet", nil, ""
 	}
 
 	var transaction_no = generateTrxNo()
 
 	//save transactions
-	stmt, err := db.Prepare("INSERT INTO transactions (client_id,user_id,username,transaction_no,amount,tranasaction_type,subject,description,source,channel,balance, status, created_at) VALUE (?,?,?,?,?,'debit',?,?,?,?,?,1,NOW())")
+	stmt, err := db.Prepare("INSERT INTO transactions (client_id,user_id,username,transaction_no,amount,tranasaction_type,subject,description,source,channel,balance,currency, status, created_at) VALUE (?,?,?,?,?,'debit',?,?,?,?,?,?,1,NOW())")
 	if err != nil {
 		log.Printf("error preparing query %s ", err.Error())
-		return false, 500, "Error saving transaction", nil
+		return false, 500, "Error saving transaction", nil, ""
 	}
 	defer stmt.Close()
 
-	_, err = stmt.Exec(in.ClientId, in.UserId, in.Username, transaction_no, amount, in.Subject, in.Description, in.Source, in.Channel, balance)
+	_, err = stmt.Exec(in.ClientId, in.UserId, in.Username, transaction_no, amount, in.Subject, in.Description, in.Source, in.Channel, balance, currency.Code)
 	if err != nil {
 
 		log.Printf("error preparing query %s ", err.Error())
-		return false, 500, "Error saving transaction", nil
+		return false, 500, "Error saving transaction", nil, ""
 	}
 
-	var wallet = &pbWallet.Wallet{
-		UserId:              userId,
-		Balance:             balance,
-		AvailableBalance:    row.AvailableBalance,
-		TrustBalance:        row.TrustBalance,
-		SportBonusBalance:   row.SportBonusBalance,
-		VirtualBonusBalance: row.VirtualBonusBalance,
-		CasinoBonusBalance:  row.CasinoBonusBalance,
-	}
+	tagWalletTransaction(db, in.ClientId, in.UserId, walletType, transaction_no, in.Subject, in.Source, amount)
+	addPlayerTotals(db, in.ClientId, in.UserId, currency.Code, "debit", in.Subject, amount)
 
-	return true, 200, "Wallet Debited", wallet
+	return true, 200, "Wallet Debited", walletResponse(db, in.ClientId, userId, row, balance), transaction_no
 }
 
+// GetBalance returns the wallet in the requested currency, or the client's default, with every wallet
+// type balance and the player's wallets in all other currencies. When a wallet is named, balance is
+// that wallet's balance
 func GetBalance(db *sql.DB, in *pbWallet.GetBalanceRequest) (success bool, status int32, message string, data *pbWallet.Wallet) {
 
 	var userId = in.UserId
-	var row models.Wallet
 
 	log.Printf("Getting balance for  %d in client %d ", in.UserId, in.ClientId)
 
-	queryString := fmt.Sprintf("SELECT balance, available_balance, sport_bonus_balance, " +
-		"virtual_bonus_balance, casino_bonus_balance, trust_balance " +
-		" FROM wallets " +
-		" WHERE user_id = ? ")
+	wallets, err := listWallets(db, in.ClientId, userId)
+	if err != nil || len(wallets) == 0 {
 
-	err := db.QueryRow(queryString, userId).Scan(&row.Balance, &row.AvailableBalance, &row.SportBonusBalance, &row.VirtualBonusBalance,
-		&row.CasinoBonusBalance, &row.TrustBalance)
+		log.Printf("error getting user wallet with id %d  %v", userId, err)
+		return false, 404, "User not found", nil
+	}
 
+	currency, err := resolveCurrency(db, in.ClientId, in.GetCurrency())
 	if err != nil {
 
-		log.Printf("error getting user wallet with id %d  %s", userId, err.Error())
-		return false, 404, "User not found", nil
+		log.Printf("error resolving currency of user %d %s", userId, err.Error())
+		return false, 400, "Currency not supported", nil
+	}
+
+	// a currency the player never used has nothing in it yet
+	row := models.Wallet{Currency: currency.Code}
+	for _, w := range wallets {
+
+		if w.Currency == currency.Code {
+
+			row = w
+		}
+	}
+
+	balance := row.Balance
+	if in.Wallet != nil {
+
+		walletType, err := getWalletType(db, in.ClientId, in.GetWallet())
+		if err == errWalletTypeDisabled {
+
+			return false, 400, fmt.Sprintf("%s wallet is disabled", walletType.Title), nil
+		}
+
+		if err == nil {
+
+			balance, err = walletTypeBalance(db, in.ClientId, userId, walletType, row)
+		}
+
+		if err != nil {
+
+			log.Printf("error getting %s wallet with id %d  %s", in.GetWallet(), userId, err.Error())
+			return false, 500, "Unable to fetch wallet", nil
+		}
 	}
 
-	var wallet = &pbWallet.Wallet{
-		UserId:              userId,
-		Balance:             row.Balance,
-		AvailableBalance:    row.AvailableBalance,
-		TrustBalance:        row.TrustBalance,
-		SportBonusBalance:   row.SportBonusBalance,
-		VirtualBonusBalance: row.VirtualBonusBalance,
-		CasinoBonusBalance:  row.CasinoBonusBalance,
+	wallet := walletResponse(db, in.ClientId, userId, row, balance)
+	for _, w := range wallets {
+
+		wallet.Currencies = append(wallet.Currencies, walletResponse(db, in.ClientId, userId, w, w.Balance))
 	}
 
 	return true, 200, "Wallet retreived", wallet
@@ -0,0 +1,260 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// WalletTransfer moves funds between an agent and a player. A deposit moves them from the sender to the
+// receiver, a withdraw takes them back from the receiver. When the two wallets are in different
+// currencies the amount is converted at the rate in force and both legs record that rate
+func WalletTransfer(db *sql.DB, in *pbWallet.WalletTransferRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("Transfer of %.2f between users %d and %d in client %d ", in.Amount, in.FromUserId, in.ToUserId, in.ClientId)
+
+	if in.Amount <= 0 {
+
+		return false, 400, "Invalid amount", nil
+	}
+
+	if in.FromUserId == in.ToUserId {
+
+		return false, 400, "Cannot transfer to the same wallet", nil
+	}
+
+	payerId, payer, payerCode := in.FromUserId, in.FromUsername, in.GetFromCurrency()
+	payeeId, payee, payeeCode := in.ToUserId, in.ToUsername, in.GetToCurrency()
+
+	if strings.EqualFold(in.Action, "withdraw") {
+
+		payerId, payer, payerCode, payeeId, payee, payeeCode = payeeId, payee, payeeCode, payerId, payer, payerCode
+	}
+
+	from, err := resolveCurrency(db, in.ClientId, payerCode)
+	if err == nil {
+
+		var to models.Currency
+		if to, err = resolveCurrency(db, in.ClientId, payeeCode); err == nil {
+
+			return transfer(db, in, payerId, payer, payeeId, payee, from, to)
+		}
+	}
+
+	log.Printf("error resolving transfer currencies %s ", err.Error())
+	return false, 400, "Currency not supported", nil
+}
+
+func transfer(db *sql.DB, in *pbWallet.WalletTransferRequest, payerId int32, payer string, payeeId int32, payee string, from, to models.Currency) (bool, int32, string, *structpb.Struct) {
+
+	amount, ok := currencyAmount(in.Amount, from)
+	if !ok {
+
+		return false, 400, fmt.Sprintf("Amount has more decimals than %s allows", from.Code), nil
+	}
+
+	credited := amount
+
+	var conversion *models.Conversion
+	if from.Code != to.Code {
+
+		var err error
+		conversion, err = convertAmount(db, in.ClientId, from, to, amount)
+		if err == sql.ErrNoRows {
+
+			return false, 400, fmt.Sprintf("No exchange rate from %s to %s", from.Code, to.Code), nil
+		}
+
+		if err != nil {
+
+			log.Printf("error converting %s to %s %s ", from.Code, to.Code, err.Error())
+			return false, 500, "Unable to convert amount", nil
+		}
+
+		if conversion.ToAmount <= 0 {
+
+			return false, 400, "Amount is too small to convert", nil
+		}
+
+		credited = conversion.ToAmount
+	}
+
+	description := in.GetDescription()
+	if description == "" {
+
+		description = fmt.Sprintf("Transfer from %s to %s", payer, payee)
+	}
+
+	ok, debitStatus, debitMessage, _, debitNo := debitUser(db, &pbWallet.DebitUserRequest{
+		UserId:      payerId,
+		ClientId:    in.ClientId,
+		Amount:      fmt.Sprintf("%.*f", from.MinorUnits, amount),
+		Source:      "transfer",
+		Description: description,
+		Username:    payer,
+		Wallet:      models.MainWallet,
+		Subject:     models.SubjectTransfer,
+		Channel:     "transfer",
+		Currency:    &from.Code,
+	})
+
+	if !ok {
+
+		return false, debitStatus, debitMessage, nil
+	}
+
+	ok, creditStatus, creditMessage, wallet, creditNo := creditUser(db, &pbWallet.CreditUserRequest{
+		UserId:      payeeId,
+		ClientId:    in.ClientId,
+		Amount:      fmt.Sprintf("%.*f", to.MinorUnits, credited),
+		Source:      "transfer",
+		Description: description,
+		Username:    payee,
+		Wallet:      models.MainWallet,
+		Subject:     models.SubjectTransfer,
+		Channel:     "transfer",
+		Currency:    &to.Code,
+	})
+
+	if !ok {
+
+		// give the sender their money back
+		refunded, _, refundMessage, _, _ := creditUser(db, &pbWallet.CreditUserRequest{
+			UserId:      payerId,
+			ClientId:    in.ClientId,
+			Amount:      fmt.Sprintf("%.*f", from.MinorUnits, amount),
+			Source:      "transfer",
+			Description: fmt.Sprintf("Transfer reversal - %s", debitNo),
+			Username:    payer,
+			Wallet:      models.MainWallet,
+			Subject:     models.SubjectTransfer,
+			Channel:     "transfer",
+			Currency:    &from.Code,
+		})
+
+		if !refunded {
+
+			log.Printf("error reversing transfer debit %s %s ", debitNo, refundMessage)
+		}
+
+		return false, creditStatus, creditMessage, nil
+	}
+
+	result := map[string]interface{}{
+		"debitTransactionNo":  debitNo,
+		"creditTransactionNo": creditNo,
+		"amount":              amount,
+		"currency":            from.Code,
+		"creditedAmount":      credited,
+		"creditedCurrency":    to.Code,
+		"balance":             wallet.AvailableBalance,
+	}
+
+	if conversion != nil {
+
+		conversion.FromUserID = int64(payerId)
+		conversion.ToUserID = int64(payeeId)
+		conversion.DebitTransactionNo = debitNo
+		conversion.CreditTransactionNo = creditNo
+
+		if err := saveConversion(db, conversion); err != nil {
+
+			log.Printf("error saving conversion of transfer %s %s ", debitNo, err.Error())
+
+			// a converted transfer is only kept with the rate it was converted at, so both legs are undone
+			reversed, _, reverseMessage, _, _ := debitUser(db, &pbWallet.DebitUserRequest{
+				UserId:      payeeId,
+				ClientId:    in.ClientId,
+				Amount:      fmt.Sprintf("%.*f", to.MinorUnits, credited),
+				Source:      "transfer",
+				Description: fmt.Sprintf("Transfer reversal - %s", creditNo),
+				Username:    payee,
+				Wallet:      models.MainWallet,
+				Subject:     models.SubjectTransfer,
+				Channel:     "transfer",
+				Currency:    &to.Code,
+			})
+
+			if !reversed {
+
+				log.Printf("error reversing transfer credit %s %s ", creditNo, reverseMessage)
+				return false, 500, "Unable to record conversion", nil
+			}
+
+			refunded, _, refundMessage, _, _ := creditUser(db, &pbWallet.CreditUserRequest{
+				UserId:      payerId,
+				ClientId:    in.ClientId,
+				Amount:      fmt.Sprintf("%.*f", from.MinorUnits, amount),
+				Source:      "transfer",
+				Description: fmt.Sprintf("Transfer reversal - %s", debitNo),
+				Username:    payer,
+				Wallet:      models.MainWallet,
+				Subject:     models.SubjectTransfer,
+				Channel:     "transfer",
+				Currency:    &from.Code,
+			})
+
+			if !refunded {
+
+				log.Printf("error reversing transfer debit %s %s ", debitNo, refundMessage)
+			}
+
+			return false, 500, "Unable to record conversion", nil
+		}
+
+		result["conversion"] = conversion
+	}
+
+	return true, 200, "Transfer successful", toStruct(result)
+}
+
+// saveConversion records the rate a transfer was converted at and marks both legs with it, all or nothing
+func saveConversion(db *sql.DB, c *models.Conversion) error {
+
+	tx, err := db.Begin()
+	if err != nil {
+
+		return err
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.Exec("INSERT INTO currency_conversions (client_id, from_user_id, to_user_id, from_currency, to_currency, from_amount, to_amount, "+
+		" rate_id, mid_rate, spread, applied_rate, debit_transaction_no, credit_transaction_no) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		c.ClientID, c.FromUserID, c.ToUserID, c.FromCurrency, c.ToCurrency, c.FromAmount, c.ToAmount, c.RateID, c.MidRate, c.Spread, c.AppliedRate,
+		c.DebitTransactionNo, c.CreditTransactionNo)
+
+	if err != nil {
+
+		return err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE transactions SET exchange_rate = ?, conversion_id = ? WHERE client_id = ? "+
+		" AND ((user_id = ? AND transaction_no = ?) OR (user_id = ? AND transaction_no = ?))",
+		c.AppliedRate, id, c.ClientID, c.FromUserID, c.DebitTransactionNo, c.ToUserID, c.CreditTransactionNo)
+
+	if err != nil {
+
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+
+		return err
+	}
+
+	c.ID = id
+
+	return nil
+}
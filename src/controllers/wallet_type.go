@@ -0,0 +1,444 @@
+package controllers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const walletTypeColumns = "id, client_id, name, title, COALESCE(balance_column, ''), withdrawable, bonus, can_go_negative, status"
+
+func scanWalletType(row rowScanner) (models.WalletType, error) {
+
+	var t models.WalletType
+	err := row.Scan(&t.ID, &t.ClientID, &t.Name, &t.Title, &t.BalanceColumn, &t.Withdrawable, &t.Bonus, &t.CanGoNegative, &t.Status)
+	if err != nil {
+
+		return t, err
+	}
+
+	if t.BalanceColumn != "" && !models.WalletColumns[t.BalanceColumn] {
+
+		return t, fmt.Errorf("wallet type %s has an unknown balance column %s", t.Name, t.BalanceColumn)
+	}
+
+	return t, nil
+}
+
+// errWalletTypeDisabled is returned for a wallet type its client has switched off
+var errWalletTypeDisabled = errors.New("wallet type is disabled")
+
+// getWalletType resolves the wallet named in a request. A client's own definition wins over the
+// shared one. A disabled type is an error, only names nobody defined fall back to the main wallet as
+// they always have
+func getWalletType(db *sql.DB, clientId int32, name string) (models.WalletType, error) {
+
+	if name == "" {
+
+		name = models.MainWallet
+	}
+
+	t, err := scanWalletType(db.QueryRow("SELECT "+walletTypeColumns+" FROM wallet_types "+
+		" WHERE name = ? AND client_id IN (0, ?) ORDER BY client_id DESC LIMIT 1", name, clientId))
+
+	if err == nil && t.Status != 1 {
+
+		return t, errWalletTypeDisabled
+	}
+
+	if err == sql.ErrNoRows && name != models.MainWallet {
+
+		log.Printf("unknown wallet %s for client %d, using the main wallet ", name, clientId)
+		return getWalletType(db, clientId, models.MainWallet)
+	}
+
+	return t, err
+}
+
+// checkWalletRules applies the rules of a wallet type to a transaction. Money leaves the platform only
+// from withdrawable wallets, by a withdrawal or a transfer to another player. Bonus wallets never take
+// real money, so deposits and refunds cannot land in them and their balance cannot be withdrawn or
+// transferred whatever withdrawable says
+func checkWalletRules(t models.WalletType, trxType, subject string) error {
+
+	class := models.TransactionSubjects[subject]
+	cashOut := class.Type == "withdrawal" || subject == models.SubjectTransfer
+
+	if trxType == "debit" && cashOut && (!t.Withdrawable || t.Bonus) {
+
+		if subject == models.SubjectTransfer {
+
+			return fmt.Errorf("%s balance cannot be transferred", t.Title)
+		}
+
+		return fmt.Errorf("%s balance cannot be withdrawn", t.Title)
+	}
+
+	if trxType == "credit" && t.Bonus && (class.Class == models.MoneyTransaction || subject == models.SubjectTransfer) {
+
+		return fmt.Errorf("%s only holds bonus money", t.Title)
+	}
+
+	return nil
+}
+
+// clientWalletTypes lists the active wallet types of a client, its own definitions replacing the shared ones
+func clientWalletTypes(db *sql.DB, clientId int32) ([]models.WalletType, error) {
+
+	rows, err := db.Query("SELECT "+walletTypeColumns+" FROM wallet_types WHERE client_id IN (0, ?) ORDER BY client_id, id", clientId)
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var types []models.WalletType
+	index := map[string]int{}
+
+	for rows.Next() {
+
+		t, err := scanWalletType(rows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		if i, ok := index[t.Name]; ok {
+
+			types[i] = t
+			continue
+		}
+
+		index[t.Name] = len(types)
+		types = append(types, t)
+	}
+
+	active := types[:0]
+	for _, t := range types {
+
+		if t.Status == 1 {
+
+			active = append(active, t)
+		}
+	}
+
+	return active, rows.Err()
+}
+
+const walletColumns = "balance, available_balance, sport_bonus_balance, virtual_bonus_balance, casino_bonus_balance, trust_balance, currency, status"
+
+func scanWallet(row rowScanner) (models.Wallet, error) {
+
+	var w models.Wallet
+	err := row.Scan(&w.Balance, &w.AvailableBalance, &w.SportBonusBalance, &w.VirtualBonusBalance, &w.CasinoBonusBalance, &w.TrustBalance,
+		&w.Currency, &w.Status)
+
+	return w, err
+}
+
+// getWallet reads a player's wallet row in one currency
+func getWallet(db *sql.DB, clientId, userId int32, currency string) (models.Wallet, error) {
+
+	return scanWallet(db.QueryRow("SELECT "+walletColumns+" FROM wallets WHERE user_id = ? AND client_id = ? AND currency = ?",
+		userId, clientId, currency))
+}
+
+// listWallets reads every currency wallet of a player
+func listWallets(db *sql.DB, clientId, userId int32) ([]models.Wallet, error) {
+
+	rows, err := db.Query("SELECT "+walletColumns+" FROM wallets WHERE user_id = ? AND client_id = ? ORDER BY id", userId, clientId)
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var wallets []models.Wallet
+	for rows.Next() {
+
+		w, err := scanWallet(rows)
+		if err != nil {
+
+			return nil, err
+		}
+
+		wallets = append(wallets, w)
+	}
+
+	return wallets, rows.Err()
+}
+
+// openCurrencyWallet gives a player a wallet in another currency, copying the name and status of the
+// wallet they already hold
+func openCurrencyWallet(db *sql.DB, clientId, userId int32, currency string) error {
+
+	res, err := db.Exec("INSERT IGNORE INTO wallets (user_id, client_id, username, currency, status) "+
+		" SELECT user_id, client_id, username, ?, MAX(status) FROM wallets WHERE user_id = ? AND client_id = ? GROUP BY user_id, client_id, username LIMIT 1",
+		currency, userId, clientId)
+
+	if err != nil {
+
+		return err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// columnBalance returns the balance a wallets column holds on a wallet row
+func columnBalance(row models.Wallet, column string) float64 {
+
+	switch column {
+	case "sport_bonus_balance":
+		return row.SportBonusBalance
+	case "virtual_bonus_balance":
+		return row.VirtualBonusBalance
+	case "casino_bonus_balance":
+		return row.CasinoBonusBalance
+	case "trust_balance":
+		return row.TrustBalance
+	default:
+		return row.AvailableBalance
+	}
+}
+
+// subWalletBalances returns the balances a player holds in sub_wallets in one currency by wallet type
+func subWalletBalances(db *sql.DB, clientId, userId int32, currency string) (map[string]float64, error) {
+
+	rows, err := db.Query("SELECT wallet_type, balance FROM sub_wallets WHERE client_id = ? AND user_id = ? AND currency = ?", clientId, userId, currency)
+	if err != nil {
+
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	balances := map[string]float64{}
+	for rows.Next() {
+
+		var name string
+		var balance float64
+		if err := rows.Scan(&name, &balance); err != nil {
+
+			return nil, err
+		}
+
+		balances[name] = balance
+	}
+
+	return balances, rows.Err()
+}
+
+// walletTypeBalance returns a player's balance in one wallet type
+func walletTypeBalance(db *sql.DB, clientId, userId int32, t models.WalletType, row models.Wallet) (float64, error) {
+
+	if t.BalanceColumn != "" {
+
+		return columnBalance(row, t.BalanceColumn), nil
+	}
+
+	var balance float64
+	err := db.QueryRow("SELECT balance FROM sub_wallets WHERE client_id = ? AND user_id = ? AND wallet_type = ? AND currency = ?",
+		clientId, userId, t.Name, row.Currency).Scan(&balance)
+	if err == sql.ErrNoRows {
+
+		return 0, nil
+	}
+
+	return balance, err
+}
+
+// errWalletClosed is returned for a balance change on a wallet that was closed or never opened
+var errWalletClosed = errors.New("wallet is closed")
+
+// adjustWalletBalance atomically adds a signed amount to one wallet type of a player in one currency. A debit
+// that would take a wallet below zero changes nothing and returns false, unless the type may go negative.
+// Closed wallets are left out of every update, so a change racing an erasure cannot reopen a balance
+func adjustWalletBalance(db *sql.DB, clientId, userId int32, currency string, t models.WalletType, amount float64) (bool, error) {
+
+	guarded := amount < 0 && !t.CanGoNegative
+
+	var res sql.Result
+	var err error
+
+	switch {
+	case t.BalanceColumn != "" && guarded:
+		res, err = db.Exec(fmt.Sprintf("UPDATE wallets SET %s = %s + ? WHERE user_id = ? AND client_id = ? AND currency = ? AND status <> ? AND %s >= ?",
+			t.BalanceColumn, t.BalanceColumn, t.BalanceColumn), amount, userId, clientId, currency, models.WalletClosed, -amount)
+
+	case t.BalanceColumn != "":
+		res, err = db.Exec(fmt.Sprintf("UPDATE wallets SET %s = %s + ? WHERE user_id = ? AND client_id = ? AND currency = ? AND status <> ?",
+			t.BalanceColumn, t.BalanceColumn), amount, userId, clientId, currency, models.WalletClosed)
+
+	case guarded:
+		res, err = db.Exec("UPDATE sub_wallets SET balance = balance + ? WHERE client_id = ? AND user_id = ? AND wallet_type = ? AND currency = ? AND balance >= ? "+
+			" AND EXISTS (SELECT 1 FROM wallets w WHERE w.client_id = sub_wallets.client_id AND w.user_id = sub_wallets.user_id AND w.currency = sub_wallets.currency AND w.status <> ?)",
+			amount, clientId, userId, t.Name, currency, -amount, models.WalletClosed)
+
+	default:
+		res, err = db.Exec("INSERT INTO sub_wallets (client_id, user_id, wallet_type, currency, balance) SELECT ?, ?, ?, ?, ? FROM wallets "+
+			" WHERE client_id = ? AND user_id = ? AND currency = ? AND status <> ? ON DUPLICATE KEY UPDATE balance = balance + VALUES(balance)",
+			clientId, userId, t.Name, currency, amount, clientId, userId, currency, models.WalletClosed)
+	}
+
+	if err != nil {
+
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+
+		return false, err
+	}
+
+	if affected > 0 {
+
+		return true, nil
+	}
+
+	// nothing changed: the wallet is closed or missing, a debit is more than the balance or the amount was zero
+	var walletStatus int64
+	err = db.QueryRow("SELECT status FROM wallets WHERE client_id = ? AND user_id = ? AND currency = ?", clientId, userId, currency).Scan(&walletStatus)
+	if err == sql.ErrNoRows || (err == nil && walletStatus == models.WalletClosed) {
+
+		return false, errWalletClosed
+	}
+
+	if err != nil {
+
+		return false, err
+	}
+
+	return !guarded, nil
+}
+
+// walletResponse builds the wallet message with the balance of every wallet type of the client
+func walletResponse(db *sql.DB, clientId, userId int32, row models.Wallet, balance float64) *pbWallet.Wallet {
+
+	var wallet = &pbWallet.Wallet{
+		UserId:              userId,
+		Balance:             balance,
+		AvailableBalance:    row.AvailableBalance,
+		TrustBalance:        row.TrustBalance,
+		SportBonusBalance:   row.SportBonusBalance,
+		VirtualBonusBalance: row.VirtualBonusBalance,
+		CasinoBonusBalance:  row.CasinoBonusBalance,
+		Currency:            row.Currency,
+	}
+
+	types, err := clientWalletTypes(db, clientId)
+	if err != nil {
+
+		log.Printf("error getting wallet types of client %d %s ", clientId, err.Error())
+		return wallet
+	}
+
+	balances, err := subWalletBalances(db, clientId, userId, row.Currency)
+	if err != nil {
+
+		log.Printf("error getting sub wallets of user %d %s ", userId, err.Error())
+		return wallet
+	}
+
+	for _, t := range types {
+
+		b := balances[t.Name]
+		if t.BalanceColumn != "" {
+
+			b = columnBalance(row, t.BalanceColumn)
+		}
+
+		wallet.Wallets = append(wallet.Wallets, &pbWallet.SubWallet{
+			Name:          t.Name,
+			Title:         t.Title,
+			Balance:       b,
+			Withdrawable:  t.Withdrawable,
+			Bonus:         t.Bonus,
+			CanGoNegative: t.CanGoNegative,
+		})
+	}
+
+	return wallet
+}
+
+// SaveWalletType adds a wallet type for a client or changes its rules. Redefining a shared type only
+// changes its rules for the client, the balance stays where it is
+func SaveWalletType(db *sql.DB, in *pbWallet.WalletTypeRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	name := strings.ToLower(strings.TrimSpace(in.Name))
+	if name == "" || strings.ContainsAny(name, " \t") {
+
+		return false, 400, "A wallet name without spaces is required", nil
+	}
+
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+
+		title = in.Name
+	}
+
+	walletStatus := int32(1)
+	if in.Status != nil {
+
+		walletStatus = in.GetStatus()
+	}
+
+	if name == models.MainWallet && walletStatus != 1 {
+
+		return false, 400, "The main wallet cannot be disabled", nil
+	}
+
+	var column sql.NullString
+	err := db.QueryRow("SELECT balance_column FROM wallet_types WHERE client_id = 0 AND name = ?", name).Scan(&column)
+	if err != nil && err != sql.ErrNoRows {
+
+		log.Printf("error getting shared wallet type %s %s ", name, err.Error())
+		return false, 500, "Unable to save wallet type", nil
+	}
+
+	_, err = db.Exec("INSERT INTO wallet_types (client_id, name, title, balance_column, withdrawable, bonus, can_go_negative, status) "+
+		" VALUES (?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE title = VALUES(title), withdrawable = VALUES(withdrawable), "+
+		" bonus = VALUES(bonus), can_go_negative = VALUES(can_go_negative), status = VALUES(status)",
+		in.ClientId, name, title, column, in.Withdrawable, in.Bonus, in.CanGoNegative, walletStatus)
+
+	if err != nil {
+
+		log.Printf("error saving wallet type %s %s ", name, err.Error())
+		return false, 500, "Unable to save wallet type", nil
+	}
+
+	t, err := scanWalletType(db.QueryRow("SELECT "+walletTypeColumns+" FROM wallet_types WHERE client_id = ? AND name = ?", in.ClientId, name))
+	if err != nil {
+
+		log.Printf("error getting wallet type %s %s ", name, err.Error())
+		return false, 500, "Unable to fetch wallet type", nil
+	}
+
+	return true, 200, "Wallet type saved", toStruct(t)
+}
+
+// GetWalletTypes lists the wallet types a client's players hold
+func GetWalletTypes(db *sql.DB, in *pbWallet.WalletTypeRequest) (success bool, status int32, message string, data []*structpb.Struct) {
+
+	types, err := clientWalletTypes(db, in.ClientId)
+	if err != nil {
+
+		log.Printf("error getting wallet types of client %d %s ", in.ClientId, err.Error())
+		return false, 500, "Unable to fetch wallet types", nil
+	}
+
+	return true, 200, "Wallet types retrieved", toStructList(types)
+}
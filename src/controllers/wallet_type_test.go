@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+func TestCheckWalletRules(t *testing.T) {
+
+	main := models.WalletType{Name: "main", Title: "Main", Withdrawable: true}
+	trust := models.WalletType{Name: "trust", Title: "Trust"}
+	bonus := models.WalletType{Name: "sport-bonus", Title: "Sport Bonus", Withdrawable: true, Bonus: true}
+
+	cases := []struct {
+		wallet  models.WalletType
+		trxType string
+		subject string
+		allowed bool
+	}{
+		{main, "debit", models.SubjectWithdrawal, true},
+		{main, "debit", models.SubjectTransfer, true},
+		{trust, "debit", models.SubjectWithdrawal, false},
+		{main, "credit", "Shop Withdrawal", true},
+		{trust, "debit", models.SubjectTransfer, false},
+		{trust, "debit", models.SubjectBet, true},
+		{bonus, "debit", models.SubjectWithdrawal, false},
+		{bonus, "debit", models.SubjectBet, true},
+		{bonus, "credit", models.SubjectDeposit, false},
+		{bonus, "credit", models.SubjectWithdrawalRefund, false},
+		{bonus, "credit", "Sport Bonus", true},
+	}
+
+	for _, c := range cases {
+
+		err := checkWalletRules(c.wallet, c.trxType, c.subject)
+		if (err == nil) != c.allowed {
+
+			t.Errorf("%s %s of %s: expected allowed %v, got %v", c.wallet.Name, c.trxType, c.subject, c.allowed, err)
+		}
+	}
+}
+
+func TestAdjustWalletBalanceClosedWallet(t *testing.T) {
+
+	main := models.WalletType{Name: "main", BalanceColumn: "available_balance"}
+	bonus := models.WalletType{Name: "sport-bonus"}
+
+	cases := []struct {
+		name   string
+		wallet models.WalletType
+		amount float64
+		write  string
+	}{
+		{"credit", main, 100, "UPDATE wallets"},
+		{"debit", main, -100, "UPDATE wallets"},
+		{"sub wallet credit", bonus, 100, "INSERT INTO sub_wallets"},
+		{"sub wallet debit", bonus, -100, "UPDATE sub_wallets"},
+	}
+
+	for _, c := range cases {
+
+		t.Run(c.name, func(t *testing.T) {
+
+			db, fake := newFakeDB(t)
+			fake.On("SELECT status FROM wallets", []string{"status"}, []driver.Value{int64(models.WalletClosed)})
+			fake.Affects(c.write, 0)
+
+			ok, err := adjustWalletBalance(db, 1, 9, "NGN", c.wallet, c.amount)
+			if ok || err != errWalletClosed {
+
+				t.Fatalf("expected a closed wallet, got %v %v", ok, err)
+			}
+
+			writes := fake.Ran(c.write)
+			if len(writes) != 1 || !strings.Contains(writes[0].query, "status <> ?") {
+
+				t.Fatalf("expected the write to skip closed wallets, got %v", writes)
+			}
+		})
+	}
+}
+
+func TestAdjustWalletBalanceInsufficient(t *testing.T) {
+
+	db, fake := newFakeDB(t)
+	fake.On("SELECT status FROM wallets", []string{"status"}, []driver.Value{int64(1)})
+	fake.Affects("UPDATE wallets", 0)
+
+	ok, err := adjustWalletBalance(db, 1, 9, "NGN", models.WalletType{Name: "main", BalanceColumn: "available_balance"}, -100)
+	if ok || err != nil {
+
+		t.Fatalf("expected an insufficient balance, got %v %v", ok, err)
+	}
+}
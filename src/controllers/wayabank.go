@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/wayabank"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const wayabankProvider = "wayabank"
+
+// virtualAccountReservation is how many minutes a reserved account may stay pending before another
+// request may take the reservation over
+const virtualAccountReservation = 10
+
+// inflowSweepBatch is the most virtual accounts checked for inflows per sweep
+const inflowSweepBatch = 200
+
+const virtualAccountColumns = "id, client_id, user_id, username, provider, account_number, account_name, bank_name, provider_reference, status, created_at"
+
+func wayabankClient(db *sql.DB, clientId int32) (*wayabank.Client, error) {
+
+	pm, err := getPaymentMethod(db, clientId, wayabankProvider)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return wayabank.NewClient(pm.BaseURL, pm.SecretKey, pm.MerchantID), nil
+}
+
+func scanVirtualAccount(row rowScanner) (*models.VirtualAccount, error) {
+
+	var v models.VirtualAccount
+	var accountNumber, accountName, bankName, providerReference sql.NullString
+
+	err := row.Scan(&v.ID, &v.ClientID, &v.UserID, &v.Username, &v.Provider, &accountNumber, &accountName, &bankName, &providerReference, &v.Status, &v.CreatedAt)
+	if err != nil {
+
+		return nil, err
+	}
+
+	v.AccountNumber = accountNumber.String
+	v.AccountName = accountName.String
+	v.BankName = bankName.String
+	v.ProviderReference = providerReference.String
+
+	return &v, nil
+}
+
+func getVirtualAccount(db *sql.DB, clientId, userId int32, provider string) (*models.VirtualAccount, error) {
+
+	return scanVirtualAccount(db.QueryRow("SELECT "+virtualAccountColumns+" FROM virtual_accounts WHERE client_id = ? AND user_id = ? AND provider = ?",
+		clientId, userId, provider))
+}
+
+// CreateVirtualAccount issues the player a persistent wayabank account number. The row is reserved before
+// calling wayabank, so concurrent requests cannot issue one player two accounts. A reservation left
+// pending by a request that did not finish is taken over once it is stale
+func CreateVirtualAccount(db *sql.DB, in *pbWallet.WayaBankRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("Creating virtual account for user %d in client %d ", in.UserId, in.ClientId)
+
+	account, err := getVirtualAccount(db, in.ClientId, in.UserId, wayabankProvider)
+	if err == nil {
+
+		if account.Status == models.StatusCompleted {
+
+			return true, 200, "Virtual account already exists", toStruct(account)
+		}
+
+		res, err := db.Exec("DELETE FROM virtual_accounts WHERE id = ? AND status = ? AND created_at < NOW() - INTERVAL ? MINUTE",
+			account.ID, models.StatusPending, virtualAccountReservation)
+
+		if err != nil {
+
+			log.Printf("error releasing virtual account reservation %d %s ", account.ID, err.Error())
+			return false, 500, "Unable to create virtual account", nil
+		}
+
+		if n, _ := res.RowsAffected(); n == 0 {
+
+			return false, 409, "Virtual account is being created", nil
+		}
+
+		log.Printf("released stale virtual account reservation %d for user %d ", account.ID, in.UserId)
+
+	} else if err != sql.ErrNoRows {
+
+		log.Printf("error getting virtual account for user %d %s ", in.UserId, err.Error())
+		return false, 500, "Unable to create virtual account", nil
+	}
+
+	client, err := wayabankClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Wayabank is not configured for this client", nil
+	}
+
+	username, err := getWalletUsername(db, in.ClientId, in.UserId)
+	if err != nil {
+
+		log.Printf("error getting user wallet with id %d  %s", in.UserId, err.Error())
+		return false, 404, "User not found", nil
+	}
+
+	reference := fmt.Sprintf("VA-%d-%d", in.ClientId, in.UserId)
+
+	res, err := db.Exec("INSERT INTO virtual_accounts (client_id, user_id, username, provider, provider_reference, status, created_at) VALUES (?,?,?,?,?,?,NOW())",
+		in.ClientId, in.UserId, username, wayabankProvider, reference, models.StatusPending)
+
+	if err != nil {
+
+		// the unique key on client, user and provider rejects a second account
+		log.Printf("error reserving virtual account for user %d %s ", in.UserId, err.Error())
+		return false, 409, "Virtual account already exists", nil
+	}
+
+	id, _ := res.LastInsertId()
+
+	created, err := client.CreateVirtualAccount(username, reference)
+	if err != nil {
+
+		log.Printf("error creating wayabank virtual account for user %d %s ", in.UserId, err.Error())
+		_, _ = db.Exec("DELETE FROM virtual_accounts WHERE id = ? AND status = ?", id, models.StatusPending)
+		return false, 500, "Unable to create virtual account", nil
+	}
+
+	_, err = db.Exec("UPDATE virtual_accounts SET account_number = ?, account_name = ?, bank_name = ?, status = ? WHERE id = ?",
+		created.AccountNumber, created.AccountName, created.BankName, models.StatusCompleted, id)
+
+	if err != nil {
+
+		// the reservation stays pending and is taken over by the player's next request once stale
+		log.Printf("error saving virtual account %s for user %d %s ", created.AccountNumber, in.UserId, err.Error())
+		return false, 500, "Unable to save virtual account", nil
+	}
+
+	account, err = getVirtualAccount(db, in.ClientId, in.UserId, wayabankProvider)
+	if err != nil {
+
+		return false, 500, "Unable to fetch virtual account", nil
+	}
+
+	return true, 200, "Virtual account created", toStruct(account)
+}
+
+// WayabankAccountEnquiry returns the player's virtual account and its recent inflows, crediting any
+// inflow that has not been credited yet
+func WayabankAccountEnquiry(db *sql.DB, in *pbWallet.WayaBankRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	account, err := getVirtualAccount(db, in.ClientId, in.UserId, wayabankProvider)
+	if err != nil || account.Status != models.StatusCompleted {
+
+		return false, 404, "Virtual account not found", nil
+	}
+
+	client, err := wayabankClient(db, in.ClientId)
+	if err != nil {
+
+		return false, 404, "Wayabank is not configured for this client", nil
+	}
+
+	inflows, err := client.Inflows(account.AccountNumber)
+	if err != nil {
+
+		log.Printf("error getting inflows for virtual account %s %s ", account.AccountNumber, err.Error())
+		return false, 500, "Unable to fetch account inflows", nil
+	}
+
+	list := make([]map[string]interface{}, 0, len(inflows))
+
+	for _, inflow := range inflows {
+
+		if !strings.EqualFold(inflow.Type, "credit") || inflow.Reference == "" {
+
+			continue
+		}
+
+		deposit, err := creditVirtualAccountInflow(db, account, inflow)
+		if err != nil {
+
+			log.Printf("error crediting inflow %s to %s %s ", inflow.Reference, account.AccountNumber, err.Error())
+		}
+
+		item := map[string]interface{}{
+			"reference":  inflow.Reference,
+			"amount":     inflow.Amount,
+			"narration":  inflow.Narration,
+			"senderName": inflow.SenderName,
+			"date":       inflow.Date,
+			"credited":   deposit != nil && deposit.Status == models.StatusCompleted,
+		}
+
+		if deposit != nil {
+
+			item["transactionNo"] = deposit.TransactionNo
+		}
+
+		list = append(list, item)
+	}
+
+	return true, 200, "Account details fetched", toStruct(map[string]interface{}{
+		"accountNumber": account.AccountNumber,
+		"accountName":   account.AccountName,
+		"bankName":      account.BankName,
+		"createdAt":     account.CreatedAt,
+		"inflows":       list,
+	})
+}
+
+// SweepVirtualAccountInflows credits transfers into wayabank virtual accounts without waiting for the
+// player to open their account, checking the accounts that have gone longest without a check first
+func SweepVirtualAccountInflows(db *sql.DB) {
+
+	rows, err := db.Query("SELECT "+virtualAccountColumns+" FROM virtual_accounts WHERE provider = ? AND status = ? "+
+		" ORDER BY last_checked_at, id LIMIT ?", wayabankProvider, models.StatusCompleted, inflowSweepBatch)
+
+	if err != nil {
+
+		log.Printf("error getting virtual accounts to sweep %s ", err.Error())
+		return
+	}
+
+	var accounts []*models.VirtualAccount
+	for rows.Next() {
+
+		account, err := scanVirtualAccount(rows)
+		if err != nil {
+
+			log.Printf("error scanning virtual account %s ", err.Error())
+			continue
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	rows.Close()
+
+	clients := map[int64]*wayabank.Client{}
+	var checked, credited int
+
+	for _, account := range accounts {
+
+		client, ok := clients[account.ClientID]
+		if !ok {
+
+			if client, err = wayabankClient(db, int32(account.ClientID)); err != nil {
+
+				log.Printf("wayabank is not configured for client %d ", account.ClientID)
+			}
+
+			clients[account.ClientID] = client
+		}
+
+		if client == nil {
+
+			continue
+		}
+
+		inflows, err := client.Inflows(account.AccountNumber)
+		if err != nil {
+
+			log.Printf("error getting inflows for virtual account %s %s ", account.AccountNumber, err.Error())
+			continue
+		}
+
+		checked++
+
+		for _, inflow := range inflows {
+
+			if !strings.EqualFold(inflow.Type, "credit") || inflow.Reference == "" {
+
+				continue
+			}
+
+			deposit, err := getAccountDeposit(db, account.Provider, account.AccountNumber, inflow.Reference)
+			if err == nil && deposit.Status != models.StatusPending {
+
+				continue
+			}
+
+			if deposit, err = creditVirtualAccountInflow(db, account, inflow); err != nil {
+
+				log.Printf("error crediting inflow %s to %s %s ", inflow.Reference, account.AccountNumber, err.Error())
+				continue
+			}
+
+			if deposit.Status == models.StatusCompleted {
+
+				credited++
+			}
+		}
+
+		if _, err := db.Exec("UPDATE virtual_accounts SET last_checked_at = NOW() WHERE id = ?", account.ID); err != nil {
+
+			log.Printf("error marking virtual account %s checked %s ", account.AccountNumber, err.Error())
+		}
+	}
+
+	log.Printf("inflow sweep %s: checked %d accounts, credited %d inflows ", wayabankProvider, checked, credited)
+}
+
+// creditVirtualAccountInflow credits a transfer into a virtual account to its owner once per reference
+// and account. A deposit saved for the account under another player is never credited
+func creditVirtualAccountInflow(db *sql.DB, account *models.VirtualAccount, inflow wayabank.Inflow) (*models.Deposit, error) {
+
+	deposit, err := getAccountDeposit(db, account.Provider, account.AccountNumber, inflow.Reference)
+	if err == sql.ErrNoRows {
+
+		deposit = &models.Deposit{
+			ClientID:          account.ClientID,
+			UserID:            account.UserID,
+			Username:          account.Username,
+			Provider:          account.Provider,
+			Reference:         inflow.Reference,
+			ProviderReference: account.AccountNumber,
+			Amount:            inflow.Amount,
+			Source:            "bank-transfer",
+		}
+
+		if err = createAccountDeposit(db, deposit, account.AccountNumber); err != nil {
+
+			deposit, err = getAccountDeposit(db, account.Provider, account.AccountNumber, inflow.Reference)
+		}
+	}
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	if deposit.ClientID != account.ClientID || deposit.UserID != account.UserID {
+
+		return nil, fmt.Errorf("inflow %s to %s was saved for user %d, the account belongs to %d", inflow.Reference, account.AccountNumber, deposit.UserID, account.UserID)
+	}
+
+	if deposit.Status == models.StatusPending {
+
+		_, err = completeDeposit(db, deposit, "COMPLETED")
+	}
+
+	return deposit, err
+}
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+	"github.com/zoroplay/go-wallet-service/providers/wayaquick"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const wayaquickProvider = "wayaquick"
+
+func wayaquickClient(db *sql.DB, clientId int32) (*wayaquick.Client, error) {
+
+	pm, err := getPaymentMethod(db, clientId, wayaquickProvider)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return wayaquick.NewClient(pm.BaseURL, pm.SecretKey, pm.PublicKey, pm.MerchantID), nil
+}
+
+// wayaquickCurrency returns the currency a client's wayaquick checkouts are paid in, from the country
+// set on its payment method. Clients that have not set a country collect in Nigeria as they always have
+func wayaquickCurrency(pm *models.PaymentMethod) string {
+
+	if pm.Country == "" {
+
+		return wayaquick.Currency("NGA")
+	}
+
+	return wayaquick.Currency(pm.Country)
+}
+
+// WayaQuickInit saves a pending deposit and returns the wayaquick checkout link for it
+func WayaQuickInit(db *sql.DB, in *pbWallet.WayaQuickRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	log.Printf("WayaQuick deposit for user %d in client %d ", in.UserId, in.ClientId)
+
+	if in.GetAmount() <= 0 {
+
+		return false, 400, "Invalid amount", nil
+	}
+
+	pm, err := getPaymentMethod(db, in.ClientId, wayaquickProvider)
+	if err != nil {
+
+		return false, 404, "WayaQuick is not configured for this client", nil
+	}
+
+	currency := wayaquickCurrency(pm)
+	if currency == "" {
+
+		return false, 400, fmt.Sprintf("WayaQuick does not collect in %s", pm.Country), nil
+	}
+
+	// money taken in a currency the client has not enabled could never be credited to a wallet
+	if _, err := resolveCurrency(db, in.ClientId, currency); err != nil {
+
+		log.Printf("error resolving currency %s of client %d %s", currency, in.ClientId, err.Error())
+		return false, 400, fmt.Sprintf("%s is not enabled for this client", currency), nil
+	}
+
+	client := wayaquick.NewClient(pm.BaseURL, pm.SecretKey, pm.PublicKey, pm.MerchantID)
+
+	if err := checkPaymentLimits(db, in.ClientId, wayaquickProvider, float64(in.GetAmount())); err != nil {
+
+		return false, 400, err.Error(), nil
+	}
+
+	username, err := getWalletUsername(db, in.ClientId, in.UserId)
+	if err != nil {
+
+		log.Printf("error getting user wallet with id %d  %s", in.UserId, err.Error())
+		return false, 404, "User not found", nil
+	}
+
+	deposit := &models.Deposit{
+		ClientID:  int64(in.ClientId),
+		UserID:    int64(in.UserId),
+		Username:  username,
+		Provider:  wayaquickProvider,
+		Reference: generateTrxNo(),
+		Amount:    float64(in.GetAmount()),
+		Currency:  currency,
+		Source:    wayaquickProvider,
+	}
+
+	if err := createDeposit(db, deposit); err != nil {
+
+		return false, 500, "Unable to save deposit", nil
+	}
+
+	tranId, link, err := client.Initialize(wayaquick.InitRequest{
+		Amount:        fmt.Sprintf("%d", in.GetAmount()),
+		Narration:     "Wallet deposit",
+		Currency:      deposit.Currency,
+		CustomerName:  username,
+		CustomerPhone: username,
+		Reference:     deposit.Reference,
+	})
+
+	if err != nil {
+
+		log.Printf("error initializing wayaquick deposit %s %s ", deposit.Reference, err.Error())
+		_, _ = failDeposit(db, deposit, "ERROR")
+		return false, 500, "Unable to initialize deposit", nil
+	}
+
+	_, err = db.Exec("UPDATE deposits SET provider_reference = ?, provider_status = ? WHERE id = ?", tranId, wayaquick.StatusPending, deposit.ID)
+	if err != nil {
+
+		// without the transaction id the deposit can never be verified, so it must not be paid
+		log.Printf("error saving wayaquick transaction id %s for deposit %s %s ", tranId, deposit.Reference, err.Error())
+		_, _ = failDeposit(db, deposit, "ERROR")
+		return false, 500, "Unable to save deposit", nil
+	}
+
+	return true, 200, "Deposit initialized", toStruct(map[string]interface{}{
+		"link":           link,
+		"transactionId":  tranId,
+		"transactionRef": deposit.Reference,
+	})
+}
+
+// WayaQuickVerify confirms a deposit with wayaquick and credits it once
+func WayaQuickVerify(db *sql.DB, in *pbWallet.WayaQuickRequest) (success bool, status int32, message string, data *structpb.Struct) {
+
+	deposit, err := getDepositByProviderReference(db, wayaquickProvider, in.GetTransactionId())
+	if err == sql.ErrNoRows {
+
+		deposit, err = getDepositByReference(db, wayaquickProvider, in.GetTransactionId())
+	}
+
+	if err != nil {
+
+		return false, 404, "Transaction not found", nil
+	}
+
+	if deposit.Status != models.StatusPending {
+
+		return deposit.Status == models.StatusCompleted, 200, "Transaction already processed", toStruct(deposit)
+	}
+
+	client, err := wayaquickClient(db, int32(deposit.ClientID))
+	if err != nil {
+
+		return false, 404, "WayaQuick is not configured for this client", nil
+	}
+
+	if err := verifyWayaQuickDeposit(db, client, deposit); err != nil {
+
+		log.Printf("error verifying wayaquick deposit %s %s ", deposit.Reference, err.Error())
+		return false, 500, "Unable to verify deposit", nil
+	}
+
+	switch deposit.Status {
+	case models.StatusCompleted:
+		return true, 200, "Deposit credited", toStruct(deposit)
+	case models.StatusFailed:
+		return false, 400, "Deposit failed", toStruct(deposit)
+	default:
+		return false, 202, "Deposit is pending", toStruct(deposit)
+	}
+}
+
+// verifyWayaQuickDeposit applies the outcome wayaquick reports for a deposit. A payment for less than
+// the deposit, or one reported without an amount, fails the deposit rather than crediting it
+func verifyWayaQuickDeposit(db *sql.DB, client *wayaquick.Client, deposit *models.Deposit) error {
+
+	payment, err := client.Verify(deposit.ProviderReference)
+	if err != nil {
+
+		return err
+	}
+
+	switch payment.Status {
+	case wayaquick.StatusSuccessful:
+		if payment.Amount <= 0 || payment.Amount < deposit.Amount {
+
+			log.Printf("wayaquick deposit %s paid %.2f of %.2f ", deposit.Reference, payment.Amount, deposit.Amount)
+			_, err = failDeposit(db, deposit, "AMOUNT_MISMATCH")
+			break
+		}
+
+		_, err = completeDeposit(db, deposit, payment.Status)
+	case wayaquick.StatusFailed, wayaquick.StatusAbandoned:
+		_, err = failDeposit(db, deposit, payment.Status)
+	default:
+		setDepositProviderStatus(db, deposit, payment.Status)
+	}
+
+	return err
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+// wayaquickDB returns a database holding the wayaquick settings of client 1 for a country, with the
+// given currencies enabled for the client
+func wayaquickDB(t *testing.T, baseURL string, country interface{}, currencies ...string) (*sql.DB, *fakeDB) {
+
+	db, fake := newFakeDB(t)
+
+	fake.On("SELECT min_amount, max_amount FROM payment_methods", []string{"min_amount", "max_amount"}, []driver.Value{0.0, 0.0})
+	fake.On("FROM payment_methods", paymentMethodFields, []driver.Value{
+		int64(1), int64(1), "WayaQuick", wayaquickProvider, "secret", "public", "merchant", baseURL, int64(1), int64(0),
+		0.0, 0.0, int64(0), country, nil,
+	})
+
+	var rows [][]driver.Value
+	for _, c := range currencies {
+
+		rows = append(rows, []driver.Value{int64(1), c, c, int64(2), len(rows) == 0})
+	}
+
+	fake.On("FROM client_currencies", []string{"client_id", "currency", "name", "minor_units", "is_default"}, rows...)
+	fake.On("SELECT username FROM wallets", []string{"username"}, []driver.Value{"2348000000000"})
+
+	return db, fake
+}
+
+func TestWayaQuickInitCurrency(t *testing.T) {
+
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		sent = r.URL.Path
+		w.Write([]byte(`{"status":true,"message":"ok","data":{"tranId":"WQ-1"}}`))
+	}))
+
+	defer server.Close()
+
+	cases := []struct {
+		name       string
+		country    interface{}
+		currencies []string
+		status     int32
+		currency   string
+	}{
+		{"nigerian checkout", "NGA", []string{"NGN"}, 200, "NGN"},
+		{"no country set", nil, []string{"NGN", "KES"}, 200, "NGN"},
+		{"currency not enabled", "NGA", []string{"KES"}, 400, ""},
+		{"country wayaquick does not serve", "GHA", []string{"GHS"}, 400, ""},
+	}
+
+	for _, c := range cases {
+
+		t.Run(c.name, func(t *testing.T) {
+
+			sent = ""
+			db, fake := wayaquickDB(t, server.URL, c.country, c.currencies...)
+
+			amount := int32(500)
+			_, status, message, _ := WayaQuickInit(db, &pbWallet.WayaQuickRequest{ClientId: 1, UserId: 9, Amount: &amount})
+			if status != c.status {
+
+				t.Fatalf("expected status %d, got %d %s", c.status, status, message)
+			}
+
+			deposits := fake.Ran("INSERT INTO deposits")
+
+			if c.currency == "" {
+
+				if len(deposits) != 0 || sent != "" {
+
+					t.Fatalf("expected no deposit and no checkout, got %v %q", deposits, sent)
+				}
+
+				return
+			}
+
+			if len(deposits) != 1 || deposits[0].args[8] != c.currency || sent == "" {
+
+				t.Fatalf("expected a %s deposit sent for checkout, got %v %q", c.currency, deposits, sent)
+			}
+		})
+	}
+}
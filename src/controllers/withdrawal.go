@@ -0,0 +1,251 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/encryption"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const withdrawalColumns = "id, client_id, user_id, username, withdrawal_code, amount, currency, country, account_number, account_name, bank_code, bank_name, " +
+	"msisdn, provider, provider_reference, source, status, provider_status, comment, updated_by, created_at, updated_at"
+
+func scanWithdrawal(row rowScanner) (*models.Withdrawal, error) {
+
+	var w models.Withdrawal
+	var country, accountNumber, accountName, bankCode, bankName, msisdn, provider, providerReference, providerStatus, comment, updatedBy sql.NullString
+
+	err := row.Scan(&w.ID, &w.ClientID, &w.UserID, &w.Username, &w.WithdrawalCode, &w.Amount, &w.Currency, &country, &accountNumber, &accountName,
+		&bankCode, &bankName, &msisdn, &provider, &providerReference, &w.Source, &w.Status, &providerStatus, &comment, &updatedBy,
+		&w.CreatedAt, &w.UpdatedAt)
+
+	if err != nil {
+
+		return nil, err
+	}
+
+	w.AccountNumber, err = encryption.Decrypt(accountNumber.String)
+	if err != nil {
+
+		return nil, err
+	}
+
+	w.Country = country.String
+	w.AccountName = accountName.String
+	w.BankCode = bankCode.String
+	w.BankName = bankName.String
+	w.Msisdn = msisdn.String
+	w.Provider = provider.String
+	w.ProviderReference = providerReference.String
+	w.ProviderStatus = providerStatus.String
+	w.Comment = comment.String
+	w.UpdatedBy = updatedBy.String
+
+	return &w, nil
+}
+
+// createWithdrawal debits the player and saves a pending withdrawal. The debit is reversed if the
+// withdrawal cannot be saved
+func createWithdrawal(db *sql.DB, w *models.Withdrawal) (status int32, message string, err error) {
+
+	if w.WithdrawalCode == "" {
+
+		w.WithdrawalCode = generateTrxNo()
+	}
+
+	success, status, message, _, _ := debitUser(db, &pbWallet.DebitUserRequest{
+		UserId:      int32(w.UserID),
+		ClientId:    int32(w.ClientID),
+		Amount:      fmt.Sprintf("%.2f", w.Amount),
+		Source:      w.Source,
+		Description: fmt.Sprintf("Withdrawal request - %s", w.WithdrawalCode),
+		Username:    w.Username,
+		Wallet:      "main",
+		Subject:     models.SubjectWithdrawal,
+		Channel:     w.Provider,
+		Currency:    &w.Currency,
+	})
+
+	if !success {
+
+		return status, message, fmt.Errorf("unable to debit withdrawal %s: %s", w.WithdrawalCode, message)
+	}
+
+	accountNumber, err := encryption.Encrypt(w.AccountNumber)
+	if err != nil {
+
+		log.Printf("error encrypting account number for withdrawal %s %s ", w.WithdrawalCode, err.Error())
+		refundWithdrawal(db, w)
+		return 500, "Unable to save withdrawal", err
+	}
+
+	res, err := db.Exec("INSERT INTO withdrawals (client_id, user_id, username, withdrawal_code, amount, currency, country, account_number, account_name, "+
+		" bank_code, bank_name, msisdn, provider, provider_reference, source, status, created_at) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,NOW())",
+		w.ClientID, w.UserID, w.Username, w.WithdrawalCode, w.Amount, w.Currency, nullString(w.Country), nullString(accountNumber), nullString(w.AccountName),
+		nullString(w.BankCode), nullString(w.BankName), nullString(w.Msisdn), nullString(w.Provider), nullString(w.ProviderReference), w.Source,
+		models.StatusPending)
+
+	if err != nil {
+
+		log.Printf("error saving withdrawal %s %s ", w.WithdrawalCode, err.Error())
+		refundWithdrawal(db, w)
+		return 500, "Unable to save withdrawal", err
+	}
+
+	w.ID, _ = res.LastInsertId()
+	w.Status = models.StatusPending
+
+	return 200, "Withdrawal created", nil
+}
+
+func getWithdrawalByProviderReference(db *sql.DB, provider, reference string) (*models.Withdrawal, error) {
+
+	return scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE provider = ? AND provider_reference = ?", provider, reference))
+}
+
+func getWithdrawalByCode(db *sql.DB, code string) (*models.Withdrawal, error) {
+
+	return scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE withdrawal_code = ?", code))
+}
+
+// completeWithdrawal marks a pending withdrawal as paid out
+func completeWithdrawal(db *sql.DB, w *models.Withdrawal, providerStatus string) (bool, error) {
+
+	res, err := db.Exec("UPDATE withdrawals SET status = ?, provider_status = ? WHERE id = ? AND status = ?",
+		models.StatusCompleted, providerStatus, w.ID, models.StatusPending)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	n, _ := res.RowsAffected()
+	if n > 0 {
+
+		w.Status = models.StatusCompleted
+		w.ProviderStatus = providerStatus
+		settleAttempt(db, w, attemptCompleted)
+	}
+
+	return n > 0, nil
+}
+
+// failWithdrawal marks a pending withdrawal failed and refunds the player once
+func failWithdrawal(db *sql.DB, w *models.Withdrawal, providerStatus, comment string) (bool, error) {
+
+	res, err := db.Exec("UPDATE withdrawals SET status = ?, provider_status = ?, comment = ? WHERE id = ? AND status = ?",
+		models.StatusFailed, providerStatus, comment, w.ID, models.StatusPending)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, nil
+	}
+
+	w.Status = models.StatusFailed
+	w.ProviderStatus = providerStatus
+	w.Comment = comment
+	settleAttempt(db, w, attemptFailed)
+
+	if !refundWithdrawal(db, w) {
+
+		return true, fmt.Errorf("withdrawal %s failed but refund was not credited", w.WithdrawalCode)
+	}
+
+	return true, nil
+}
+
+// setWithdrawalProviderStatus records an intermediate provider status without changing the withdrawal state
+func setWithdrawalProviderStatus(db *sql.DB, w *models.Withdrawal, providerStatus string) {
+
+	_, err := db.Exec("UPDATE withdrawals SET provider_status = ? WHERE id = ? AND status = ?", providerStatus, w.ID, models.StatusPending)
+	if err != nil {
+
+		log.Printf("error updating withdrawal %d provider status %s ", w.ID, err.Error())
+	}
+}
+
+func refundWithdrawal(db *sql.DB, w *models.Withdrawal) bool {
+
+	success, _, message, _ := CreditUser(db, &pbWallet.CreditUserRequest{
+		UserId:      int32(w.UserID),
+		ClientId:    int32(w.ClientID),
+		Amount:      fmt.Sprintf("%.2f", w.Amount),
+		Source:      w.Source,
+		Description: fmt.Sprintf("Withdrawal refund - %s", w.WithdrawalCode),
+		Username:    w.Username,
+		Wallet:      "main",
+		Subject:     models.SubjectWithdrawalRefund,
+		Channel:     w.Provider,
+		Currency:    &w.Currency,
+	})
+
+	if !success {
+
+		log.Printf("error refunding withdrawal %s %s ", w.WithdrawalCode, message)
+	}
+
+	return success
+}
+
+// RequestWithdrawal debits the player and saves a pending bank withdrawal. Bank payouts are only
+// allowed to accounts the player has verified under their registered name
+func RequestWithdrawal(db *sql.DB, in *pbWallet.WithdrawRequest) (success bool, status int32, message string, data *pbWallet.Withdraw) {
+
+	log.Printf("Withdrawal request for user %d in client %d ", in.UserId, in.ClientId)
+
+	if in.Amount <= 0 {
+
+		return false, 400, "Invalid amount", nil
+	}
+
+	if in.AccountNumber != "" && in.GetBankCode() == "" {
+
+		return false, 400, "Bank code is required", nil
+	}
+
+	if in.AccountNumber != "" {
+
+		if err := checkWithdrawalAccount(db, in.ClientId, in.UserId, in.GetBankCode(), in.AccountNumber); err != nil {
+
+			log.Printf("withdrawal account check failed for user %d %s ", in.UserId, err.Error())
+			return false, 400, err.Error(), nil
+		}
+	}
+
+	withdrawal := &models.Withdrawal{
+		ClientID:      int64(in.ClientId),
+		UserID:        int64(in.UserId),
+		Username:      in.Username,
+		Amount:        in.Amount,
+		AccountNumber: in.AccountNumber,
+		AccountName:   in.AccountName,
+		BankCode:      in.GetBankCode(),
+		BankName:      in.GetBankName(),
+		Source:        in.GetSource(),
+	}
+
+	if status, message, err := createWithdrawal(db, withdrawal); err != nil {
+
+		return false, status, message, nil
+	}
+
+	var balance float64
+	err := db.QueryRow("SELECT available_balance FROM wallets WHERE user_id = ? AND client_id = ? AND "+defaultCurrencyWallet, in.UserId, in.ClientId).Scan(&balance)
+	if err != nil {
+
+		log.Printf("error getting balance for user %d %s ", in.UserId, err.Error())
+	}
+
+	return true, 200, "Withdrawal request submitted", &pbWallet.Withdraw{
+		Balance: balance,
+		Code:    withdrawal.WithdrawalCode,
+	}
+}
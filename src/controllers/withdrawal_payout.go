@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+const withdrawalPayoutColumns = "id, withdrawal_id, provider, payout_id, amount, status, provider_status, failure_reason, refunded"
+
+func scanWithdrawalPayout(row rowScanner) (*models.WithdrawalPayout, error) {
+
+	var p models.WithdrawalPayout
+	var providerStatus, failureReason sql.NullString
+
+	err := row.Scan(&p.ID, &p.WithdrawalID, &p.Provider, &p.PayoutID, &p.Amount, &p.Status, &providerStatus, &failureReason, &p.Refunded)
+	if err != nil {
+
+		return nil, err
+	}
+
+	p.ProviderStatus = providerStatus.String
+	p.FailureReason = failureReason.String
+
+	return &p, nil
+}
+
+// createWithdrawalPayout saves one pending part of a split withdrawal
+func createWithdrawalPayout(db *sql.DB, p *models.WithdrawalPayout) error {
+
+	res, err := db.Exec("INSERT INTO withdrawal_payouts (withdrawal_id, provider, payout_id, amount, status, created_at) VALUES (?,?,?,?,?,NOW())",
+		p.WithdrawalID, p.Provider, p.PayoutID, p.Amount, models.StatusPending)
+
+	if err != nil {
+
+		log.Printf("error saving withdrawal payout %s %s ", p.PayoutID, err.Error())
+		return err
+	}
+
+	p.ID, _ = res.LastInsertId()
+	p.Status = models.StatusPending
+	return nil
+}
+
+func getWithdrawalPayout(db *sql.DB, provider, payoutId string) (*models.WithdrawalPayout, error) {
+
+	return scanWithdrawalPayout(db.QueryRow("SELECT "+withdrawalPayoutColumns+" FROM withdrawal_payouts WHERE provider = ? AND payout_id = ?", provider, payoutId))
+}
+
+func getWithdrawalByID(db *sql.DB, id int64) (*models.Withdrawal, error) {
+
+	return scanWithdrawal(db.QueryRow("SELECT "+withdrawalColumns+" FROM withdrawals WHERE id = ?", id))
+}
+
+// completeWithdrawalPayout marks one part of a split withdrawal paid and settles the parent
+func completeWithdrawalPayout(db *sql.DB, p *models.WithdrawalPayout, providerStatus string) (bool, error) {
+
+	res, err := db.Exec("UPDATE withdrawal_payouts SET status = ?, provider_status = ? WHERE id = ? AND status = ?",
+		models.StatusCompleted, providerStatus, p.ID, models.StatusPending)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, nil
+	}
+
+	p.Status = models.StatusCompleted
+	p.ProviderStatus = providerStatus
+
+	return true, settleWithdrawal(db, p.WithdrawalID)
+}
+
+// failWithdrawalPayout marks one part of a split withdrawal failed, refunds only that part and settles the parent
+func failWithdrawalPayout(db *sql.DB, p *models.WithdrawalPayout, providerStatus, reason string) (bool, error) {
+
+	res, err := db.Exec("UPDATE withdrawal_payouts SET status = ?, provider_status = ?, failure_reason = ? WHERE id = ? AND status = ?",
+		models.StatusFailed, providerStatus, reason, p.ID, models.StatusPending)
+
+	if err != nil {
+
+		return false, err
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+
+		return false, nil
+	}
+
+	p.Status = models.StatusFailed
+	p.ProviderStatus = providerStatus
+	p.FailureReason = reason
+
+	withdrawal, err := getWithdrawalByID(db, p.WithdrawalID)
+	if err != nil {
+
+		return true, err
+	}
+
+	part := *withdrawal
+	part.Amount = p.Amount
+
+	if !refundWithdrawal(db, &part) {
+
+		return true, fmt.Errorf("payout %s failed but refund was not credited", p.PayoutID)
+	}
+
+	_, err = db.Exec("UPDATE withdrawal_payouts SET refunded = 1 WHERE id = ?", p.ID)
+	if err != nil {
+
+		log.Printf("error flagging payout %s refunded %s ", p.PayoutID, err.Error())
+	}
+
+	p.Refunded = 1
+
+	return true, settleWithdrawal(db, p.WithdrawalID)
+}
+
+// abandonWithdrawalPayouts fails the pending parts of a split withdrawal that is reversed before any
+// part was sent. The parent refund returns their money, so they are flagged refunded
+func abandonWithdrawalPayouts(db *sql.DB, withdrawalId int64, reason string) {
+
+	_, err := db.Exec("UPDATE withdrawal_payouts SET status = ?, provider_status = 'ERROR', failure_reason = ?, refunded = 1 "+
+		" WHERE withdrawal_id = ? AND status = ?", models.StatusFailed, reason, withdrawalId, models.StatusPending)
+
+	if err != nil {
+
+		log.Printf("error failing payouts of withdrawal %d %s ", withdrawalId, err.Error())
+	}
+}
+
+// setWithdrawalPayoutProviderStatus records an intermediate provider status on a payout
+func setWithdrawalPayoutProviderStatus(db *sql.DB, p *models.WithdrawalPayout, providerStatus string) {
+
+	_, err := db.Exec("UPDATE withdrawal_payouts SET provider_status = ? WHERE id = ? AND status = ?", providerStatus, p.ID, models.StatusPending)
+	if err != nil {
+
+		log.Printf("error updating payout %d provider status %s ", p.ID, err.Error())
+	}
+}
+
+// settleWithdrawal closes a split withdrawal once none of its payouts are pending. Failed payouts
+// are refunded individually, so the parent only records the outcome
+func settleWithdrawal(db *sql.DB, withdrawalId int64) error {
+
+	var pending, completed, failed int64
+
+	err := db.QueryRow("SELECT COALESCE(SUM(status = ?), 0), COALESCE(SUM(status = ?), 0), COALESCE(SUM(status = ?), 0) "+
+		" FROM withdrawal_payouts WHERE withdrawal_id = ?", models.StatusPending, models.StatusCompleted, models.StatusFailed, withdrawalId).
+		Scan(&pending, &completed, &failed)
+
+	if err != nil {
+
+		return err
+	}
+
+	if pending > 0 {
+
+		return nil
+	}
+
+	status := models.StatusCompleted
+	if failed > 0 && completed > 0 {
+
+		status = models.StatusPartial
+
+	} else if failed > 0 {
+
+		status = models.StatusFailed
+	}
+
+	_, err = db.Exec("UPDATE withdrawals SET status = ? WHERE id = ? AND status = ?", status, withdrawalId, models.StatusPending)
+	return err
+}
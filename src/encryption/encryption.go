@@ -0,0 +1,133 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+)
+
+// prefix marks encrypted values so values saved before encryption was introduced can still be read
+const prefix = "enc:v1:"
+
+var ErrNoKey = errors.New("encryption_key is not set")
+
+// masterKey derives the 32 byte AES key from the encryption_key setting
+func masterKey() ([]byte, error) {
+
+	secret := os.Getenv("encryption_key")
+	if secret == "" {
+
+		return nil, ErrNoKey
+	}
+
+	key := sha256.Sum256([]byte(secret))
+	return key[:], nil
+}
+
+// Encrypt seals a value with AES-GCM under the master key
+func Encrypt(plain string) (string, error) {
+
+	if plain == "" {
+
+		return "", nil
+	}
+
+	key, err := masterKey()
+	if err != nil {
+
+		return "", err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(plain), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value sealed by Encrypt. Values without the encryption prefix are returned as they are
+func Decrypt(value string) (string, error) {
+
+	if !IsEncrypted(value) {
+
+		return value, nil
+	}
+
+	key, err := masterKey()
+	if err != nil {
+
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil {
+
+		return "", err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+
+		return "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+
+		return "", err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+
+		return "", errors.New("encrypted value is too short")
+	}
+
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+
+		return "", err
+	}
+
+	return string(plain), nil
+}
+
+func IsEncrypted(value string) bool {
+
+	return strings.HasPrefix(value, prefix)
+}
+
+// Hash returns a keyed digest of a value, used to look up and de-duplicate encrypted columns
+func Hash(value string) (string, error) {
+
+	key, err := masterKey()
+	if err != nil {
+
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
@@ -217,6 +217,7 @@ type TigoWebhookRequest struct {
 	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
 	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	Status        bool                   `protobuf:"varint,5,opt,name=Status,proto3" json:"Status,omitempty"`
+	Signature     string                 `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -286,6 +287,13 @@ func (x *TigoWebhookRequest) GetStatus() bool {
 	return false
 }
 
+func (x *TigoWebhookRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
 type TigoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1126,6 +1134,7 @@ type FetchLastApprovedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BranchId      int32                  `protobuf:"varint,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
 	ClientId      int32                  `protobuf:"varint,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	VerifiedBy    *int32                 `protobuf:"varint,3,opt,name=verifiedBy,proto3,oneof" json:"verifiedBy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1174,6 +1183,13 @@ func (x *FetchLastApprovedRequest) GetClientId() int32 {
 	return 0
 }
 
+func (x *FetchLastApprovedRequest) GetVerifiedBy() int32 {
+	if x != nil && x.VerifiedBy != nil {
+		return *x.VerifiedBy
+	}
+	return 0
+}
+
 type FetchSalesReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BranchId      int32                  `protobuf:"varint,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
@@ -1439,26 +1455,28 @@ func (x *LastApprovedResponseObj) GetData() *LastApproved {
 }
 
 type LastApproved struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BranchId       int32                  `protobuf:"varint,2,opt,name=branchId,proto3" json:"branchId,omitempty"`
-	OpeningBalance int32                  `protobuf:"varint,3,opt,name=openingBalance,proto3" json:"openingBalance,omitempty"`
-	ClosingBalance int32                  `protobuf:"varint,4,opt,name=closingBalance,proto3" json:"closingBalance,omitempty"`
-	OnlinePayouts  int32                  `protobuf:"varint,5,opt,name=onlinePayouts,proto3" json:"onlinePayouts,omitempty"`
-	OnlineSales    int32                  `protobuf:"varint,6,opt,name=onlineSales,proto3" json:"onlineSales,omitempty"`
-	NormalSales    int32                  `protobuf:"varint,7,opt,name=normalSales,proto3" json:"normalSales,omitempty"`
-	NormalPayouts  int32                  `protobuf:"varint,8,opt,name=normalPayouts,proto3" json:"normalPayouts,omitempty"`
-	OtherSales     int32                  `protobuf:"varint,9,opt,name=otherSales,proto3" json:"otherSales,omitempty"`
-	OtherPayouts   int32                  `protobuf:"varint,10,opt,name=otherPayouts,proto3" json:"otherPayouts,omitempty"`
-	Cashin         int32                  `protobuf:"varint,11,opt,name=cashin,proto3" json:"cashin,omitempty"`
-	Cashout        int32                  `protobuf:"varint,12,opt,name=cashout,proto3" json:"cashout,omitempty"`
-	Expenses       int32                  `protobuf:"varint,13,opt,name=expenses,proto3" json:"expenses,omitempty"`
-	Status         int32                  `protobuf:"varint,14,opt,name=status,proto3" json:"status,omitempty"`
-	Date           string                 `protobuf:"bytes,15,opt,name=date,proto3" json:"date,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	ClientId       int32                  `protobuf:"varint,17,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BranchId               int32                  `protobuf:"varint,2,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	OpeningBalance         int32                  `protobuf:"varint,3,opt,name=openingBalance,proto3" json:"openingBalance,omitempty"`
+	ClosingBalance         int32                  `protobuf:"varint,4,opt,name=closingBalance,proto3" json:"closingBalance,omitempty"`
+	OnlinePayouts          int32                  `protobuf:"varint,5,opt,name=onlinePayouts,proto3" json:"onlinePayouts,omitempty"`
+	OnlineSales            int32                  `protobuf:"varint,6,opt,name=onlineSales,proto3" json:"onlineSales,omitempty"`
+	NormalSales            int32                  `protobuf:"varint,7,opt,name=normalSales,proto3" json:"normalSales,omitempty"`
+	NormalPayouts          int32                  `protobuf:"varint,8,opt,name=normalPayouts,proto3" json:"normalPayouts,omitempty"`
+	OtherSales             int32                  `protobuf:"varint,9,opt,name=otherSales,proto3" json:"otherSales,omitempty"`
+	OtherPayouts           int32                  `protobuf:"varint,10,opt,name=otherPayouts,proto3" json:"otherPayouts,omitempty"`
+	Cashin                 int32                  `protobuf:"varint,11,opt,name=cashin,proto3" json:"cashin,omitempty"`
+	Cashout                int32                  `protobuf:"varint,12,opt,name=cashout,proto3" json:"cashout,omitempty"`
+	Expenses               int32                  `protobuf:"varint,13,opt,name=expenses,proto3" json:"expenses,omitempty"`
+	Status                 int32                  `protobuf:"varint,14,opt,name=status,proto3" json:"status,omitempty"`
+	Date                   string                 `protobuf:"bytes,15,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedAt              string                 `protobuf:"bytes,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ClientId               int32                  `protobuf:"varint,17,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ExpectedClosingBalance *int32                 `protobuf:"varint,18,opt,name=expectedClosingBalance,proto3,oneof" json:"expectedClosingBalance,omitempty"`
+	Variance               *int32                 `protobuf:"varint,19,opt,name=variance,proto3,oneof" json:"variance,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *LastApproved) Reset() {
@@ -1610,6 +1628,20 @@ func (x *LastApproved) GetClientId() int32 {
 	return 0
 }
 
+func (x *LastApproved) GetExpectedClosingBalance() int32 {
+	if x != nil && x.ExpectedClosingBalance != nil {
+		return *x.ExpectedClosingBalance
+	}
+	return 0
+}
+
+func (x *LastApproved) GetVariance() int32 {
+	if x != nil && x.Variance != nil {
+		return *x.Variance
+	}
+	return 0
+}
+
 type FetchReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
@@ -1675,19 +1707,21 @@ type HandleReportRequest struct {
 	BranchId       int32                  `protobuf:"varint,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
 	OpeningBalance int32                  `protobuf:"varint,2,opt,name=openingBalance,proto3" json:"openingBalance,omitempty"`
 	ClosingBalance int32                  `protobuf:"varint,3,opt,name=closingBalance,proto3" json:"closingBalance,omitempty"`
-	OnlinePayouts  int32                  `protobuf:"varint,4,opt,name=onlinePayouts,proto3" json:"onlinePayouts,omitempty"`
-	OnlineSales    int32                  `protobuf:"varint,5,opt,name=onlineSales,proto3" json:"onlineSales,omitempty"`
-	NormalSales    int32                  `protobuf:"varint,6,opt,name=normalSales,proto3" json:"normalSales,omitempty"`
-	NormalPayouts  int32                  `protobuf:"varint,7,opt,name=normalPayouts,proto3" json:"normalPayouts,omitempty"`
-	OtherPayouts   int32                  `protobuf:"varint,8,opt,name=otherPayouts,proto3" json:"otherPayouts,omitempty"`
-	OtherSales     int32                  `protobuf:"varint,9,opt,name=otherSales,proto3" json:"otherSales,omitempty"`
-	Cashin         int32                  `protobuf:"varint,10,opt,name=cashin,proto3" json:"cashin,omitempty"`
-	Cashout        int32                  `protobuf:"varint,11,opt,name=cashout,proto3" json:"cashout,omitempty"`
-	Expenses       int32                  `protobuf:"varint,12,opt,name=expenses,proto3" json:"expenses,omitempty"`
-	Date           string                 `protobuf:"bytes,13,opt,name=date,proto3" json:"date,omitempty"`
-	ClientId       int32                  `protobuf:"varint,14,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// sales, payouts, cash in, cash out and expenses are ignored, closing a day works them out from the
+	// shop's transactions and approved cashbook entries
+	OnlinePayouts int32  `protobuf:"varint,4,opt,name=onlinePayouts,proto3" json:"onlinePayouts,omitempty"`
+	OnlineSales   int32  `protobuf:"varint,5,opt,name=onlineSales,proto3" json:"onlineSales,omitempty"`
+	NormalSales   int32  `protobuf:"varint,6,opt,name=normalSales,proto3" json:"normalSales,omitempty"`
+	NormalPayouts int32  `protobuf:"varint,7,opt,name=normalPayouts,proto3" json:"normalPayouts,omitempty"`
+	OtherPayouts  int32  `protobuf:"varint,8,opt,name=otherPayouts,proto3" json:"otherPayouts,omitempty"`
+	OtherSales    int32  `protobuf:"varint,9,opt,name=otherSales,proto3" json:"otherSales,omitempty"`
+	Cashin        int32  `protobuf:"varint,10,opt,name=cashin,proto3" json:"cashin,omitempty"`
+	Cashout       int32  `protobuf:"varint,11,opt,name=cashout,proto3" json:"cashout,omitempty"`
+	Expenses      int32  `protobuf:"varint,12,opt,name=expenses,proto3" json:"expenses,omitempty"`
+	Date          string `protobuf:"bytes,13,opt,name=date,proto3" json:"date,omitempty"`
+	ClientId      int32  `protobuf:"varint,14,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HandleReportRequest) Reset() {
@@ -2086,6 +2120,98 @@ func (x *ProcessRetailTransaction) GetUserRole() string {
 	return ""
 }
 
+type TillSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	BranchId      int32                  `protobuf:"varint,2,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	CashierId     int32                  `protobuf:"varint,3,opt,name=cashierId,proto3" json:"cashierId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	SessionId     *int32                 `protobuf:"varint,5,opt,name=sessionId,proto3,oneof" json:"sessionId,omitempty"`
+	ToCashierId   *int32                 `protobuf:"varint,6,opt,name=toCashierId,proto3,oneof" json:"toCashierId,omitempty"`
+	Comment       *string                `protobuf:"bytes,7,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TillSessionRequest) Reset() {
+	*x = TillSessionRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TillSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TillSessionRequest) ProtoMessage() {}
+
+func (x *TillSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TillSessionRequest.ProtoReflect.Descriptor instead.
+func (*TillSessionRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TillSessionRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *TillSessionRequest) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *TillSessionRequest) GetCashierId() int32 {
+	if x != nil {
+		return x.CashierId
+	}
+	return 0
+}
+
+func (x *TillSessionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TillSessionRequest) GetSessionId() int32 {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return 0
+}
+
+func (x *TillSessionRequest) GetToCashierId() int32 {
+	if x != nil && x.ToCashierId != nil {
+		return *x.ToCashierId
+	}
+	return 0
+}
+
+func (x *TillSessionRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
 type WalletTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
@@ -2096,13 +2222,15 @@ type WalletTransferRequest struct {
 	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	Description   *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	FromCurrency  *string                `protobuf:"bytes,9,opt,name=fromCurrency,proto3,oneof" json:"fromCurrency,omitempty"`
+	ToCurrency    *string                `protobuf:"bytes,10,opt,name=toCurrency,proto3,oneof" json:"toCurrency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WalletTransferRequest) Reset() {
 	*x = WalletTransferRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[28]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2114,7 +2242,7 @@ func (x *WalletTransferRequest) String() string {
 func (*WalletTransferRequest) ProtoMessage() {}
 
 func (x *WalletTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[28]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,7 +2255,7 @@ func (x *WalletTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletTransferRequest.ProtoReflect.Descriptor instead.
 func (*WalletTransferRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{28}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *WalletTransferRequest) GetClientId() int32 {
@@ -2186,6 +2314,20 @@ func (x *WalletTransferRequest) GetAction() string {
 	return ""
 }
 
+func (x *WalletTransferRequest) GetFromCurrency() string {
+	if x != nil && x.FromCurrency != nil {
+		return *x.FromCurrency
+	}
+	return ""
+}
+
+func (x *WalletTransferRequest) GetToCurrency() string {
+	if x != nil && x.ToCurrency != nil {
+		return *x.ToCurrency
+	}
+	return ""
+}
+
 type ValidateTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
@@ -2198,7 +2340,7 @@ type ValidateTransactionRequest struct {
 
 func (x *ValidateTransactionRequest) Reset() {
 	*x = ValidateTransactionRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[29]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2210,7 +2352,7 @@ func (x *ValidateTransactionRequest) String() string {
 func (*ValidateTransactionRequest) ProtoMessage() {}
 
 func (x *ValidateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[29]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +2365,7 @@ func (x *ValidateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*ValidateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{29}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ValidateTransactionRequest) GetClientId() int32 {
@@ -2262,7 +2404,7 @@ type EmptyRequest struct {
 
 func (x *EmptyRequest) Reset() {
 	*x = EmptyRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[30]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2274,7 +2416,7 @@ func (x *EmptyRequest) String() string {
 func (*EmptyRequest) ProtoMessage() {}
 
 func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[30]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,7 +2429,51 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
 func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{30}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{31}
+}
+
+type ClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientRequest) Reset() {
+	*x = ClientRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRequest) ProtoMessage() {}
+
+func (x *ClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRequest.ProtoReflect.Descriptor instead.
+func (*ClientRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ClientRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
 }
 
 type BranchRequest struct {
@@ -2301,7 +2487,7 @@ type BranchRequest struct {
 
 func (x *BranchRequest) Reset() {
 	*x = BranchRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[31]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2313,7 +2499,7 @@ func (x *BranchRequest) String() string {
 func (*BranchRequest) ProtoMessage() {}
 
 func (x *BranchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[31]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,7 +2512,7 @@ func (x *BranchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BranchRequest.ProtoReflect.Descriptor instead.
 func (*BranchRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{31}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *BranchRequest) GetClientId() int32 {
@@ -2360,7 +2546,7 @@ type CashbookIdRequest struct {
 
 func (x *CashbookIdRequest) Reset() {
 	*x = CashbookIdRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[32]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2372,7 +2558,7 @@ func (x *CashbookIdRequest) String() string {
 func (*CashbookIdRequest) ProtoMessage() {}
 
 func (x *CashbookIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[32]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,7 +2571,7 @@ func (x *CashbookIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashbookIdRequest.ProtoReflect.Descriptor instead.
 func (*CashbookIdRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{32}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CashbookIdRequest) GetId() int32 {
@@ -2411,7 +2597,7 @@ type IdRequest struct {
 
 func (x *IdRequest) Reset() {
 	*x = IdRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[33]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2423,7 +2609,7 @@ func (x *IdRequest) String() string {
 func (*IdRequest) ProtoMessage() {}
 
 func (x *IdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[33]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +2622,7 @@ func (x *IdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdRequest.ProtoReflect.Descriptor instead.
 func (*IdRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{33}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *IdRequest) GetId() int32 {
@@ -2446,6 +2632,58 @@ func (x *IdRequest) GetId() int32 {
 	return 0
 }
 
+type DeletePlayerDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId      int32                  `protobuf:"varint,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlayerDataRequest) Reset() {
+	*x = DeletePlayerDataRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlayerDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlayerDataRequest) ProtoMessage() {}
+
+func (x *DeletePlayerDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlayerDataRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlayerDataRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeletePlayerDataRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeletePlayerDataRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
 type CashbookApproveExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -2453,13 +2691,14 @@ type CashbookApproveExpenseRequest struct {
 	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	ExpenseId     int32                  `protobuf:"varint,4,opt,name=expenseId,proto3" json:"expenseId,omitempty"`
 	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	ClientId      int32                  `protobuf:"varint,7,opt,name=clientId,proto3" json:"clientId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CashbookApproveExpenseRequest) Reset() {
 	*x = CashbookApproveExpenseRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[34]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2471,7 +2710,7 @@ func (x *CashbookApproveExpenseRequest) String() string {
 func (*CashbookApproveExpenseRequest) ProtoMessage() {}
 
 func (x *CashbookApproveExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[34]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2484,7 +2723,7 @@ func (x *CashbookApproveExpenseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashbookApproveExpenseRequest.ProtoReflect.Descriptor instead.
 func (*CashbookApproveExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{34}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CashbookApproveExpenseRequest) GetStatus() int32 {
@@ -2522,6 +2761,13 @@ func (x *CashbookApproveExpenseRequest) GetComment() string {
 	return ""
 }
 
+func (x *CashbookApproveExpenseRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
 type CashbookCreateExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Amount        int32                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -2536,7 +2782,7 @@ type CashbookCreateExpenseRequest struct {
 
 func (x *CashbookCreateExpenseRequest) Reset() {
 	*x = CashbookCreateExpenseRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[35]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2548,7 +2794,7 @@ func (x *CashbookCreateExpenseRequest) String() string {
 func (*CashbookCreateExpenseRequest) ProtoMessage() {}
 
 func (x *CashbookCreateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[35]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2561,7 +2807,7 @@ func (x *CashbookCreateExpenseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashbookCreateExpenseRequest.ProtoReflect.Descriptor instead.
 func (*CashbookCreateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{35}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CashbookCreateExpenseRequest) GetAmount() int32 {
@@ -2618,7 +2864,7 @@ type ExpenseSingleResponse struct {
 
 func (x *ExpenseSingleResponse) Reset() {
 	*x = ExpenseSingleResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[36]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2630,7 +2876,7 @@ func (x *ExpenseSingleResponse) String() string {
 func (*ExpenseSingleResponse) ProtoMessage() {}
 
 func (x *ExpenseSingleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[36]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2643,7 +2889,7 @@ func (x *ExpenseSingleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpenseSingleResponse.ProtoReflect.Descriptor instead.
 func (*ExpenseSingleResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{36}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ExpenseSingleResponse) GetSuccess() bool {
@@ -2686,7 +2932,7 @@ type ExpenseRepeatedResponse struct {
 
 func (x *ExpenseRepeatedResponse) Reset() {
 	*x = ExpenseRepeatedResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[37]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2698,7 +2944,7 @@ func (x *ExpenseRepeatedResponse) String() string {
 func (*ExpenseRepeatedResponse) ProtoMessage() {}
 
 func (x *ExpenseRepeatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[37]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2711,7 +2957,7 @@ func (x *ExpenseRepeatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpenseRepeatedResponse.ProtoReflect.Descriptor instead.
 func (*ExpenseRepeatedResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{37}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ExpenseRepeatedResponse) GetSuccess() bool {
@@ -2757,13 +3003,14 @@ type Expense struct {
 	CreatedAt       string                 `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Balance         *int32                 `protobuf:"varint,12,opt,name=balance,proto3,oneof" json:"balance,omitempty"`
 	ExpenseType     *string                `protobuf:"bytes,13,opt,name=expenseType,proto3,oneof" json:"expenseType,omitempty"`
+	ApprovalLevel   *int32                 `protobuf:"varint,14,opt,name=approvalLevel,proto3,oneof" json:"approvalLevel,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Expense) Reset() {
 	*x = Expense{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[38]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2775,7 +3022,7 @@ func (x *Expense) String() string {
 func (*Expense) ProtoMessage() {}
 
 func (x *Expense) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[38]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2788,7 +3035,7 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expense.ProtoReflect.Descriptor instead.
 func (*Expense) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{38}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Expense) GetId() int32 {
@@ -2882,6 +3129,13 @@ func (x *Expense) GetExpenseType() string {
 	return ""
 }
 
+func (x *Expense) GetApprovalLevel() int32 {
+	if x != nil && x.ApprovalLevel != nil {
+		return *x.ApprovalLevel
+	}
+	return 0
+}
+
 type CashbookApproveCashInOutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -2893,7 +3147,7 @@ type CashbookApproveCashInOutRequest struct {
 
 func (x *CashbookApproveCashInOutRequest) Reset() {
 	*x = CashbookApproveCashInOutRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[39]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2905,7 +3159,7 @@ func (x *CashbookApproveCashInOutRequest) String() string {
 func (*CashbookApproveCashInOutRequest) ProtoMessage() {}
 
 func (x *CashbookApproveCashInOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[39]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,7 +3172,7 @@ func (x *CashbookApproveCashInOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashbookApproveCashInOutRequest.ProtoReflect.Descriptor instead.
 func (*CashbookApproveCashInOutRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{39}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CashbookApproveCashInOutRequest) GetStatus() int32 {
@@ -2956,7 +3210,7 @@ type CashbookCreateCashInOutRequest struct {
 
 func (x *CashbookCreateCashInOutRequest) Reset() {
 	*x = CashbookCreateCashInOutRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[40]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2968,7 +3222,7 @@ func (x *CashbookCreateCashInOutRequest) String() string {
 func (*CashbookCreateCashInOutRequest) ProtoMessage() {}
 
 func (x *CashbookCreateCashInOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[40]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2981,7 +3235,7 @@ func (x *CashbookCreateCashInOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashbookCreateCashInOutRequest.ProtoReflect.Descriptor instead.
 func (*CashbookCreateCashInOutRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{40}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CashbookCreateCashInOutRequest) GetUserId() int32 {
@@ -3038,7 +3292,7 @@ type CashInOutSingleResponse struct {
 
 func (x *CashInOutSingleResponse) Reset() {
 	*x = CashInOutSingleResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[41]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3050,7 +3304,7 @@ func (x *CashInOutSingleResponse) String() string {
 func (*CashInOutSingleResponse) ProtoMessage() {}
 
 func (x *CashInOutSingleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[41]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3063,7 +3317,7 @@ func (x *CashInOutSingleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashInOutSingleResponse.ProtoReflect.Descriptor instead.
 func (*CashInOutSingleResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{41}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CashInOutSingleResponse) GetSuccess() bool {
@@ -3106,7 +3360,7 @@ type CashInOutRepeatedResponse struct {
 
 func (x *CashInOutRepeatedResponse) Reset() {
 	*x = CashInOutRepeatedResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[42]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3118,7 +3372,7 @@ func (x *CashInOutRepeatedResponse) String() string {
 func (*CashInOutRepeatedResponse) ProtoMessage() {}
 
 func (x *CashInOutRepeatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[42]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3131,7 +3385,7 @@ func (x *CashInOutRepeatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashInOutRepeatedResponse.ProtoReflect.Descriptor instead.
 func (*CashInOutRepeatedResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{42}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CashInOutRepeatedResponse) GetSuccess() bool {
@@ -3179,7 +3433,7 @@ type CashInOut struct {
 
 func (x *CashInOut) Reset() {
 	*x = CashInOut{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[43]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3191,7 +3445,7 @@ func (x *CashInOut) String() string {
 func (*CashInOut) ProtoMessage() {}
 
 func (x *CashInOut) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[43]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3204,7 +3458,7 @@ func (x *CashInOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashInOut.ProtoReflect.Descriptor instead.
 func (*CashInOut) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{43}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CashInOut) GetId() int32 {
@@ -3273,15 +3527,19 @@ func (x *CashInOut) GetBalance() int32 {
 type CashbookCreateExpenseTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Fixed         int32                  `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
-	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fixed         *int32                 `protobuf:"varint,3,opt,name=fixed,proto3,oneof" json:"fixed,omitempty"`
+	Amount        *int32                 `protobuf:"varint,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	ClientId      int32                  `protobuf:"varint,5,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Id            *int32                 `protobuf:"varint,6,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	MonthlyBudget *int32                 `protobuf:"varint,7,opt,name=monthlyBudget,proto3,oneof" json:"monthlyBudget,omitempty"`
+	Status        *int32                 `protobuf:"varint,8,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CashbookCreateExpenseTypeRequest) Reset() {
 	*x = CashbookCreateExpenseTypeRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[44]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3293,7 +3551,7 @@ func (x *CashbookCreateExpenseTypeRequest) String() string {
 func (*CashbookCreateExpenseTypeRequest) ProtoMessage() {}
 
 func (x *CashbookCreateExpenseTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[44]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3306,7 +3564,7 @@ func (x *CashbookCreateExpenseTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashbookCreateExpenseTypeRequest.ProtoReflect.Descriptor instead.
 func (*CashbookCreateExpenseTypeRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{44}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CashbookCreateExpenseTypeRequest) GetTitle() string {
@@ -3317,15 +3575,171 @@ func (x *CashbookCreateExpenseTypeRequest) GetTitle() string {
 }
 
 func (x *CashbookCreateExpenseTypeRequest) GetFixed() int32 {
-	if x != nil {
-		return x.Fixed
+	if x != nil && x.Fixed != nil {
+		return *x.Fixed
 	}
 	return 0
 }
 
 func (x *CashbookCreateExpenseTypeRequest) GetAmount() int32 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *CashbookCreateExpenseTypeRequest) GetClientId() int32 {
 	if x != nil {
-		return x.Amount
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *CashbookCreateExpenseTypeRequest) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CashbookCreateExpenseTypeRequest) GetMonthlyBudget() int32 {
+	if x != nil && x.MonthlyBudget != nil {
+		return *x.MonthlyBudget
+	}
+	return 0
+}
+
+func (x *CashbookCreateExpenseTypeRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type ExpenseApproverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseApproverRequest) Reset() {
+	*x = ExpenseApproverRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseApproverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseApproverRequest) ProtoMessage() {}
+
+func (x *ExpenseApproverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseApproverRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseApproverRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExpenseApproverRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ExpenseApproverRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExpenseApproverRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type ExpenseBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ExpenseTypeId int32                  `protobuf:"varint,2,opt,name=expenseTypeId,proto3" json:"expenseTypeId,omitempty"`
+	BranchId      int32                  `protobuf:"varint,3,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	MonthlyBudget int32                  `protobuf:"varint,4,opt,name=monthlyBudget,proto3" json:"monthlyBudget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseBudgetRequest) Reset() {
+	*x = ExpenseBudgetRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseBudgetRequest) ProtoMessage() {}
+
+func (x *ExpenseBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseBudgetRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExpenseBudgetRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetRequest) GetExpenseTypeId() int32 {
+	if x != nil {
+		return x.ExpenseTypeId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetRequest) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *ExpenseBudgetRequest) GetMonthlyBudget() int32 {
+	if x != nil {
+		return x.MonthlyBudget
 	}
 	return 0
 }
@@ -3342,7 +3756,7 @@ type ExpenseTypeSingleResponse struct {
 
 func (x *ExpenseTypeSingleResponse) Reset() {
 	*x = ExpenseTypeSingleResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[45]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3354,7 +3768,7 @@ func (x *ExpenseTypeSingleResponse) String() string {
 func (*ExpenseTypeSingleResponse) ProtoMessage() {}
 
 func (x *ExpenseTypeSingleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[45]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3367,7 +3781,7 @@ func (x *ExpenseTypeSingleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpenseTypeSingleResponse.ProtoReflect.Descriptor instead.
 func (*ExpenseTypeSingleResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{45}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ExpenseTypeSingleResponse) GetSuccess() bool {
@@ -3410,7 +3824,7 @@ type ExpenseTypeRepeatedResponse struct {
 
 func (x *ExpenseTypeRepeatedResponse) Reset() {
 	*x = ExpenseTypeRepeatedResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[46]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3422,7 +3836,7 @@ func (x *ExpenseTypeRepeatedResponse) String() string {
 func (*ExpenseTypeRepeatedResponse) ProtoMessage() {}
 
 func (x *ExpenseTypeRepeatedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[46]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3435,7 +3849,7 @@ func (x *ExpenseTypeRepeatedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpenseTypeRepeatedResponse.ProtoReflect.Descriptor instead.
 func (*ExpenseTypeRepeatedResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{46}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ExpenseTypeRepeatedResponse) GetSuccess() bool {
@@ -3474,13 +3888,16 @@ type ExpenseType struct {
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
 	Fixed         int32                  `protobuf:"varint,6,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	ClientId      int32                  `protobuf:"varint,7,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	MonthlyBudget *int32                 `protobuf:"varint,8,opt,name=monthlyBudget,proto3,oneof" json:"monthlyBudget,omitempty"`
+	Spent         *int32                 `protobuf:"varint,9,opt,name=spent,proto3,oneof" json:"spent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExpenseType) Reset() {
 	*x = ExpenseType{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[47]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3492,7 +3909,7 @@ func (x *ExpenseType) String() string {
 func (*ExpenseType) ProtoMessage() {}
 
 func (x *ExpenseType) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[47]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3505,7 +3922,7 @@ func (x *ExpenseType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpenseType.ProtoReflect.Descriptor instead.
 func (*ExpenseType) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{47}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ExpenseType) GetId() int32 {
@@ -3550,6 +3967,27 @@ func (x *ExpenseType) GetFixed() int32 {
 	return 0
 }
 
+func (x *ExpenseType) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ExpenseType) GetMonthlyBudget() int32 {
+	if x != nil && x.MonthlyBudget != nil {
+		return *x.MonthlyBudget
+	}
+	return 0
+}
+
+func (x *ExpenseType) GetSpent() int32 {
+	if x != nil && x.Spent != nil {
+		return *x.Spent
+	}
+	return 0
+}
+
 type GetUserAccountsResponse struct {
 	state         protoimpl.MessageState                 `protogen:"open.v1"`
 	Data          []*GetUserAccountsResponse_BankAccount `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -3559,7 +3997,7 @@ type GetUserAccountsResponse struct {
 
 func (x *GetUserAccountsResponse) Reset() {
 	*x = GetUserAccountsResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[48]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3571,7 +4009,7 @@ func (x *GetUserAccountsResponse) String() string {
 func (*GetUserAccountsResponse) ProtoMessage() {}
 
 func (x *GetUserAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[48]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3584,7 +4022,7 @@ func (x *GetUserAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserAccountsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{48}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetUserAccountsResponse) GetData() []*GetUserAccountsResponse_BankAccount {
@@ -3605,7 +4043,7 @@ type GetNetworkBalanceRequest struct {
 
 func (x *GetNetworkBalanceRequest) Reset() {
 	*x = GetNetworkBalanceRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[49]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3617,7 +4055,7 @@ func (x *GetNetworkBalanceRequest) String() string {
 func (*GetNetworkBalanceRequest) ProtoMessage() {}
 
 func (x *GetNetworkBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[49]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +4068,7 @@ func (x *GetNetworkBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetworkBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetNetworkBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{49}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetNetworkBalanceRequest) GetAgentId() int32 {
@@ -3669,7 +4107,7 @@ type GetNetworkBalanceResponse struct {
 
 func (x *GetNetworkBalanceResponse) Reset() {
 	*x = GetNetworkBalanceResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[50]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3681,7 +4119,7 @@ func (x *GetNetworkBalanceResponse) String() string {
 func (*GetNetworkBalanceResponse) ProtoMessage() {}
 
 func (x *GetNetworkBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[50]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3694,7 +4132,7 @@ func (x *GetNetworkBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetworkBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetNetworkBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{50}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetNetworkBalanceResponse) GetSuccess() bool {
@@ -3759,7 +4197,7 @@ type FetchBetRangeRequest struct {
 
 func (x *FetchBetRangeRequest) Reset() {
 	*x = FetchBetRangeRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[51]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3771,7 +4209,7 @@ func (x *FetchBetRangeRequest) String() string {
 func (*FetchBetRangeRequest) ProtoMessage() {}
 
 func (x *FetchBetRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[51]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3784,7 +4222,7 @@ func (x *FetchBetRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchBetRangeRequest.ProtoReflect.Descriptor instead.
 func (*FetchBetRangeRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{51}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *FetchBetRangeRequest) GetMinAmount() int32 {
@@ -3834,7 +4272,7 @@ type FetchBetRangeResponse struct {
 
 func (x *FetchBetRangeResponse) Reset() {
 	*x = FetchBetRangeResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[52]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3846,7 +4284,7 @@ func (x *FetchBetRangeResponse) String() string {
 func (*FetchBetRangeResponse) ProtoMessage() {}
 
 func (x *FetchBetRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[52]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3859,7 +4297,7 @@ func (x *FetchBetRangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchBetRangeResponse.ProtoReflect.Descriptor instead.
 func (*FetchBetRangeResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{52}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *FetchBetRangeResponse) GetStatus() int32 {
@@ -3903,7 +4341,7 @@ type FetchDepositRangeRequest struct {
 
 func (x *FetchDepositRangeRequest) Reset() {
 	*x = FetchDepositRangeRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[53]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3915,7 +4353,7 @@ func (x *FetchDepositRangeRequest) String() string {
 func (*FetchDepositRangeRequest) ProtoMessage() {}
 
 func (x *FetchDepositRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[53]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3928,7 +4366,7 @@ func (x *FetchDepositRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDepositRangeRequest.ProtoReflect.Descriptor instead.
 func (*FetchDepositRangeRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{53}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FetchDepositRangeRequest) GetMinAmount() int32 {
@@ -3978,7 +4416,7 @@ type FetchDepositCountRequest struct {
 
 func (x *FetchDepositCountRequest) Reset() {
 	*x = FetchDepositCountRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[54]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3990,7 +4428,7 @@ func (x *FetchDepositCountRequest) String() string {
 func (*FetchDepositCountRequest) ProtoMessage() {}
 
 func (x *FetchDepositCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[54]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4003,7 +4441,7 @@ func (x *FetchDepositCountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDepositCountRequest.ProtoReflect.Descriptor instead.
 func (*FetchDepositCountRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{54}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *FetchDepositCountRequest) GetClientId() int32 {
@@ -4046,7 +4484,7 @@ type FetchDepositCountResponse struct {
 
 func (x *FetchDepositCountResponse) Reset() {
 	*x = FetchDepositCountResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[55]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4058,7 +4496,7 @@ func (x *FetchDepositCountResponse) String() string {
 func (*FetchDepositCountResponse) ProtoMessage() {}
 
 func (x *FetchDepositCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[55]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4071,7 +4509,7 @@ func (x *FetchDepositCountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDepositCountResponse.ProtoReflect.Descriptor instead.
 func (*FetchDepositCountResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{55}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *FetchDepositCountResponse) GetStatus() int32 {
@@ -4114,7 +4552,7 @@ type FetchDepositRangeResponse struct {
 
 func (x *FetchDepositRangeResponse) Reset() {
 	*x = FetchDepositRangeResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[56]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4126,7 +4564,7 @@ func (x *FetchDepositRangeResponse) String() string {
 func (*FetchDepositRangeResponse) ProtoMessage() {}
 
 func (x *FetchDepositRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[56]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4139,7 +4577,7 @@ func (x *FetchDepositRangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDepositRangeResponse.ProtoReflect.Descriptor instead.
 func (*FetchDepositRangeResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{56}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *FetchDepositRangeResponse) GetStatus() int32 {
@@ -4175,13 +4613,14 @@ type FetchPlayerDepositRequest struct {
 	UserId        int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
 	EndDate       string                 `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	ClientId      int32                  `protobuf:"varint,4,opt,name=clientId,proto3" json:"clientId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FetchPlayerDepositRequest) Reset() {
 	*x = FetchPlayerDepositRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[57]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4193,7 +4632,7 @@ func (x *FetchPlayerDepositRequest) String() string {
 func (*FetchPlayerDepositRequest) ProtoMessage() {}
 
 func (x *FetchPlayerDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[57]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4206,7 +4645,7 @@ func (x *FetchPlayerDepositRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchPlayerDepositRequest.ProtoReflect.Descriptor instead.
 func (*FetchPlayerDepositRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{57}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *FetchPlayerDepositRequest) GetUserId() int32 {
@@ -4230,10 +4669,17 @@ func (x *FetchPlayerDepositRequest) GetEndDate() string {
 	return ""
 }
 
-type TransactionEntity struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClientId        int32                  `protobuf:"varint,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+func (x *FetchPlayerDepositRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+type TransactionEntity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientId        int32                  `protobuf:"varint,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
 	UserId          int32                  `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
 	Username        string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
 	TransactionNo   string                 `protobuf:"bytes,5,opt,name=transactionNo,proto3" json:"transactionNo,omitempty"`
@@ -4253,7 +4699,7 @@ type TransactionEntity struct {
 
 func (x *TransactionEntity) Reset() {
 	*x = TransactionEntity{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[58]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4265,7 +4711,7 @@ func (x *TransactionEntity) String() string {
 func (*TransactionEntity) ProtoMessage() {}
 
 func (x *TransactionEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[58]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4278,7 +4724,7 @@ func (x *TransactionEntity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionEntity.ProtoReflect.Descriptor instead.
 func (*TransactionEntity) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{58}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *TransactionEntity) GetId() int32 {
@@ -4392,19 +4838,24 @@ type PaymentMethodRequest struct {
 	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Provider        string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
 	SecretKey       string                 `protobuf:"bytes,4,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
-	PublicKey       string                 `protobuf:"bytes,5,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	MerchantId      string                 `protobuf:"bytes,6,opt,name=merchantId,proto3" json:"merchantId,omitempty"`
-	BaseUrl         string                 `protobuf:"bytes,7,opt,name=baseUrl,proto3" json:"baseUrl,omitempty"`
-	Status          int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	ForDisbursement int32                  `protobuf:"varint,9,opt,name=forDisbursement,proto3" json:"forDisbursement,omitempty"`
+	PublicKey       *string                `protobuf:"bytes,5,opt,name=publicKey,proto3,oneof" json:"publicKey,omitempty"`
+	MerchantId      *string                `protobuf:"bytes,6,opt,name=merchantId,proto3,oneof" json:"merchantId,omitempty"`
+	BaseUrl         *string                `protobuf:"bytes,7,opt,name=baseUrl,proto3,oneof" json:"baseUrl,omitempty"`
+	Status          *int32                 `protobuf:"varint,8,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ForDisbursement *int32                 `protobuf:"varint,9,opt,name=forDisbursement,proto3,oneof" json:"forDisbursement,omitempty"`
 	Id              int32                  `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
+	MinAmount       *float64               `protobuf:"fixed64,11,opt,name=minAmount,proto3,oneof" json:"minAmount,omitempty"`
+	MaxAmount       *float64               `protobuf:"fixed64,12,opt,name=maxAmount,proto3,oneof" json:"maxAmount,omitempty"`
+	DisplayOrder    *int32                 `protobuf:"varint,13,opt,name=displayOrder,proto3,oneof" json:"displayOrder,omitempty"`
+	Country         *string                `protobuf:"bytes,14,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	BankCodes       *string                `protobuf:"bytes,15,opt,name=bankCodes,proto3,oneof" json:"bankCodes,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PaymentMethodRequest) Reset() {
 	*x = PaymentMethodRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[59]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4416,7 +4867,7 @@ func (x *PaymentMethodRequest) String() string {
 func (*PaymentMethodRequest) ProtoMessage() {}
 
 func (x *PaymentMethodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[59]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4429,7 +4880,7 @@ func (x *PaymentMethodRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentMethodRequest.ProtoReflect.Descriptor instead.
 func (*PaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{59}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *PaymentMethodRequest) GetClientId() int32 {
@@ -4461,36 +4912,36 @@ func (x *PaymentMethodRequest) GetSecretKey() string {
 }
 
 func (x *PaymentMethodRequest) GetPublicKey() string {
-	if x != nil {
-		return x.PublicKey
+	if x != nil && x.PublicKey != nil {
+		return *x.PublicKey
 	}
 	return ""
 }
 
 func (x *PaymentMethodRequest) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
+	if x != nil && x.MerchantId != nil {
+		return *x.MerchantId
 	}
 	return ""
 }
 
 func (x *PaymentMethodRequest) GetBaseUrl() string {
-	if x != nil {
-		return x.BaseUrl
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
 	}
 	return ""
 }
 
 func (x *PaymentMethodRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return 0
 }
 
 func (x *PaymentMethodRequest) GetForDisbursement() int32 {
-	if x != nil {
-		return x.ForDisbursement
+	if x != nil && x.ForDisbursement != nil {
+		return *x.ForDisbursement
 	}
 	return 0
 }
@@ -4502,6 +4953,41 @@ func (x *PaymentMethodRequest) GetId() int32 {
 	return 0
 }
 
+func (x *PaymentMethodRequest) GetMinAmount() float64 {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return 0
+}
+
+func (x *PaymentMethodRequest) GetMaxAmount() float64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *PaymentMethodRequest) GetDisplayOrder() int32 {
+	if x != nil && x.DisplayOrder != nil {
+		return *x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *PaymentMethodRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *PaymentMethodRequest) GetBankCodes() string {
+	if x != nil && x.BankCodes != nil {
+		return *x.BankCodes
+	}
+	return ""
+}
+
 type VerifyDepositRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ClientId       int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
@@ -4513,7 +4999,7 @@ type VerifyDepositRequest struct {
 
 func (x *VerifyDepositRequest) Reset() {
 	*x = VerifyDepositRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[60]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4525,7 +5011,7 @@ func (x *VerifyDepositRequest) String() string {
 func (*VerifyDepositRequest) ProtoMessage() {}
 
 func (x *VerifyDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[60]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4538,7 +5024,7 @@ func (x *VerifyDepositRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyDepositRequest.ProtoReflect.Descriptor instead.
 func (*VerifyDepositRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{60}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *VerifyDepositRequest) GetClientId() int32 {
@@ -4573,7 +5059,7 @@ type VerifyDepositResponse struct {
 
 func (x *VerifyDepositResponse) Reset() {
 	*x = VerifyDepositResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[61]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4585,7 +5071,7 @@ func (x *VerifyDepositResponse) String() string {
 func (*VerifyDepositResponse) ProtoMessage() {}
 
 func (x *VerifyDepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[61]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4598,7 +5084,7 @@ func (x *VerifyDepositResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyDepositResponse.ProtoReflect.Descriptor instead.
 func (*VerifyDepositResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{61}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *VerifyDepositResponse) GetSuccess() bool {
@@ -4635,7 +5121,7 @@ type PaystackWebhookRequest struct {
 
 func (x *PaystackWebhookRequest) Reset() {
 	*x = PaystackWebhookRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[62]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4647,7 +5133,7 @@ func (x *PaystackWebhookRequest) String() string {
 func (*PaystackWebhookRequest) ProtoMessage() {}
 
 func (x *PaystackWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[62]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4660,7 +5146,7 @@ func (x *PaystackWebhookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaystackWebhookRequest.ProtoReflect.Descriptor instead.
 func (*PaystackWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{62}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *PaystackWebhookRequest) GetClientId() int32 {
@@ -4710,7 +5196,7 @@ type MonnifyWebhookRequest struct {
 
 func (x *MonnifyWebhookRequest) Reset() {
 	*x = MonnifyWebhookRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[63]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4722,7 +5208,7 @@ func (x *MonnifyWebhookRequest) String() string {
 func (*MonnifyWebhookRequest) ProtoMessage() {}
 
 func (x *MonnifyWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[63]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4735,7 +5221,7 @@ func (x *MonnifyWebhookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonnifyWebhookRequest.ProtoReflect.Descriptor instead.
 func (*MonnifyWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{63}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *MonnifyWebhookRequest) GetClientId() int32 {
@@ -4775,7 +5261,7 @@ type WebhookResponse struct {
 
 func (x *WebhookResponse) Reset() {
 	*x = WebhookResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[64]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4787,7 +5273,7 @@ func (x *WebhookResponse) String() string {
 func (*WebhookResponse) ProtoMessage() {}
 
 func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[64]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4800,7 +5286,7 @@ func (x *WebhookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebhookResponse.ProtoReflect.Descriptor instead.
 func (*WebhookResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{64}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *WebhookResponse) GetSuccess() bool {
@@ -4811,16 +5297,17 @@ func (x *WebhookResponse) GetSuccess() bool {
 }
 
 type GetPaymentMethodRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	Status        *int32                 `protobuf:"varint,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Status          *int32                 `protobuf:"varint,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ForDisbursement *int32                 `protobuf:"varint,3,opt,name=forDisbursement,proto3,oneof" json:"forDisbursement,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetPaymentMethodRequest) Reset() {
 	*x = GetPaymentMethodRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[65]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4832,7 +5319,7 @@ func (x *GetPaymentMethodRequest) String() string {
 func (*GetPaymentMethodRequest) ProtoMessage() {}
 
 func (x *GetPaymentMethodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[65]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4845,7 +5332,7 @@ func (x *GetPaymentMethodRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentMethodRequest.ProtoReflect.Descriptor instead.
 func (*GetPaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{65}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetPaymentMethodRequest) GetClientId() int32 {
@@ -4862,6 +5349,13 @@ func (x *GetPaymentMethodRequest) GetStatus() int32 {
 	return 0
 }
 
+func (x *GetPaymentMethodRequest) GetForDisbursement() int32 {
+	if x != nil && x.ForDisbursement != nil {
+		return *x.ForDisbursement
+	}
+	return 0
+}
+
 type GetPaymentMethodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -4874,7 +5368,7 @@ type GetPaymentMethodResponse struct {
 
 func (x *GetPaymentMethodResponse) Reset() {
 	*x = GetPaymentMethodResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[66]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4886,7 +5380,7 @@ func (x *GetPaymentMethodResponse) String() string {
 func (*GetPaymentMethodResponse) ProtoMessage() {}
 
 func (x *GetPaymentMethodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[66]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4899,7 +5393,7 @@ func (x *GetPaymentMethodResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPaymentMethodResponse.ProtoReflect.Descriptor instead.
 func (*GetPaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{66}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetPaymentMethodResponse) GetSuccess() bool {
@@ -4942,7 +5436,7 @@ type PaymentMethodResponse struct {
 
 func (x *PaymentMethodResponse) Reset() {
 	*x = PaymentMethodResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[67]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4954,7 +5448,7 @@ func (x *PaymentMethodResponse) String() string {
 func (*PaymentMethodResponse) ProtoMessage() {}
 
 func (x *PaymentMethodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[67]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4967,7 +5461,7 @@ func (x *PaymentMethodResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentMethodResponse.ProtoReflect.Descriptor instead.
 func (*PaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{67}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *PaymentMethodResponse) GetSuccess() bool {
@@ -5009,13 +5503,18 @@ type PaymentMethod struct {
 	Status          int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
 	ForDisbursement int32                  `protobuf:"varint,9,opt,name=for_disbursement,json=forDisbursement,proto3" json:"for_disbursement,omitempty"`
 	Id              int32                  `protobuf:"varint,10,opt,name=id,proto3" json:"id,omitempty"`
+	MinAmount       float64                `protobuf:"fixed64,11,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount       float64                `protobuf:"fixed64,12,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	DisplayOrder    int32                  `protobuf:"varint,13,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	Country         string                 `protobuf:"bytes,14,opt,name=country,proto3" json:"country,omitempty"`
+	BankCodes       string                 `protobuf:"bytes,15,opt,name=bank_codes,json=bankCodes,proto3" json:"bank_codes,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PaymentMethod) Reset() {
 	*x = PaymentMethod{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[68]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5027,7 +5526,7 @@ func (x *PaymentMethod) String() string {
 func (*PaymentMethod) ProtoMessage() {}
 
 func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[68]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5040,7 +5539,7 @@ func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
 func (*PaymentMethod) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{68}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *PaymentMethod) GetTitle() string {
@@ -5106,6 +5605,41 @@ func (x *PaymentMethod) GetId() int32 {
 	return 0
 }
 
+func (x *PaymentMethod) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetBankCodes() string {
+	if x != nil {
+		return x.BankCodes
+	}
+	return ""
+}
+
 type CreateWalletRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -5119,7 +5653,7 @@ type CreateWalletRequest struct {
 
 func (x *CreateWalletRequest) Reset() {
 	*x = CreateWalletRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[69]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5131,7 +5665,7 @@ func (x *CreateWalletRequest) String() string {
 func (*CreateWalletRequest) ProtoMessage() {}
 
 func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[69]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5144,7 +5678,7 @@ func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWalletRequest.ProtoReflect.Descriptor instead.
 func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{69}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *CreateWalletRequest) GetUserId() int32 {
@@ -5194,7 +5728,7 @@ type WalletResponse struct {
 
 func (x *WalletResponse) Reset() {
 	*x = WalletResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[70]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5206,7 +5740,7 @@ func (x *WalletResponse) String() string {
 func (*WalletResponse) ProtoMessage() {}
 
 func (x *WalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[70]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5219,7 +5753,7 @@ func (x *WalletResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletResponse.ProtoReflect.Descriptor instead.
 func (*WalletResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{70}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *WalletResponse) GetSuccess() bool {
@@ -5256,13 +5790,14 @@ type GetBalanceRequest struct {
 	UserId        int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	ClientId      int32                  `protobuf:"varint,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
 	Wallet        *string                `protobuf:"bytes,3,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
+	Currency      *string                `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBalanceRequest) Reset() {
 	*x = GetBalanceRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[71]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5274,7 +5809,7 @@ func (x *GetBalanceRequest) String() string {
 func (*GetBalanceRequest) ProtoMessage() {}
 
 func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[71]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5287,7 +5822,7 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{71}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetBalanceRequest) GetUserId() int32 {
@@ -5311,6 +5846,13 @@ func (x *GetBalanceRequest) GetWallet() string {
 	return ""
 }
 
+func (x *GetBalanceRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
 // credit user request payload
 type CreditUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5323,13 +5865,14 @@ type CreditUserRequest struct {
 	Wallet        string                 `protobuf:"bytes,7,opt,name=wallet,proto3" json:"wallet,omitempty"`
 	Subject       string                 `protobuf:"bytes,8,opt,name=subject,proto3" json:"subject,omitempty"`
 	Channel       string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Currency      *string                `protobuf:"bytes,10,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreditUserRequest) Reset() {
 	*x = CreditUserRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[72]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5341,7 +5884,7 @@ func (x *CreditUserRequest) String() string {
 func (*CreditUserRequest) ProtoMessage() {}
 
 func (x *CreditUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[72]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5354,7 +5897,7 @@ func (x *CreditUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditUserRequest.ProtoReflect.Descriptor instead.
 func (*CreditUserRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{72}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *CreditUserRequest) GetUserId() int32 {
@@ -5420,6 +5963,13 @@ func (x *CreditUserRequest) GetChannel() string {
 	return ""
 }
 
+func (x *CreditUserRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
 // credit user request payload
 type DebitUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5432,13 +5982,14 @@ type DebitUserRequest struct {
 	Wallet        string                 `protobuf:"bytes,7,opt,name=wallet,proto3" json:"wallet,omitempty"`
 	Subject       string                 `protobuf:"bytes,8,opt,name=subject,proto3" json:"subject,omitempty"`
 	Channel       string                 `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
+	Currency      *string                `protobuf:"bytes,10,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DebitUserRequest) Reset() {
 	*x = DebitUserRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[73]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5450,7 +6001,7 @@ func (x *DebitUserRequest) String() string {
 func (*DebitUserRequest) ProtoMessage() {}
 
 func (x *DebitUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[73]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5463,7 +6014,7 @@ func (x *DebitUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitUserRequest.ProtoReflect.Descriptor instead.
 func (*DebitUserRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{73}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *DebitUserRequest) GetUserId() int32 {
@@ -5529,6 +6080,13 @@ func (x *DebitUserRequest) GetChannel() string {
 	return ""
 }
 
+func (x *DebitUserRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
 type Wallet struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	UserId              int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -5538,13 +6096,16 @@ type Wallet struct {
 	SportBonusBalance   float64                `protobuf:"fixed64,5,opt,name=sportBonusBalance,proto3" json:"sportBonusBalance,omitempty"`
 	VirtualBonusBalance float64                `protobuf:"fixed64,6,opt,name=virtualBonusBalance,proto3" json:"virtualBonusBalance,omitempty"`
 	CasinoBonusBalance  float64                `protobuf:"fixed64,7,opt,name=casinoBonusBalance,proto3" json:"casinoBonusBalance,omitempty"`
+	Wallets             []*SubWallet           `protobuf:"bytes,8,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Currency            string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Currencies          []*Wallet              `protobuf:"bytes,10,rep,name=currencies,proto3" json:"currencies,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Wallet) Reset() {
 	*x = Wallet{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[74]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5556,7 +6117,7 @@ func (x *Wallet) String() string {
 func (*Wallet) ProtoMessage() {}
 
 func (x *Wallet) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[74]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5569,7 +6130,7 @@ func (x *Wallet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
 func (*Wallet) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{74}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *Wallet) GetUserId() int32 {
@@ -5621,6 +6182,399 @@ func (x *Wallet) GetCasinoBonusBalance() float64 {
 	return 0
 }
 
+func (x *Wallet) GetWallets() []*SubWallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *Wallet) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Wallet) GetCurrencies() []*Wallet {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type SubWallet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Withdrawable  bool                   `protobuf:"varint,4,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	Bonus         bool                   `protobuf:"varint,5,opt,name=bonus,proto3" json:"bonus,omitempty"`
+	CanGoNegative bool                   `protobuf:"varint,6,opt,name=canGoNegative,proto3" json:"canGoNegative,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubWallet) Reset() {
+	*x = SubWallet{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubWallet) ProtoMessage() {}
+
+func (x *SubWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubWallet.ProtoReflect.Descriptor instead.
+func (*SubWallet) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SubWallet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubWallet) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubWallet) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *SubWallet) GetWithdrawable() bool {
+	if x != nil {
+		return x.Withdrawable
+	}
+	return false
+}
+
+func (x *SubWallet) GetBonus() bool {
+	if x != nil {
+		return x.Bonus
+	}
+	return false
+}
+
+func (x *SubWallet) GetCanGoNegative() bool {
+	if x != nil {
+		return x.CanGoNegative
+	}
+	return false
+}
+
+type ClientCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,3,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientCurrencyRequest) Reset() {
+	*x = ClientCurrencyRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientCurrencyRequest) ProtoMessage() {}
+
+func (x *ClientCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*ClientCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ClientCurrencyRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ClientCurrencyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ClientCurrencyRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type ExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=baseCurrency,proto3" json:"baseCurrency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quoteCurrency,proto3" json:"quoteCurrency,omitempty"`
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	EffectiveAt   *string                `protobuf:"bytes,5,opt,name=effectiveAt,proto3,oneof" json:"effectiveAt,omitempty"`
+	Spread        *float64               `protobuf:"fixed64,6,opt,name=spread,proto3,oneof" json:"spread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateRequest) Reset() {
+	*x = ExchangeRateRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateRequest) ProtoMessage() {}
+
+func (x *ExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ExchangeRateRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ExchangeRateRequest) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRateRequest) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRateRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ExchangeRateRequest) GetEffectiveAt() string {
+	if x != nil && x.EffectiveAt != nil {
+		return *x.EffectiveAt
+	}
+	return ""
+}
+
+func (x *ExchangeRateRequest) GetSpread() float64 {
+	if x != nil && x.Spread != nil {
+		return *x.Spread
+	}
+	return 0
+}
+
+type ExchangeRateImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateImportRequest) Reset() {
+	*x = ExchangeRateImportRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateImportRequest) ProtoMessage() {}
+
+func (x *ExchangeRateImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateImportRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRateImportRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ExchangeRateImportRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *ExchangeRateImportRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type WalletTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Withdrawable  bool                   `protobuf:"varint,4,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	Bonus         bool                   `protobuf:"varint,5,opt,name=bonus,proto3" json:"bonus,omitempty"`
+	CanGoNegative bool                   `protobuf:"varint,6,opt,name=canGoNegative,proto3" json:"canGoNegative,omitempty"`
+	Status        *int32                 `protobuf:"varint,7,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletTypeRequest) Reset() {
+	*x = WalletTypeRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTypeRequest) ProtoMessage() {}
+
+func (x *WalletTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTypeRequest.ProtoReflect.Descriptor instead.
+func (*WalletTypeRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *WalletTypeRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *WalletTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WalletTypeRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WalletTypeRequest) GetWithdrawable() bool {
+	if x != nil {
+		return x.Withdrawable
+	}
+	return false
+}
+
+func (x *WalletTypeRequest) GetBonus() bool {
+	if x != nil {
+		return x.Bonus
+	}
+	return false
+}
+
+func (x *WalletTypeRequest) GetCanGoNegative() bool {
+	if x != nil {
+		return x.CanGoNegative
+	}
+	return false
+}
+
+func (x *WalletTypeRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
 type InitiateDepositRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -5635,7 +6589,7 @@ type InitiateDepositRequest struct {
 
 func (x *InitiateDepositRequest) Reset() {
 	*x = InitiateDepositRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[75]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5647,7 +6601,7 @@ func (x *InitiateDepositRequest) String() string {
 func (*InitiateDepositRequest) ProtoMessage() {}
 
 func (x *InitiateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[75]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5660,7 +6614,7 @@ func (x *InitiateDepositRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateDepositRequest.ProtoReflect.Descriptor instead.
 func (*InitiateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{75}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *InitiateDepositRequest) GetUserId() int32 {
@@ -5716,7 +6670,7 @@ type InitiateDepositResponse struct {
 
 func (x *InitiateDepositResponse) Reset() {
 	*x = InitiateDepositResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[76]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5728,7 +6682,7 @@ func (x *InitiateDepositResponse) String() string {
 func (*InitiateDepositResponse) ProtoMessage() {}
 
 func (x *InitiateDepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[76]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5741,7 +6695,7 @@ func (x *InitiateDepositResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateDepositResponse.ProtoReflect.Descriptor instead.
 func (*InitiateDepositResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{76}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *InitiateDepositResponse) GetSuccess() bool {
@@ -5784,7 +6738,7 @@ type Transaction struct {
 
 func (x *Transaction) Reset() {
 	*x = Transaction{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[77]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5796,7 +6750,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[77]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5809,7 +6763,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{77}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *Transaction) GetUsername() string {
@@ -5903,7 +6857,7 @@ type SearchTransactionsRequest struct {
 
 func (x *SearchTransactionsRequest) Reset() {
 	*x = SearchTransactionsRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[78]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5915,7 +6869,7 @@ func (x *SearchTransactionsRequest) String() string {
 func (*SearchTransactionsRequest) ProtoMessage() {}
 
 func (x *SearchTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[78]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5928,7 +6882,7 @@ func (x *SearchTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*SearchTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{78}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *SearchTransactionsRequest) GetClientId() int32 {
@@ -5973,6 +6927,110 @@ func (x *SearchTransactionsRequest) GetFromDate() string {
 	return ""
 }
 
+type ListBanksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBanksRequest) Reset() {
+	*x = ListBanksRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBanksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBanksRequest) ProtoMessage() {}
+
+func (x *ListBanksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBanksRequest.ProtoReflect.Descriptor instead.
+func (*ListBanksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListBanksRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+type PlayerNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerNameRequest) Reset() {
+	*x = PlayerNameRequest{}
+	mi := &file_grpc_proto_wallet_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerNameRequest) ProtoMessage() {}
+
+func (x *PlayerNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_wallet_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerNameRequest.ProtoReflect.Descriptor instead.
+func (*PlayerNameRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PlayerNameRequest) GetClientId() int32 {
+	if x != nil {
+		return x.ClientId
+	}
+	return 0
+}
+
+func (x *PlayerNameRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlayerNameRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
 type VerifyBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
@@ -5986,7 +7044,7 @@ type VerifyBankAccountRequest struct {
 
 func (x *VerifyBankAccountRequest) Reset() {
 	*x = VerifyBankAccountRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[79]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5998,7 +7056,7 @@ func (x *VerifyBankAccountRequest) String() string {
 func (*VerifyBankAccountRequest) ProtoMessage() {}
 
 func (x *VerifyBankAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[79]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6011,7 +7069,7 @@ func (x *VerifyBankAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyBankAccountRequest.ProtoReflect.Descriptor instead.
 func (*VerifyBankAccountRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{79}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *VerifyBankAccountRequest) GetClientId() int32 {
@@ -6061,7 +7119,7 @@ type VerifyBankAccountResponse struct {
 
 func (x *VerifyBankAccountResponse) Reset() {
 	*x = VerifyBankAccountResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[80]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6073,7 +7131,7 @@ func (x *VerifyBankAccountResponse) String() string {
 func (*VerifyBankAccountResponse) ProtoMessage() {}
 
 func (x *VerifyBankAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[80]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6086,7 +7144,7 @@ func (x *VerifyBankAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyBankAccountResponse.ProtoReflect.Descriptor instead.
 func (*VerifyBankAccountResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{80}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *VerifyBankAccountResponse) GetSuccess() bool {
@@ -6135,7 +7193,7 @@ type WithdrawRequest struct {
 
 func (x *WithdrawRequest) Reset() {
 	*x = WithdrawRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[81]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6147,7 +7205,7 @@ func (x *WithdrawRequest) String() string {
 func (*WithdrawRequest) ProtoMessage() {}
 
 func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[81]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6160,7 +7218,7 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{81}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *WithdrawRequest) GetUserId() int32 {
@@ -6245,7 +7303,7 @@ type WithdrawResponse struct {
 
 func (x *WithdrawResponse) Reset() {
 	*x = WithdrawResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[82]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6257,7 +7315,7 @@ func (x *WithdrawResponse) String() string {
 func (*WithdrawResponse) ProtoMessage() {}
 
 func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[82]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6270,7 +7328,7 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{82}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *WithdrawResponse) GetSuccess() bool {
@@ -6311,7 +7369,7 @@ type Withdraw struct {
 
 func (x *Withdraw) Reset() {
 	*x = Withdraw{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[83]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6323,7 +7381,7 @@ func (x *Withdraw) String() string {
 func (*Withdraw) ProtoMessage() {}
 
 func (x *Withdraw) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[83]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6336,7 +7394,7 @@ func (x *Withdraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Withdraw.ProtoReflect.Descriptor instead.
 func (*Withdraw) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{83}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *Withdraw) GetBalance() float64 {
@@ -6369,7 +7427,7 @@ type GetTransactionRequest struct {
 
 func (x *GetTransactionRequest) Reset() {
 	*x = GetTransactionRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[84]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6381,7 +7439,7 @@ func (x *GetTransactionRequest) String() string {
 func (*GetTransactionRequest) ProtoMessage() {}
 
 func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[84]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6394,7 +7452,7 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{84}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetTransactionRequest) GetUserId() int32 {
@@ -6465,7 +7523,7 @@ type GetTransactionResponse struct {
 
 func (x *GetTransactionResponse) Reset() {
 	*x = GetTransactionResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[85]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6477,7 +7535,7 @@ func (x *GetTransactionResponse) String() string {
 func (*GetTransactionResponse) ProtoMessage() {}
 
 func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[85]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6490,7 +7548,7 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{85}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetTransactionResponse) GetSuccess() bool {
@@ -6533,7 +7591,7 @@ type OpayWebhookRequest struct {
 
 func (x *OpayWebhookRequest) Reset() {
 	*x = OpayWebhookRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[86]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6545,7 +7603,7 @@ func (x *OpayWebhookRequest) String() string {
 func (*OpayWebhookRequest) ProtoMessage() {}
 
 func (x *OpayWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[86]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6558,7 +7616,7 @@ func (x *OpayWebhookRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpayWebhookRequest.ProtoReflect.Descriptor instead.
 func (*OpayWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{86}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *OpayWebhookRequest) GetClientId() int32 {
@@ -6600,7 +7658,7 @@ type OpayWebhookResponse struct {
 
 func (x *OpayWebhookResponse) Reset() {
 	*x = OpayWebhookResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[87]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6612,7 +7670,7 @@ func (x *OpayWebhookResponse) String() string {
 func (*OpayWebhookResponse) ProtoMessage() {}
 
 func (x *OpayWebhookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[87]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6625,7 +7683,7 @@ func (x *OpayWebhookResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpayWebhookResponse.ProtoReflect.Descriptor instead.
 func (*OpayWebhookResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{87}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *OpayWebhookResponse) GetResponseCode() string {
@@ -6662,7 +7720,7 @@ type ListWithdrawalRequests struct {
 
 func (x *ListWithdrawalRequests) Reset() {
 	*x = ListWithdrawalRequests{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[88]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6674,7 +7732,7 @@ func (x *ListWithdrawalRequests) String() string {
 func (*ListWithdrawalRequests) ProtoMessage() {}
 
 func (x *ListWithdrawalRequests) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[88]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6687,7 +7745,7 @@ func (x *ListWithdrawalRequests) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWithdrawalRequests.ProtoReflect.Descriptor instead.
 func (*ListWithdrawalRequests) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{88}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *ListWithdrawalRequests) GetClientId() int32 {
@@ -6737,7 +7795,7 @@ type ListWithdrawalRequestResponse struct {
 
 func (x *ListWithdrawalRequestResponse) Reset() {
 	*x = ListWithdrawalRequestResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[89]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6749,7 +7807,7 @@ func (x *ListWithdrawalRequestResponse) String() string {
 func (*ListWithdrawalRequestResponse) ProtoMessage() {}
 
 func (x *ListWithdrawalRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[89]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6762,7 +7820,7 @@ func (x *ListWithdrawalRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWithdrawalRequestResponse.ProtoReflect.Descriptor instead.
 func (*ListWithdrawalRequestResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{89}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *ListWithdrawalRequestResponse) GetSuccess() bool {
@@ -6811,7 +7869,7 @@ type WithdrawalRequest struct {
 
 func (x *WithdrawalRequest) Reset() {
 	*x = WithdrawalRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[90]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6823,7 +7881,7 @@ func (x *WithdrawalRequest) String() string {
 func (*WithdrawalRequest) ProtoMessage() {}
 
 func (x *WithdrawalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[90]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6836,7 +7894,7 @@ func (x *WithdrawalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{90}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *WithdrawalRequest) GetId() int32 {
@@ -6923,7 +7981,7 @@ type UserTransactionRequest struct {
 
 func (x *UserTransactionRequest) Reset() {
 	*x = UserTransactionRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[91]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6935,7 +7993,7 @@ func (x *UserTransactionRequest) String() string {
 func (*UserTransactionRequest) ProtoMessage() {}
 
 func (x *UserTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[91]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6948,7 +8006,7 @@ func (x *UserTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserTransactionRequest.ProtoReflect.Descriptor instead.
 func (*UserTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{91}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *UserTransactionRequest) GetClientId() int32 {
@@ -7005,7 +8063,7 @@ type UserTransactionResponse struct {
 
 func (x *UserTransactionResponse) Reset() {
 	*x = UserTransactionResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[92]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7017,7 +8075,7 @@ func (x *UserTransactionResponse) String() string {
 func (*UserTransactionResponse) ProtoMessage() {}
 
 func (x *UserTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[92]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7030,7 +8088,7 @@ func (x *UserTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserTransactionResponse.ProtoReflect.Descriptor instead.
 func (*UserTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{92}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *UserTransactionResponse) GetSuccess() bool {
@@ -7080,7 +8138,7 @@ type TransactionData struct {
 
 func (x *TransactionData) Reset() {
 	*x = TransactionData{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[93]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7092,7 +8150,7 @@ func (x *TransactionData) String() string {
 func (*TransactionData) ProtoMessage() {}
 
 func (x *TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[93]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7105,7 +8163,7 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
 func (*TransactionData) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{93}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *TransactionData) GetId() int32 {
@@ -7198,7 +8256,7 @@ type UpdateWithdrawalRequest struct {
 
 func (x *UpdateWithdrawalRequest) Reset() {
 	*x = UpdateWithdrawalRequest{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[94]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7210,7 +8268,7 @@ func (x *UpdateWithdrawalRequest) String() string {
 func (*UpdateWithdrawalRequest) ProtoMessage() {}
 
 func (x *UpdateWithdrawalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[94]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7223,7 +8281,7 @@ func (x *UpdateWithdrawalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWithdrawalRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWithdrawalRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{94}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *UpdateWithdrawalRequest) GetClientId() int32 {
@@ -7273,7 +8331,7 @@ type CommonResponseObj struct {
 
 func (x *CommonResponseObj) Reset() {
 	*x = CommonResponseObj{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[95]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7285,7 +8343,7 @@ func (x *CommonResponseObj) String() string {
 func (*CommonResponseObj) ProtoMessage() {}
 
 func (x *CommonResponseObj) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[95]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7298,7 +8356,7 @@ func (x *CommonResponseObj) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommonResponseObj.ProtoReflect.Descriptor instead.
 func (*CommonResponseObj) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{95}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *CommonResponseObj) GetSuccess() bool {
@@ -7341,7 +8399,7 @@ type CommonResponseArray struct {
 
 func (x *CommonResponseArray) Reset() {
 	*x = CommonResponseArray{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[96]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7353,7 +8411,7 @@ func (x *CommonResponseArray) String() string {
 func (*CommonResponseArray) ProtoMessage() {}
 
 func (x *CommonResponseArray) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[96]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7366,7 +8424,7 @@ func (x *CommonResponseArray) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommonResponseArray.ProtoReflect.Descriptor instead.
 func (*CommonResponseArray) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{96}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *CommonResponseArray) GetSuccess() bool {
@@ -7413,13 +8471,14 @@ type PlayerWalletData struct {
 	LastActivityDate     string                 `protobuf:"bytes,12,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty"`
 	NoOfDeposits         int32                  `protobuf:"varint,13,opt,name=noOfDeposits,proto3" json:"noOfDeposits,omitempty"`
 	NoOfWithdrawals      int32                  `protobuf:"varint,14,opt,name=noOfWithdrawals,proto3" json:"noOfWithdrawals,omitempty"`
+	AvgDeposits          float32                `protobuf:"fixed32,15,opt,name=avgDeposits,proto3" json:"avgDeposits,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
 func (x *PlayerWalletData) Reset() {
 	*x = PlayerWalletData{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[97]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7431,7 +8490,7 @@ func (x *PlayerWalletData) String() string {
 func (*PlayerWalletData) ProtoMessage() {}
 
 func (x *PlayerWalletData) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[97]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7444,7 +8503,7 @@ func (x *PlayerWalletData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerWalletData.ProtoReflect.Descriptor instead.
 func (*PlayerWalletData) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{97}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *PlayerWalletData) GetSportBalance() float32 {
@@ -7545,23 +8604,33 @@ func (x *PlayerWalletData) GetNoOfWithdrawals() int32 {
 	return 0
 }
 
+func (x *PlayerWalletData) GetAvgDeposits() float32 {
+	if x != nil {
+		return x.AvgDeposits
+	}
+	return 0
+}
+
 type ListDepositRequests struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      int32                  `protobuf:"varint,1,opt,name=clientId,proto3" json:"clientId,omitempty"`
 	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
 	EndDate       string                 `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,4,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
-	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Status        *int32                 `protobuf:"varint,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	Username      string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
 	TransactionId string                 `protobuf:"bytes,7,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
 	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       *int32                 `protobuf:"varint,9,opt,name=perPage,proto3,oneof" json:"perPage,omitempty"`
+	// with export set, page walks the CSV export in files of 5000 rows
+	Export        *bool `protobuf:"varint,10,opt,name=export,proto3,oneof" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListDepositRequests) Reset() {
 	*x = ListDepositRequests{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[98]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7573,7 +8642,7 @@ func (x *ListDepositRequests) String() string {
 func (*ListDepositRequests) ProtoMessage() {}
 
 func (x *ListDepositRequests) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[98]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7586,7 +8655,7 @@ func (x *ListDepositRequests) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDepositRequests.ProtoReflect.Descriptor instead.
 func (*ListDepositRequests) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{98}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *ListDepositRequests) GetClientId() int32 {
@@ -7618,8 +8687,8 @@ func (x *ListDepositRequests) GetPaymentMethod() string {
 }
 
 func (x *ListDepositRequests) GetStatus() int32 {
-	if x != nil {
-		return x.Status
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return 0
 }
@@ -7645,6 +8714,20 @@ func (x *ListDepositRequests) GetPage() int32 {
 	return 0
 }
 
+func (x *ListDepositRequests) GetPerPage() int32 {
+	if x != nil && x.PerPage != nil {
+		return *x.PerPage
+	}
+	return 0
+}
+
+func (x *ListDepositRequests) GetExport() bool {
+	if x != nil && x.Export != nil {
+		return *x.Export
+	}
+	return false
+}
+
 type PaginationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -7660,7 +8743,7 @@ type PaginationResponse struct {
 
 func (x *PaginationResponse) Reset() {
 	*x = PaginationResponse{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[99]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7672,7 +8755,7 @@ func (x *PaginationResponse) String() string {
 func (*PaginationResponse) ProtoMessage() {}
 
 func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[99]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7685,7 +8768,7 @@ func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationResponse.ProtoReflect.Descriptor instead.
 func (*PaginationResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{99}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *PaginationResponse) GetMessage() string {
@@ -7751,7 +8834,7 @@ type MetaData struct {
 
 func (x *MetaData) Reset() {
 	*x = MetaData{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[100]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7763,7 +8846,7 @@ func (x *MetaData) String() string {
 func (*MetaData) ProtoMessage() {}
 
 func (x *MetaData) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[100]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7776,7 +8859,7 @@ func (x *MetaData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
 func (*MetaData) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{100}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *MetaData) GetPage() int32 {
@@ -7833,7 +8916,7 @@ type GetUserAccountsResponse_BankAccount struct {
 
 func (x *GetUserAccountsResponse_BankAccount) Reset() {
 	*x = GetUserAccountsResponse_BankAccount{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[101]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7845,7 +8928,7 @@ func (x *GetUserAccountsResponse_BankAccount) String() string {
 func (*GetUserAccountsResponse_BankAccount) ProtoMessage() {}
 
 func (x *GetUserAccountsResponse_BankAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[101]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7858,7 +8941,7 @@ func (x *GetUserAccountsResponse_BankAccount) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetUserAccountsResponse_BankAccount.ProtoReflect.Descriptor instead.
 func (*GetUserAccountsResponse_BankAccount) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{48, 0}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{53, 0}
 }
 
 func (x *GetUserAccountsResponse_BankAccount) GetBankCode() string {
@@ -7901,7 +8984,7 @@ type FetchBetRangeResponse_Data struct {
 
 func (x *FetchBetRangeResponse_Data) Reset() {
 	*x = FetchBetRangeResponse_Data{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[102]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7913,7 +8996,7 @@ func (x *FetchBetRangeResponse_Data) String() string {
 func (*FetchBetRangeResponse_Data) ProtoMessage() {}
 
 func (x *FetchBetRangeResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[102]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7926,7 +9009,7 @@ func (x *FetchBetRangeResponse_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchBetRangeResponse_Data.ProtoReflect.Descriptor instead.
 func (*FetchBetRangeResponse_Data) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{52, 0}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{57, 0}
 }
 
 func (x *FetchBetRangeResponse_Data) GetUserId() int32 {
@@ -7968,7 +9051,7 @@ type FetchDepositCountResponse_Data struct {
 
 func (x *FetchDepositCountResponse_Data) Reset() {
 	*x = FetchDepositCountResponse_Data{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[103]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7980,7 +9063,7 @@ func (x *FetchDepositCountResponse_Data) String() string {
 func (*FetchDepositCountResponse_Data) ProtoMessage() {}
 
 func (x *FetchDepositCountResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[103]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7993,7 +9076,7 @@ func (x *FetchDepositCountResponse_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDepositCountResponse_Data.ProtoReflect.Descriptor instead.
 func (*FetchDepositCountResponse_Data) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{55, 0}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{60, 0}
 }
 
 func (x *FetchDepositCountResponse_Data) GetUserId() int32 {
@@ -8028,7 +9111,7 @@ type FetchDepositRangeResponse_Data struct {
 
 func (x *FetchDepositRangeResponse_Data) Reset() {
 	*x = FetchDepositRangeResponse_Data{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[104]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8040,7 +9123,7 @@ func (x *FetchDepositRangeResponse_Data) String() string {
 func (*FetchDepositRangeResponse_Data) ProtoMessage() {}
 
 func (x *FetchDepositRangeResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[104]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8053,7 +9136,7 @@ func (x *FetchDepositRangeResponse_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchDepositRangeResponse_Data.ProtoReflect.Descriptor instead.
 func (*FetchDepositRangeResponse_Data) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{56, 0}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{61, 0}
 }
 
 func (x *FetchDepositRangeResponse_Data) GetUserId() int32 {
@@ -8087,7 +9170,7 @@ type InitiateDepositResponse_Data struct {
 
 func (x *InitiateDepositResponse_Data) Reset() {
 	*x = InitiateDepositResponse_Data{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[105]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8099,7 +9182,7 @@ func (x *InitiateDepositResponse_Data) String() string {
 func (*InitiateDepositResponse_Data) ProtoMessage() {}
 
 func (x *InitiateDepositResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[105]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8112,7 +9195,7 @@ func (x *InitiateDepositResponse_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateDepositResponse_Data.ProtoReflect.Descriptor instead.
 func (*InitiateDepositResponse_Data) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{76, 0}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{86, 0}
 }
 
 func (x *InitiateDepositResponse_Data) GetLink() string {
@@ -8143,7 +9226,7 @@ type OpayWebhookResponse_Data struct {
 
 func (x *OpayWebhookResponse_Data) Reset() {
 	*x = OpayWebhookResponse_Data{}
-	mi := &file_grpc_proto_wallet_proto_msgTypes[106]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8155,7 +9238,7 @@ func (x *OpayWebhookResponse_Data) String() string {
 func (*OpayWebhookResponse_Data) ProtoMessage() {}
 
 func (x *OpayWebhookResponse_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_wallet_proto_msgTypes[106]
+	mi := &file_grpc_proto_wallet_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8168,7 +9251,7 @@ func (x *OpayWebhookResponse_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpayWebhookResponse_Data.ProtoReflect.Descriptor instead.
 func (*OpayWebhookResponse_Data) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{87, 0}
+	return file_grpc_proto_wallet_proto_rawDescGZIP(), []int{99, 0}
 }
 
 func (x *OpayWebhookResponse_Data) GetUserID() string {
@@ -8230,13 +9313,14 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06tx_ref\x18\x02 \x01(\tR\x05txRef\x12\x14\n" +
 	"\x05event\x18\x03 \x01(\tR\x05event\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12&\n" +
-	"\x0eflutterwaveKey\x18\x05 \x01(\tR\x0eflutterwaveKey\"\x90\x01\n" +
+	"\x0eflutterwaveKey\x18\x05 \x01(\tR\x0eflutterwaveKey\"\xae\x01\n" +
 	"\x12TigoWebhookRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1c\n" +
 	"\treference\x18\x02 \x01(\tR\treference\x12\x14\n" +
 	"\x05event\x18\x03 \x01(\tR\x05event\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12\x16\n" +
-	"\x06Status\x18\x05 \x01(\bR\x06Status\"B\n" +
+	"\x06Status\x18\x05 \x01(\bR\x06Status\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\tR\tsignature\"B\n" +
 	"\fTigoResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\x9b\x01\n" +
@@ -8303,10 +9387,14 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\bclientId\x18\x03 \x01(\x05R\bclientId\"M\n" +
 	"\x15PawapayCountryRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x18\n" +
-	"\acountry\x18\x02 \x01(\tR\acountry\"R\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\"\x86\x01\n" +
 	"\x18FetchLastApprovedRequest\x12\x1a\n" +
 	"\bbranchId\x18\x01 \x01(\x05R\bbranchId\x12\x1a\n" +
-	"\bclientId\x18\x02 \x01(\x05R\bclientId\"i\n" +
+	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12#\n" +
+	"\n" +
+	"verifiedBy\x18\x03 \x01(\x05H\x00R\n" +
+	"verifiedBy\x88\x01\x01B\r\n" +
+	"\v_verifiedBy\"i\n" +
 	"\x17FetchSalesReportRequest\x12\x1a\n" +
 	"\bbranchId\x18\x01 \x01(\x05R\bbranchId\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x16\n" +
@@ -8327,7 +9415,7 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12-\n" +
 	"\x04data\x18\x04 \x01(\v2\x14.wallet.LastApprovedH\x00R\x04data\x88\x01\x01B\a\n" +
-	"\x05_data\"\x92\x04\n" +
+	"\x05_data\"\x98\x05\n" +
 	"\fLastApproved\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
 	"\bbranchId\x18\x02 \x01(\x05R\bbranchId\x12&\n" +
@@ -8348,7 +9436,11 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06status\x18\x0e \x01(\x05R\x06status\x12\x12\n" +
 	"\x04date\x18\x0f \x01(\tR\x04date\x12\x1c\n" +
 	"\tcreatedAt\x18\x10 \x01(\tR\tcreatedAt\x12\x1a\n" +
-	"\bclientId\x18\x11 \x01(\x05R\bclientId\"\\\n" +
+	"\bclientId\x18\x11 \x01(\x05R\bclientId\x12;\n" +
+	"\x16expectedClosingBalance\x18\x12 \x01(\x05H\x00R\x16expectedClosingBalance\x88\x01\x01\x12\x1f\n" +
+	"\bvariance\x18\x13 \x01(\x05H\x01R\bvariance\x88\x01\x01B\x19\n" +
+	"\x17_expectedClosingBalanceB\v\n" +
+	"\t_variance\"\\\n" +
 	"\x12FetchReportRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
@@ -8404,7 +9496,20 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\t_usernameB\t\n" +
 	"\a_amountB\x13\n" +
 	"\x11_withdrawalChargeB\v\n" +
-	"\t_userRole\"\x9a\x02\n" +
+	"\t_userRole\"\x95\x02\n" +
+	"\x12TillSessionRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1a\n" +
+	"\bbranchId\x18\x02 \x01(\x05R\bbranchId\x12\x1c\n" +
+	"\tcashierId\x18\x03 \x01(\x05R\tcashierId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12!\n" +
+	"\tsessionId\x18\x05 \x01(\x05H\x00R\tsessionId\x88\x01\x01\x12%\n" +
+	"\vtoCashierId\x18\x06 \x01(\x05H\x01R\vtoCashierId\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\a \x01(\tH\x02R\acomment\x88\x01\x01B\f\n" +
+	"\n" +
+	"_sessionIdB\x0e\n" +
+	"\f_toCashierIdB\n" +
+	"\n" +
+	"\b_comment\"\x88\x03\n" +
 	"\x15WalletTransferRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1a\n" +
 	"\btoUserId\x18\x02 \x01(\x05R\btoUserId\x12\x1e\n" +
@@ -8417,15 +9522,24 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"fromUserId\x12\x16\n" +
 	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12%\n" +
 	"\vdescription\x18\a \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x16\n" +
-	"\x06action\x18\b \x01(\tR\x06actionB\x0e\n" +
-	"\f_description\"\x92\x01\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12'\n" +
+	"\ffromCurrency\x18\t \x01(\tH\x01R\ffromCurrency\x88\x01\x01\x12#\n" +
+	"\n" +
+	"toCurrency\x18\n" +
+	" \x01(\tH\x02R\n" +
+	"toCurrency\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_fromCurrencyB\r\n" +
+	"\v_toCurrency\"\x92\x01\n" +
 	"\x1aValidateTransactionRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
 	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1f\n" +
 	"\buserRole\x18\x04 \x01(\tH\x00R\buserRole\x88\x01\x01B\v\n" +
 	"\t_userRole\"\x0e\n" +
-	"\fEmptyRequest\"i\n" +
+	"\fEmptyRequest\"+\n" +
+	"\rClientRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\"i\n" +
 	"\rBranchRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1a\n" +
 	"\bbranchId\x18\x02 \x01(\x05R\bbranchId\x12\x17\n" +
@@ -8435,7 +9549,10 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\"\x1b\n" +
 	"\tIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xa7\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"E\n" +
+	"\x17DeletePlayerDataRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bclientId\x18\x02 \x01(\x05R\bclientId\"\xc9\x01\n" +
 	"\x1dCashbookApproveExpenseRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x1e\n" +
 	"\n" +
@@ -8443,7 +9560,8 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"verifiedBy\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1c\n" +
 	"\texpenseId\x18\x04 \x01(\x05R\texpenseId\x12\x18\n" +
-	"\acomment\x18\x05 \x01(\tR\acomment\"\xca\x01\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1a\n" +
+	"\bclientId\x18\a \x01(\x05R\bclientIdJ\x04\b\x06\x10\a\"\xca\x01\n" +
 	"\x1cCashbookCreateExpenseRequest\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\x05R\x06amount\x12$\n" +
 	"\rexpenseTypeId\x18\x02 \x01(\x05R\rexpenseTypeId\x12\x1a\n" +
@@ -8462,7 +9580,7 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12#\n" +
-	"\x04data\x18\x04 \x03(\v2\x0f.wallet.ExpenseR\x04data\"\xbb\x03\n" +
+	"\x04data\x18\x04 \x03(\v2\x0f.wallet.ExpenseR\x04data\"\xf8\x03\n" +
 	"\aExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12$\n" +
@@ -8481,10 +9599,12 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"verifiedBy\x12\x1c\n" +
 	"\tcreatedAt\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\abalance\x18\f \x01(\x05H\x00R\abalance\x88\x01\x01\x12%\n" +
-	"\vexpenseType\x18\r \x01(\tH\x01R\vexpenseType\x88\x01\x01B\n" +
+	"\vexpenseType\x18\r \x01(\tH\x01R\vexpenseType\x88\x01\x01\x12)\n" +
+	"\rapprovalLevel\x18\x0e \x01(\x05H\x02R\rapprovalLevel\x88\x01\x01B\n" +
 	"\n" +
 	"\b_balanceB\x0e\n" +
-	"\f_expenseType\"i\n" +
+	"\f_expenseTypeB\x10\n" +
+	"\x0e_approvalLevel\"i\n" +
 	"\x1fCashbookApproveCashInOutRequest\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x1e\n" +
@@ -8523,11 +9643,29 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\abalance\x18\t \x01(\x05H\x00R\abalance\x88\x01\x01B\n" +
 	"\n" +
-	"\b_balance\"f\n" +
+	"\b_balance\"\xa2\x02\n" +
 	" CashbookCreateExpenseTypeRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
-	"\x05fixed\x18\x03 \x01(\x05R\x05fixed\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x05R\x06amount\"\x9e\x01\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x19\n" +
+	"\x05fixed\x18\x03 \x01(\x05H\x00R\x05fixed\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\x05H\x01R\x06amount\x88\x01\x01\x12\x1a\n" +
+	"\bclientId\x18\x05 \x01(\x05R\bclientId\x12\x13\n" +
+	"\x02id\x18\x06 \x01(\x05H\x02R\x02id\x88\x01\x01\x12)\n" +
+	"\rmonthlyBudget\x18\a \x01(\x05H\x03R\rmonthlyBudget\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\b \x01(\x05H\x04R\x06status\x88\x01\x01B\b\n" +
+	"\x06_fixedB\t\n" +
+	"\a_amountB\x05\n" +
+	"\x03_idB\x10\n" +
+	"\x0e_monthlyBudgetB\t\n" +
+	"\a_status\"b\n" +
+	"\x16ExpenseApproverRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\"\x9a\x01\n" +
+	"\x14ExpenseBudgetRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12$\n" +
+	"\rexpenseTypeId\x18\x02 \x01(\x05R\rexpenseTypeId\x12\x1a\n" +
+	"\bbranchId\x18\x03 \x01(\x05R\bbranchId\x12$\n" +
+	"\rmonthlyBudget\x18\x04 \x01(\x05R\rmonthlyBudget\"\x9e\x01\n" +
 	"\x19ExpenseTypeSingleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
@@ -8538,14 +9676,19 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12'\n" +
-	"\x04data\x18\x04 \x03(\v2\x13.wallet.ExpenseTypeR\x04data\"\x97\x01\n" +
+	"\x04data\x18\x04 \x03(\v2\x13.wallet.ExpenseTypeR\x04data\"\x95\x02\n" +
 	"\vExpenseType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1c\n" +
 	"\tcreatedAt\x18\x04 \x01(\tR\tcreatedAt\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x14\n" +
-	"\x05fixed\x18\x06 \x01(\x05R\x05fixed\"\xea\x01\n" +
+	"\x05fixed\x18\x06 \x01(\x05R\x05fixed\x12\x1a\n" +
+	"\bclientId\x18\a \x01(\x05R\bclientId\x12)\n" +
+	"\rmonthlyBudget\x18\b \x01(\x05H\x00R\rmonthlyBudget\x88\x01\x01\x12\x19\n" +
+	"\x05spent\x18\t \x01(\x05H\x01R\x05spent\x88\x01\x01B\x10\n" +
+	"\x0e_monthlyBudgetB\b\n" +
+	"\x06_spent\"\xea\x01\n" +
 	"\x17GetUserAccountsResponse\x12?\n" +
 	"\x04data\x18\x01 \x03(\v2+.wallet.GetUserAccountsResponse.BankAccountR\x04data\x1a\x8d\x01\n" +
 	"\vBankAccount\x12\x1a\n" +
@@ -8616,11 +9759,12 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x02R\x05total\x12\x18\n" +
 	"\abalance\x18\x03 \x01(\x02R\abalanceB\b\n" +
-	"\x06_error\"k\n" +
+	"\x06_error\"\x87\x01\n" +
 	"\x19FetchPlayerDepositRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x1c\n" +
 	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12\x18\n" +
-	"\aendDate\x18\x03 \x01(\tR\aendDate\"\xb7\x03\n" +
+	"\aendDate\x18\x03 \x01(\tR\aendDate\x12\x1a\n" +
+	"\bclientId\x18\x04 \x01(\x05R\bclientId\"\xb7\x03\n" +
 	"\x11TransactionEntity\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x16\n" +
@@ -8637,21 +9781,42 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\abalance\x18\f \x01(\x05R\abalance\x12\x16\n" +
 	"\x06status\x18\r \x01(\x05R\x06status\x12\x1c\n" +
 	"\tcreatedAt\x18\x0e \x01(\tR\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\x0f \x01(\tR\tupdatedAt\"\xac\x02\n" +
+	"\tupdatedAt\x18\x0f \x01(\tR\tupdatedAt\"\x85\x05\n" +
 	"\x14PaymentMethodRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
 	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x1c\n" +
-	"\tsecretKey\x18\x04 \x01(\tR\tsecretKey\x12\x1c\n" +
-	"\tpublicKey\x18\x05 \x01(\tR\tpublicKey\x12\x1e\n" +
+	"\tsecretKey\x18\x04 \x01(\tR\tsecretKey\x12!\n" +
+	"\tpublicKey\x18\x05 \x01(\tH\x00R\tpublicKey\x88\x01\x01\x12#\n" +
 	"\n" +
-	"merchantId\x18\x06 \x01(\tR\n" +
-	"merchantId\x12\x18\n" +
-	"\abaseUrl\x18\a \x01(\tR\abaseUrl\x12\x16\n" +
-	"\x06status\x18\b \x01(\x05R\x06status\x12(\n" +
-	"\x0fforDisbursement\x18\t \x01(\x05R\x0fforDisbursement\x12\x0e\n" +
+	"merchantId\x18\x06 \x01(\tH\x01R\n" +
+	"merchantId\x88\x01\x01\x12\x1d\n" +
+	"\abaseUrl\x18\a \x01(\tH\x02R\abaseUrl\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\b \x01(\x05H\x03R\x06status\x88\x01\x01\x12-\n" +
+	"\x0fforDisbursement\x18\t \x01(\x05H\x04R\x0fforDisbursement\x88\x01\x01\x12\x0e\n" +
 	"\x02id\x18\n" +
-	" \x01(\x05R\x02id\"\x82\x01\n" +
+	" \x01(\x05R\x02id\x12!\n" +
+	"\tminAmount\x18\v \x01(\x01H\x05R\tminAmount\x88\x01\x01\x12!\n" +
+	"\tmaxAmount\x18\f \x01(\x01H\x06R\tmaxAmount\x88\x01\x01\x12'\n" +
+	"\fdisplayOrder\x18\r \x01(\x05H\aR\fdisplayOrder\x88\x01\x01\x12\x1d\n" +
+	"\acountry\x18\x0e \x01(\tH\bR\acountry\x88\x01\x01\x12!\n" +
+	"\tbankCodes\x18\x0f \x01(\tH\tR\tbankCodes\x88\x01\x01B\f\n" +
+	"\n" +
+	"_publicKeyB\r\n" +
+	"\v_merchantIdB\n" +
+	"\n" +
+	"\b_baseUrlB\t\n" +
+	"\a_statusB\x12\n" +
+	"\x10_forDisbursementB\f\n" +
+	"\n" +
+	"_minAmountB\f\n" +
+	"\n" +
+	"_maxAmountB\x0f\n" +
+	"\r_displayOrderB\n" +
+	"\n" +
+	"\b_countryB\f\n" +
+	"\n" +
+	"_bankCodes\"\x82\x01\n" +
 	"\x14VerifyDepositRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12&\n" +
 	"\x0etransactionRef\x18\x02 \x01(\tR\x0etransactionRef\x12&\n" +
@@ -8672,11 +9837,13 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x05event\x18\x03 \x01(\tR\x05event\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\"+\n" +
 	"\x0fWebhookResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"]\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa0\x01\n" +
 	"\x17GetPaymentMethodRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1b\n" +
-	"\x06status\x18\x02 \x01(\x05H\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_status\"\x91\x01\n" +
+	"\x06status\x18\x02 \x01(\x05H\x00R\x06status\x88\x01\x01\x12-\n" +
+	"\x0fforDisbursement\x18\x03 \x01(\x05H\x01R\x0fforDisbursement\x88\x01\x01B\t\n" +
+	"\a_statusB\x12\n" +
+	"\x10_forDisbursement\"\x91\x01\n" +
 	"\x18GetPaymentMethodResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
@@ -8687,7 +9854,7 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12.\n" +
 	"\x04data\x18\x04 \x01(\v2\x15.wallet.PaymentMethodH\x00R\x04data\x88\x01\x01B\a\n" +
-	"\x05_data\"\x8e\x02\n" +
+	"\x05_data\"\xaa\x03\n" +
 	"\rPaymentMethod\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
 	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x1d\n" +
@@ -8701,7 +9868,15 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06status\x18\b \x01(\x05R\x06status\x12)\n" +
 	"\x10for_disbursement\x18\t \x01(\x05R\x0fforDisbursement\x12\x0e\n" +
 	"\x02id\x18\n" +
-	" \x01(\x05R\x02id\"\xb2\x01\n" +
+	" \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\v \x01(\x01R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\f \x01(\x01R\tmaxAmount\x12#\n" +
+	"\rdisplay_order\x18\r \x01(\x05R\fdisplayOrder\x12\x18\n" +
+	"\acountry\x18\x0e \x01(\tR\acountry\x12\x1d\n" +
+	"\n" +
+	"bank_codes\x18\x0f \x01(\tR\tbankCodes\"\xb2\x01\n" +
 	"\x13CreateWalletRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x1a\n" +
@@ -8715,12 +9890,14 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12'\n" +
 	"\x04data\x18\x04 \x01(\v2\x0e.wallet.WalletH\x00R\x04data\x88\x01\x01B\a\n" +
-	"\x05_data\"o\n" +
+	"\x05_data\"\x9d\x01\n" +
 	"\x11GetBalanceRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x1b\n" +
-	"\x06wallet\x18\x03 \x01(\tH\x00R\x06wallet\x88\x01\x01B\t\n" +
-	"\a_wallet\"\x81\x02\n" +
+	"\x06wallet\x18\x03 \x01(\tH\x00R\x06wallet\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tH\x01R\bcurrency\x88\x01\x01B\t\n" +
+	"\a_walletB\v\n" +
+	"\t_currency\"\xaf\x02\n" +
 	"\x11CreditUserRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x16\n" +
@@ -8730,7 +9907,10 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\busername\x18\x06 \x01(\tR\busername\x12\x16\n" +
 	"\x06wallet\x18\a \x01(\tR\x06wallet\x12\x18\n" +
 	"\asubject\x18\b \x01(\tR\asubject\x12\x18\n" +
-	"\achannel\x18\t \x01(\tR\achannel\"\x80\x02\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x1f\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tH\x00R\bcurrency\x88\x01\x01B\v\n" +
+	"\t_currency\"\xae\x02\n" +
 	"\x10DebitUserRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x16\n" +
@@ -8740,7 +9920,10 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\busername\x18\x06 \x01(\tR\busername\x12\x16\n" +
 	"\x06wallet\x18\a \x01(\tR\x06wallet\x12\x18\n" +
 	"\asubject\x18\b \x01(\tR\asubject\x12\x18\n" +
-	"\achannel\x18\t \x01(\tR\achannel\"\x9a\x02\n" +
+	"\achannel\x18\t \x01(\tR\achannel\x12\x1f\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tH\x00R\bcurrency\x88\x01\x01B\v\n" +
+	"\t_currency\"\x93\x03\n" +
 	"\x06Wallet\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\x01R\abalance\x12*\n" +
@@ -8748,7 +9931,45 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\ftrustBalance\x18\x04 \x01(\x01R\ftrustBalance\x12,\n" +
 	"\x11sportBonusBalance\x18\x05 \x01(\x01R\x11sportBonusBalance\x120\n" +
 	"\x13virtualBonusBalance\x18\x06 \x01(\x01R\x13virtualBonusBalance\x12.\n" +
-	"\x12casinoBonusBalance\x18\a \x01(\x01R\x12casinoBonusBalance\"\xbe\x01\n" +
+	"\x12casinoBonusBalance\x18\a \x01(\x01R\x12casinoBonusBalance\x12+\n" +
+	"\awallets\x18\b \x03(\v2\x11.wallet.SubWalletR\awallets\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12.\n" +
+	"\n" +
+	"currencies\x18\n" +
+	" \x03(\v2\x0e.wallet.WalletR\n" +
+	"currencies\"\xaf\x01\n" +
+	"\tSubWallet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\x12\"\n" +
+	"\fwithdrawable\x18\x04 \x01(\bR\fwithdrawable\x12\x14\n" +
+	"\x05bonus\x18\x05 \x01(\bR\x05bonus\x12$\n" +
+	"\rcanGoNegative\x18\x06 \x01(\bR\rcanGoNegative\"m\n" +
+	"\x15ClientCurrencyRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tisDefault\x18\x03 \x01(\bR\tisDefault\"\xee\x01\n" +
+	"\x13ExchangeRateRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\"\n" +
+	"\fbaseCurrency\x18\x02 \x01(\tR\fbaseCurrency\x12$\n" +
+	"\rquoteCurrency\x18\x03 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12%\n" +
+	"\veffectiveAt\x18\x05 \x01(\tH\x00R\veffectiveAt\x88\x01\x01\x12\x1b\n" +
+	"\x06spread\x18\x06 \x01(\x01H\x01R\x06spread\x88\x01\x01B\x0e\n" +
+	"\f_effectiveAtB\t\n" +
+	"\a_spread\"Q\n" +
+	"\x19ExchangeRateImportRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xe1\x01\n" +
+	"\x11WalletTypeRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\"\n" +
+	"\fwithdrawable\x18\x04 \x01(\bR\fwithdrawable\x12\x14\n" +
+	"\x05bonus\x18\x05 \x01(\bR\x05bonus\x12$\n" +
+	"\rcanGoNegative\x18\x06 \x01(\bR\rcanGoNegative\x12\x1b\n" +
+	"\x06status\x18\a \x01(\x05H\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xbe\x01\n" +
 	"\x16InitiateDepositRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
 	"\bclientId\x18\x02 \x01(\x05R\bclientId\x12\x16\n" +
@@ -8786,7 +10007,13 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\raccountNumber\x18\x03 \x01(\tR\raccountNumber\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04size\x18\x05 \x01(\x05R\x04size\x12\x1a\n" +
-	"\bfromDate\x18\x06 \x01(\tR\bfromDate\"\xa8\x01\n" +
+	"\bfromDate\x18\x06 \x01(\tR\bfromDate\".\n" +
+	"\x10ListBanksRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\"c\n" +
+	"\x11PlayerNameRequest\x12\x1a\n" +
+	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\bfullName\x18\x03 \x01(\tR\bfullName\"\xa8\x01\n" +
 	"\x18VerifyBankAccountRequest\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x16\n" +
 	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12$\n" +
@@ -8923,7 +10150,7 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\x12+\n" +
-	"\x04data\x18\x04 \x03(\v2\x17.google.protobuf.StructR\x04data\"\xf2\x04\n" +
+	"\x04data\x18\x04 \x03(\v2\x17.google.protobuf.StructR\x04data\"\x94\x05\n" +
 	"\x10PlayerWalletData\x12\"\n" +
 	"\fsportBalance\x18\x01 \x01(\x02R\fsportBalance\x12$\n" +
 	"\rtotalDeposits\x18\x02 \x01(\x02R\rtotalDeposits\x12,\n" +
@@ -8939,16 +10166,24 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x11firstActivityDate\x18\v \x01(\tR\x11firstActivityDate\x12*\n" +
 	"\x10lastActivityDate\x18\f \x01(\tR\x10lastActivityDate\x12\"\n" +
 	"\fnoOfDeposits\x18\r \x01(\x05R\fnoOfDeposits\x12(\n" +
-	"\x0fnoOfWithdrawals\x18\x0e \x01(\x05R\x0fnoOfWithdrawals\"\xfd\x01\n" +
+	"\x0fnoOfWithdrawals\x18\x0e \x01(\x05R\x0fnoOfWithdrawals\x12 \n" +
+	"\vavgDeposits\x18\x0f \x01(\x02R\vavgDeposits\"\xe0\x02\n" +
 	"\x13ListDepositRequests\x12\x1a\n" +
 	"\bclientId\x18\x01 \x01(\x05R\bclientId\x12\x1c\n" +
 	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\x03 \x01(\tR\aendDate\x12$\n" +
-	"\rpaymentMethod\x18\x04 \x01(\tR\rpaymentMethod\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x1a\n" +
+	"\rpaymentMethod\x18\x04 \x01(\tR\rpaymentMethod\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\x05H\x00R\x06status\x88\x01\x01\x12\x1a\n" +
 	"\busername\x18\x06 \x01(\tR\busername\x12$\n" +
 	"\rtransactionId\x18\a \x01(\tR\rtransactionId\x12\x12\n" +
-	"\x04page\x18\b \x01(\x05R\x04page\"\xe7\x01\n" +
+	"\x04page\x18\b \x01(\x05R\x04page\x12\x1d\n" +
+	"\aperPage\x18\t \x01(\x05H\x01R\aperPage\x88\x01\x01\x12\x1b\n" +
+	"\x06export\x18\n" +
+	" \x01(\bH\x02R\x06export\x88\x01\x01B\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_perPageB\t\n" +
+	"\a_export\"\xe7\x01\n" +
 	"\x12PaginationResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\x12 \n" +
@@ -8963,7 +10198,7 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1a\n" +
 	"\blastPage\x18\x04 \x01(\x05R\blastPage\x12\x1a\n" +
 	"\bnextPage\x18\x05 \x01(\x05R\bnextPage\x12\x1a\n" +
-	"\bprevPage\x18\x06 \x01(\x05R\bprevPage2\xf3<\n" +
+	"\bprevPage\x18\x06 \x01(\x05R\bprevPage2\xb4I\n" +
 	"\rWalletService\x12_\n" +
 	"\x1eCashbookVerifyFinalTransaction\x12 .wallet.FetchLastApprovedRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12]\n" +
 	"\x19CashbookFetchLastApproved\x12 .wallet.FetchLastApprovedRequest\x1a\x1c.wallet.LastApprovedResponse\"\x00\x12_\n" +
@@ -8973,14 +10208,19 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x1eCashbookFetchMonthlyShopReport\x12\x1a.wallet.FetchReportRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12H\n" +
 	"\rCurrentReport\x12\x1a.wallet.FetchReportRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12`\n" +
 	"\x16CashbookApproveExpense\x12%.wallet.CashbookApproveExpenseRequest\x1a\x1d.wallet.ExpenseSingleResponse\"\x00\x12^\n" +
-	"\x15CashbookCreateExpense\x12$.wallet.CashbookCreateExpenseRequest\x1a\x1d.wallet.ExpenseSingleResponse\"\x00\x12Q\n" +
-	"\x16CashbookFindAllExpense\x12\x14.wallet.EmptyRequest\x1a\x1f.wallet.ExpenseRepeatedResponse\"\x00\x12T\n" +
+	"\x15CashbookCreateExpense\x12$.wallet.CashbookCreateExpenseRequest\x1a\x1d.wallet.ExpenseSingleResponse\"\x00\x12R\n" +
+	"\x16CashbookFindAllExpense\x12\x15.wallet.ClientRequest\x1a\x1f.wallet.ExpenseRepeatedResponse\"\x00\x12T\n" +
 	"\x16CashbookFindOneExpense\x12\x19.wallet.CashbookIdRequest\x1a\x1d.wallet.ExpenseSingleResponse\"\x00\x12V\n" +
 	"\x18CashbookDeleteOneExpense\x12\x19.wallet.CashbookIdRequest\x1a\x1d.wallet.ExpenseSingleResponse\"\x00\x12a\n" +
 	"\x18CashbookUpdateOneExpense\x12$.wallet.CashbookCreateExpenseRequest\x1a\x1d.wallet.ExpenseSingleResponse\"\x00\x12X\n" +
 	"\x1cCashbookFindAllBranchExpense\x12\x15.wallet.BranchRequest\x1a\x1f.wallet.ExpenseRepeatedResponse\"\x00\x12j\n" +
-	"\x19CashbookCreateExpenseType\x12(.wallet.CashbookCreateExpenseTypeRequest\x1a!.wallet.ExpenseTypeSingleResponse\"\x00\x12Y\n" +
-	"\x1aCashbookFindAllExpenseType\x12\x14.wallet.EmptyRequest\x1a#.wallet.ExpenseTypeRepeatedResponse\"\x00\x12c\n" +
+	"\x19CashbookCreateExpenseType\x12(.wallet.CashbookCreateExpenseTypeRequest\x1a!.wallet.ExpenseTypeSingleResponse\"\x00\x12Z\n" +
+	"\x1aCashbookFindAllExpenseType\x12\x15.wallet.ClientRequest\x1a#.wallet.ExpenseTypeRepeatedResponse\"\x00\x12j\n" +
+	"\x19CashbookUpdateExpenseType\x12(.wallet.CashbookCreateExpenseTypeRequest\x1a!.wallet.ExpenseTypeSingleResponse\"\x00\x12[\n" +
+	"\x19CashbookDeleteExpenseType\x12\x19.wallet.CashbookIdRequest\x1a!.wallet.ExpenseTypeSingleResponse\"\x00\x12`\n" +
+	" CashbookFindAllClientExpenseType\x12\x15.wallet.BranchRequest\x1a#.wallet.ExpenseTypeRepeatedResponse\"\x00\x12U\n" +
+	"\x18CashbookSetExpenseBudget\x12\x1c.wallet.ExpenseBudgetRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12Y\n" +
+	"\x1aCashbookSetExpenseApprover\x12\x1e.wallet.ExpenseApproverRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12c\n" +
 	"\x15CashbookApproveCashIn\x12'.wallet.CashbookApproveCashInOutRequest\x1a\x1f.wallet.CashInOutSingleResponse\"\x00\x12a\n" +
 	"\x14CashbookCreateCashIn\x12&.wallet.CashbookCreateCashInOutRequest\x1a\x1f.wallet.CashInOutSingleResponse\"\x00\x12a\n" +
 	"\x14CashbookUpdateCashIn\x12&.wallet.CashbookCreateCashInOutRequest\x1a\x1f.wallet.CashInOutSingleResponse\"\x00\x12W\n" +
@@ -9016,7 +10256,14 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x15HandleWayaQuickVerify\x12\x18.wallet.WayaQuickRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12Z\n" +
 	"\x14FetchUsersWithdrawal\x12#.wallet.FetchUsersWithdrawalRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12A\n" +
 	"\n" +
-	"GetBalance\x12\x19.wallet.GetBalanceRequest\x1a\x16.wallet.WalletResponse\"\x00\x12E\n" +
+	"GetBalance\x12\x19.wallet.GetBalanceRequest\x1a\x16.wallet.WalletResponse\"\x00\x12H\n" +
+	"\x0eSaveWalletType\x12\x19.wallet.WalletTypeRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12J\n" +
+	"\x0eGetWalletTypes\x12\x19.wallet.WalletTypeRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12P\n" +
+	"\x12SaveClientCurrency\x12\x1d.wallet.ClientCurrencyRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12S\n" +
+	"\x13GetClientCurrencies\x12\x1d.wallet.ClientCurrencyRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12L\n" +
+	"\x10SaveExchangeRate\x12\x1b.wallet.ExchangeRateRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12U\n" +
+	"\x13ImportExchangeRates\x12!.wallet.ExchangeRateImportRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12N\n" +
+	"\x10GetExchangeRates\x12\x1b.wallet.ExchangeRateRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12E\n" +
 	"\fCreateWallet\x12\x1b.wallet.CreateWalletRequest\x1a\x16.wallet.WalletResponse\"\x00\x12N\n" +
 	"\rFetchBetRange\x12\x1c.wallet.FetchBetRangeRequest\x1a\x1d.wallet.FetchBetRangeResponse\"\x00\x12Q\n" +
 	"\x12FetchPlayerDeposit\x12!.wallet.FetchPlayerDepositRequest\x1a\x16.wallet.WalletResponse\"\x00\x12Z\n" +
@@ -9029,11 +10276,13 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x10InititateDeposit\x12\x1e.wallet.InitiateDepositRequest\x1a\x1f.wallet.InitiateDepositResponse\"\x00\x12N\n" +
 	"\rVerifyDeposit\x12\x1c.wallet.VerifyDepositRequest\x1a\x1d.wallet.VerifyDepositResponse\"\x00\x12H\n" +
 	"\x11RequestWithdrawal\x12\x17.wallet.WithdrawRequest\x1a\x18.wallet.WithdrawResponse\"\x00\x12Z\n" +
-	"\x11VerifyBankAccount\x12 .wallet.VerifyBankAccountRequest\x1a!.wallet.VerifyBankAccountResponse\"\x00\x12@\n" +
-	"\tListBanks\x12\x14.wallet.EmptyRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12R\n" +
+	"\x11VerifyBankAccount\x12 .wallet.VerifyBankAccountRequest\x1a!.wallet.VerifyBankAccountResponse\"\x00\x12D\n" +
+	"\tListBanks\x12\x18.wallet.ListBanksRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12H\n" +
+	"\x0eSavePlayerName\x12\x19.wallet.PlayerNameRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12R\n" +
 	"\x0fGetTransactions\x12\x1d.wallet.GetTransactionRequest\x1a\x1e.wallet.GetTransactionResponse\"\x00\x12X\n" +
 	"\x11GetPaymentMethods\x12\x1f.wallet.GetPaymentMethodRequest\x1a .wallet.GetPaymentMethodResponse\"\x00\x12R\n" +
-	"\x11SavePaymentMethod\x12\x1c.wallet.PaymentMethodRequest\x1a\x1d.wallet.PaymentMethodResponse\"\x00\x12L\n" +
+	"\x11SavePaymentMethod\x12\x1c.wallet.PaymentMethodRequest\x1a\x1d.wallet.PaymentMethodResponse\"\x00\x12T\n" +
+	"\x13DeletePaymentMethod\x12\x1c.wallet.PaymentMethodRequest\x1a\x1d.wallet.PaymentMethodResponse\"\x00\x12L\n" +
 	"\x0fPaystackWebhook\x12\x1e.wallet.PaystackWebhookRequest\x1a\x17.wallet.WebhookResponse\"\x00\x12J\n" +
 	"\x0eMonnifyWebhook\x12\x1d.wallet.MonnifyWebhookRequest\x1a\x17.wallet.WebhookResponse\"\x00\x12O\n" +
 	"\x12OpayDepositWebhook\x12\x1a.wallet.OpayWebhookRequest\x1a\x1b.wallet.OpayWebhookResponse\"\x00\x12N\n" +
@@ -9042,8 +10291,8 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\fListDeposits\x12\x1b.wallet.ListDepositRequests\x1a\x1a.wallet.PaginationResponse\"\x00\x12U\n" +
 	"\x10UserTransactions\x12\x1e.wallet.UserTransactionRequest\x1a\x1f.wallet.UserTransactionResponse\"\x00\x12P\n" +
 	"\x10UpdateWithdrawal\x12\x1f.wallet.UpdateWithdrawalRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12L\n" +
-	"\x13GetPlayerWalletData\x12\x19.wallet.GetBalanceRequest\x1a\x18.wallet.PlayerWalletData\"\x00\x12B\n" +
-	"\x10DeletePlayerData\x12\x11.wallet.IdRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12O\n" +
+	"\x13GetPlayerWalletData\x12\x19.wallet.GetBalanceRequest\x1a\x18.wallet.PlayerWalletData\"\x00\x12P\n" +
+	"\x10DeletePlayerData\x12\x1f.wallet.DeletePlayerDataRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12O\n" +
 	"\x0fGetUserAccounts\x12\x19.wallet.GetBalanceRequest\x1a\x1f.wallet.GetUserAccountsResponse\"\x00\x12Z\n" +
 	"\x11GetNetworkBalance\x12 .wallet.GetNetworkBalanceRequest\x1a!.wallet.GetNetworkBalanceResponse\"\x00\x12R\n" +
 	"\x13GetMoneyTransaction\x12\x1e.wallet.GetTransactionsRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12S\n" +
@@ -9053,7 +10302,12 @@ const file_grpc_proto_wallet_proto_rawDesc = "" +
 	"\x12ProcessShopDeposit\x12 .wallet.ProcessRetailTransaction\x1a\x19.wallet.CommonResponseObj\"\x00\x12Y\n" +
 	"\x16ValidateWithdrawalCode\x12\".wallet.ValidateTransactionRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12V\n" +
 	"\x15ProcessShopWithdrawal\x12 .wallet.ProcessRetailTransaction\x1a\x19.wallet.CommonResponseObj\"\x00\x12J\n" +
-	"\x11DebitAgentBalance\x12\x18.wallet.DebitUserRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12R\n" +
+	"\x11DebitAgentBalance\x12\x18.wallet.DebitUserRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12J\n" +
+	"\x0fOpenTillSession\x12\x1a.wallet.TillSessionRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12K\n" +
+	"\x10CloseTillSession\x12\x1a.wallet.TillSessionRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12N\n" +
+	"\x13HandoverTillSession\x12\x1a.wallet.TillSessionRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12I\n" +
+	"\x0eGetTillSession\x12\x1a.wallet.TillSessionRequest\x1a\x19.wallet.CommonResponseObj\"\x00\x12H\n" +
+	"\x10ListTillSessions\x12\x15.wallet.BranchRequest\x1a\x1b.wallet.CommonResponseArray\"\x00\x12R\n" +
 	"\x12FlutterWaveWebhook\x12!.wallet.FlutterwaveWebhookRequest\x1a\x17.wallet.WebhookResponse\"\x00\x12J\n" +
 	"\x0eKorapayWebhook\x12\x1d.wallet.KoraPayWebhookRequest\x1a\x17.wallet.WebhookResponse\"\x00\x12A\n" +
 	"\vTigoWebhook\x12\x1a.wallet.TigoWebhookRequest\x1a\x14.wallet.TigoResponse\"\x00\x12D\n" +
@@ -9072,7 +10326,7 @@ func file_grpc_proto_wallet_proto_rawDescGZIP() []byte {
 	return file_grpc_proto_wallet_proto_rawDescData
 }
 
-var file_grpc_proto_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
+var file_grpc_proto_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
 var file_grpc_proto_wallet_proto_goTypes = []any{
 	(*PawapayRequest)(nil),                      // 0: wallet.PawapayRequest
 	(*PawapayResponse)(nil),                     // 1: wallet.PawapayResponse
@@ -9102,302 +10356,354 @@ var file_grpc_proto_wallet_proto_goTypes = []any{
 	(*FetchReportResponse)(nil),                 // 25: wallet.FetchReportResponse
 	(*GetTransactionsRequest)(nil),              // 26: wallet.GetTransactionsRequest
 	(*ProcessRetailTransaction)(nil),            // 27: wallet.ProcessRetailTransaction
-	(*WalletTransferRequest)(nil),               // 28: wallet.WalletTransferRequest
-	(*ValidateTransactionRequest)(nil),          // 29: wallet.ValidateTransactionRequest
-	(*EmptyRequest)(nil),                        // 30: wallet.EmptyRequest
-	(*BranchRequest)(nil),                       // 31: wallet.BranchRequest
-	(*CashbookIdRequest)(nil),                   // 32: wallet.CashbookIdRequest
-	(*IdRequest)(nil),                           // 33: wallet.IdRequest
-	(*CashbookApproveExpenseRequest)(nil),       // 34: wallet.CashbookApproveExpenseRequest
-	(*CashbookCreateExpenseRequest)(nil),        // 35: wallet.CashbookCreateExpenseRequest
-	(*ExpenseSingleResponse)(nil),               // 36: wallet.ExpenseSingleResponse
-	(*ExpenseRepeatedResponse)(nil),             // 37: wallet.ExpenseRepeatedResponse
-	(*Expense)(nil),                             // 38: wallet.Expense
-	(*CashbookApproveCashInOutRequest)(nil),     // 39: wallet.CashbookApproveCashInOutRequest
-	(*CashbookCreateCashInOutRequest)(nil),      // 40: wallet.CashbookCreateCashInOutRequest
-	(*CashInOutSingleResponse)(nil),             // 41: wallet.CashInOutSingleResponse
-	(*CashInOutRepeatedResponse)(nil),           // 42: wallet.CashInOutRepeatedResponse
-	(*CashInOut)(nil),                           // 43: wallet.CashInOut
-	(*CashbookCreateExpenseTypeRequest)(nil),    // 44: wallet.CashbookCreateExpenseTypeRequest
-	(*ExpenseTypeSingleResponse)(nil),           // 45: wallet.ExpenseTypeSingleResponse
-	(*ExpenseTypeRepeatedResponse)(nil),         // 46: wallet.ExpenseTypeRepeatedResponse
-	(*ExpenseType)(nil),                         // 47: wallet.ExpenseType
-	(*GetUserAccountsResponse)(nil),             // 48: wallet.GetUserAccountsResponse
-	(*GetNetworkBalanceRequest)(nil),            // 49: wallet.GetNetworkBalanceRequest
-	(*GetNetworkBalanceResponse)(nil),           // 50: wallet.GetNetworkBalanceResponse
-	(*FetchBetRangeRequest)(nil),                // 51: wallet.FetchBetRangeRequest
-	(*FetchBetRangeResponse)(nil),               // 52: wallet.FetchBetRangeResponse
-	(*FetchDepositRangeRequest)(nil),            // 53: wallet.FetchDepositRangeRequest
-	(*FetchDepositCountRequest)(nil),            // 54: wallet.FetchDepositCountRequest
-	(*FetchDepositCountResponse)(nil),           // 55: wallet.FetchDepositCountResponse
-	(*FetchDepositRangeResponse)(nil),           // 56: wallet.FetchDepositRangeResponse
-	(*FetchPlayerDepositRequest)(nil),           // 57: wallet.FetchPlayerDepositRequest
-	(*TransactionEntity)(nil),                   // 58: wallet.TransactionEntity
-	(*PaymentMethodRequest)(nil),                // 59: wallet.PaymentMethodRequest
-	(*VerifyDepositRequest)(nil),                // 60: wallet.VerifyDepositRequest
-	(*VerifyDepositResponse)(nil),               // 61: wallet.VerifyDepositResponse
-	(*PaystackWebhookRequest)(nil),              // 62: wallet.PaystackWebhookRequest
-	(*MonnifyWebhookRequest)(nil),               // 63: wallet.MonnifyWebhookRequest
-	(*WebhookResponse)(nil),                     // 64: wallet.WebhookResponse
-	(*GetPaymentMethodRequest)(nil),             // 65: wallet.GetPaymentMethodRequest
-	(*GetPaymentMethodResponse)(nil),            // 66: wallet.GetPaymentMethodResponse
-	(*PaymentMethodResponse)(nil),               // 67: wallet.PaymentMethodResponse
-	(*PaymentMethod)(nil),                       // 68: wallet.PaymentMethod
-	(*CreateWalletRequest)(nil),                 // 69: wallet.CreateWalletRequest
-	(*WalletResponse)(nil),                      // 70: wallet.WalletResponse
-	(*GetBalanceRequest)(nil),                   // 71: wallet.GetBalanceRequest
-	(*CreditUserRequest)(nil),                   // 72: wallet.CreditUserRequest
-	(*DebitUserRequest)(nil),                    // 73: wallet.DebitUserRequest
-	(*Wallet)(nil),                              // 74: wallet.Wallet
-	(*InitiateDepositRequest)(nil),              // 75: wallet.InitiateDepositRequest
-	(*InitiateDepositResponse)(nil),             // 76: wallet.InitiateDepositResponse
-	(*Transaction)(nil),                         // 77: wallet.Transaction
-	(*SearchTransactionsRequest)(nil),           // 78: wallet.SearchTransactionsRequest
-	(*VerifyBankAccountRequest)(nil),            // 79: wallet.VerifyBankAccountRequest
-	(*VerifyBankAccountResponse)(nil),           // 80: wallet.VerifyBankAccountResponse
-	(*WithdrawRequest)(nil),                     // 81: wallet.WithdrawRequest
-	(*WithdrawResponse)(nil),                    // 82: wallet.WithdrawResponse
-	(*Withdraw)(nil),                            // 83: wallet.Withdraw
-	(*GetTransactionRequest)(nil),               // 84: wallet.GetTransactionRequest
-	(*GetTransactionResponse)(nil),              // 85: wallet.GetTransactionResponse
-	(*OpayWebhookRequest)(nil),                  // 86: wallet.OpayWebhookRequest
-	(*OpayWebhookResponse)(nil),                 // 87: wallet.OpayWebhookResponse
-	(*ListWithdrawalRequests)(nil),              // 88: wallet.ListWithdrawalRequests
-	(*ListWithdrawalRequestResponse)(nil),       // 89: wallet.ListWithdrawalRequestResponse
-	(*WithdrawalRequest)(nil),                   // 90: wallet.WithdrawalRequest
-	(*UserTransactionRequest)(nil),              // 91: wallet.UserTransactionRequest
-	(*UserTransactionResponse)(nil),             // 92: wallet.UserTransactionResponse
-	(*TransactionData)(nil),                     // 93: wallet.TransactionData
-	(*UpdateWithdrawalRequest)(nil),             // 94: wallet.UpdateWithdrawalRequest
-	(*CommonResponseObj)(nil),                   // 95: wallet.CommonResponseObj
-	(*CommonResponseArray)(nil),                 // 96: wallet.CommonResponseArray
-	(*PlayerWalletData)(nil),                    // 97: wallet.PlayerWalletData
-	(*ListDepositRequests)(nil),                 // 98: wallet.ListDepositRequests
-	(*PaginationResponse)(nil),                  // 99: wallet.PaginationResponse
-	(*MetaData)(nil),                            // 100: wallet.MetaData
-	(*GetUserAccountsResponse_BankAccount)(nil), // 101: wallet.GetUserAccountsResponse.BankAccount
-	(*FetchBetRangeResponse_Data)(nil),          // 102: wallet.FetchBetRangeResponse.Data
-	(*FetchDepositCountResponse_Data)(nil),      // 103: wallet.FetchDepositCountResponse.Data
-	(*FetchDepositRangeResponse_Data)(nil),      // 104: wallet.FetchDepositRangeResponse.Data
-	(*InitiateDepositResponse_Data)(nil),        // 105: wallet.InitiateDepositResponse.Data
-	(*OpayWebhookResponse_Data)(nil),            // 106: wallet.OpayWebhookResponse.Data
-	(*structpb.Struct)(nil),                     // 107: google.protobuf.Struct
+	(*TillSessionRequest)(nil),                  // 28: wallet.TillSessionRequest
+	(*WalletTransferRequest)(nil),               // 29: wallet.WalletTransferRequest
+	(*ValidateTransactionRequest)(nil),          // 30: wallet.ValidateTransactionRequest
+	(*EmptyRequest)(nil),                        // 31: wallet.EmptyRequest
+	(*ClientRequest)(nil),                       // 32: wallet.ClientRequest
+	(*BranchRequest)(nil),                       // 33: wallet.BranchRequest
+	(*CashbookIdRequest)(nil),                   // 34: wallet.CashbookIdRequest
+	(*IdRequest)(nil),                           // 35: wallet.IdRequest
+	(*DeletePlayerDataRequest)(nil),             // 36: wallet.DeletePlayerDataRequest
+	(*CashbookApproveExpenseRequest)(nil),       // 37: wallet.CashbookApproveExpenseRequest
+	(*CashbookCreateExpenseRequest)(nil),        // 38: wallet.CashbookCreateExpenseRequest
+	(*ExpenseSingleResponse)(nil),               // 39: wallet.ExpenseSingleResponse
+	(*ExpenseRepeatedResponse)(nil),             // 40: wallet.ExpenseRepeatedResponse
+	(*Expense)(nil),                             // 41: wallet.Expense
+	(*CashbookApproveCashInOutRequest)(nil),     // 42: wallet.CashbookApproveCashInOutRequest
+	(*CashbookCreateCashInOutRequest)(nil),      // 43: wallet.CashbookCreateCashInOutRequest
+	(*CashInOutSingleResponse)(nil),             // 44: wallet.CashInOutSingleResponse
+	(*CashInOutRepeatedResponse)(nil),           // 45: wallet.CashInOutRepeatedResponse
+	(*CashInOut)(nil),                           // 46: wallet.CashInOut
+	(*CashbookCreateExpenseTypeRequest)(nil),    // 47: wallet.CashbookCreateExpenseTypeRequest
+	(*ExpenseApproverRequest)(nil),              // 48: wallet.ExpenseApproverRequest
+	(*ExpenseBudgetRequest)(nil),                // 49: wallet.ExpenseBudgetRequest
+	(*ExpenseTypeSingleResponse)(nil),           // 50: wallet.ExpenseTypeSingleResponse
+	(*ExpenseTypeRepeatedResponse)(nil),         // 51: wallet.ExpenseTypeRepeatedResponse
+	(*ExpenseType)(nil),                         // 52: wallet.ExpenseType
+	(*GetUserAccountsResponse)(nil),             // 53: wallet.GetUserAccountsResponse
+	(*GetNetworkBalanceRequest)(nil),            // 54: wallet.GetNetworkBalanceRequest
+	(*GetNetworkBalanceResponse)(nil),           // 55: wallet.GetNetworkBalanceResponse
+	(*FetchBetRangeRequest)(nil),                // 56: wallet.FetchBetRangeRequest
+	(*FetchBetRangeResponse)(nil),               // 57: wallet.FetchBetRangeResponse
+	(*FetchDepositRangeRequest)(nil),            // 58: wallet.FetchDepositRangeRequest
+	(*FetchDepositCountRequest)(nil),            // 59: wallet.FetchDepositCountRequest
+	(*FetchDepositCountResponse)(nil),           // 60: wallet.FetchDepositCountResponse
+	(*FetchDepositRangeResponse)(nil),           // 61: wallet.FetchDepositRangeResponse
+	(*FetchPlayerDepositRequest)(nil),           // 62: wallet.FetchPlayerDepositRequest
+	(*TransactionEntity)(nil),                   // 63: wallet.TransactionEntity
+	(*PaymentMethodRequest)(nil),                // 64: wallet.PaymentMethodRequest
+	(*VerifyDepositRequest)(nil),                // 65: wallet.VerifyDepositRequest
+	(*VerifyDepositResponse)(nil),               // 66: wallet.VerifyDepositResponse
+	(*PaystackWebhookRequest)(nil),              // 67: wallet.PaystackWebhookRequest
+	(*MonnifyWebhookRequest)(nil),               // 68: wallet.MonnifyWebhookRequest
+	(*WebhookResponse)(nil),                     // 69: wallet.WebhookResponse
+	(*GetPaymentMethodRequest)(nil),             // 70: wallet.GetPaymentMethodRequest
+	(*GetPaymentMethodResponse)(nil),            // 71: wallet.GetPaymentMethodResponse
+	(*PaymentMethodResponse)(nil),               // 72: wallet.PaymentMethodResponse
+	(*PaymentMethod)(nil),                       // 73: wallet.PaymentMethod
+	(*CreateWalletRequest)(nil),                 // 74: wallet.CreateWalletRequest
+	(*WalletResponse)(nil),                      // 75: wallet.WalletResponse
+	(*GetBalanceRequest)(nil),                   // 76: wallet.GetBalanceRequest
+	(*CreditUserRequest)(nil),                   // 77: wallet.CreditUserRequest
+	(*DebitUserRequest)(nil),                    // 78: wallet.DebitUserRequest
+	(*Wallet)(nil),                              // 79: wallet.Wallet
+	(*SubWallet)(nil),                           // 80: wallet.SubWallet
+	(*ClientCurrencyRequest)(nil),               // 81: wallet.ClientCurrencyRequest
+	(*ExchangeRateRequest)(nil),                 // 82: wallet.ExchangeRateRequest
+	(*ExchangeRateImportRequest)(nil),           // 83: wallet.ExchangeRateImportRequest
+	(*WalletTypeRequest)(nil),                   // 84: wallet.WalletTypeRequest
+	(*InitiateDepositRequest)(nil),              // 85: wallet.InitiateDepositRequest
+	(*InitiateDepositResponse)(nil),             // 86: wallet.InitiateDepositResponse
+	(*Transaction)(nil),                         // 87: wallet.Transaction
+	(*SearchTransactionsRequest)(nil),           // 88: wallet.SearchTransactionsRequest
+	(*ListBanksRequest)(nil),                    // 89: wallet.ListBanksRequest
+	(*PlayerNameRequest)(nil),                   // 90: wallet.PlayerNameRequest
+	(*VerifyBankAccountRequest)(nil),            // 91: wallet.VerifyBankAccountRequest
+	(*VerifyBankAccountResponse)(nil),           // 92: wallet.VerifyBankAccountResponse
+	(*WithdrawRequest)(nil),                     // 93: wallet.WithdrawRequest
+	(*WithdrawResponse)(nil),                    // 94: wallet.WithdrawResponse
+	(*Withdraw)(nil),                            // 95: wallet.Withdraw
+	(*GetTransactionRequest)(nil),               // 96: wallet.GetTransactionRequest
+	(*GetTransactionResponse)(nil),              // 97: wallet.GetTransactionResponse
+	(*OpayWebhookRequest)(nil),                  // 98: wallet.OpayWebhookRequest
+	(*OpayWebhookResponse)(nil),                 // 99: wallet.OpayWebhookResponse
+	(*ListWithdrawalRequests)(nil),              // 100: wallet.ListWithdrawalRequests
+	(*ListWithdrawalRequestResponse)(nil),       // 101: wallet.ListWithdrawalRequestResponse
+	(*WithdrawalRequest)(nil),                   // 102: wallet.WithdrawalRequest
+	(*UserTransactionRequest)(nil),              // 103: wallet.UserTransactionRequest
+	(*UserTransactionResponse)(nil),             // 104: wallet.UserTransactionResponse
+	(*TransactionData)(nil),                     // 105: wallet.TransactionData
+	(*UpdateWithdrawalRequest)(nil),             // 106: wallet.UpdateWithdrawalRequest
+	(*CommonResponseObj)(nil),                   // 107: wallet.CommonResponseObj
+	(*CommonResponseArray)(nil),                 // 108: wallet.CommonResponseArray
+	(*PlayerWalletData)(nil),                    // 109: wallet.PlayerWalletData
+	(*ListDepositRequests)(nil),                 // 110: wallet.ListDepositRequests
+	(*PaginationResponse)(nil),                  // 111: wallet.PaginationResponse
+	(*MetaData)(nil),                            // 112: wallet.MetaData
+	(*GetUserAccountsResponse_BankAccount)(nil), // 113: wallet.GetUserAccountsResponse.BankAccount
+	(*FetchBetRangeResponse_Data)(nil),          // 114: wallet.FetchBetRangeResponse.Data
+	(*FetchDepositCountResponse_Data)(nil),      // 115: wallet.FetchDepositCountResponse.Data
+	(*FetchDepositRangeResponse_Data)(nil),      // 116: wallet.FetchDepositRangeResponse.Data
+	(*InitiateDepositResponse_Data)(nil),        // 117: wallet.InitiateDepositResponse.Data
+	(*OpayWebhookResponse_Data)(nil),            // 118: wallet.OpayWebhookResponse.Data
+	(*structpb.Struct)(nil),                     // 119: google.protobuf.Struct
 }
 var file_grpc_proto_wallet_proto_depIdxs = []int32{
 	22,  // 0: wallet.LastApprovedResponse.data:type_name -> wallet.LastApproved
 	22,  // 1: wallet.SalesReportResponseArray.data:type_name -> wallet.LastApproved
 	22,  // 2: wallet.LastApprovedResponseObj.data:type_name -> wallet.LastApproved
-	107, // 3: wallet.FetchReportResponse.data:type_name -> google.protobuf.Struct
-	38,  // 4: wallet.ExpenseSingleResponse.data:type_name -> wallet.Expense
-	38,  // 5: wallet.ExpenseRepeatedResponse.data:type_name -> wallet.Expense
-	43,  // 6: wallet.CashInOutSingleResponse.data:type_name -> wallet.CashInOut
-	43,  // 7: wallet.CashInOutRepeatedResponse.data:type_name -> wallet.CashInOut
-	47,  // 8: wallet.ExpenseTypeSingleResponse.data:type_name -> wallet.ExpenseType
-	47,  // 9: wallet.ExpenseTypeRepeatedResponse.data:type_name -> wallet.ExpenseType
-	101, // 10: wallet.GetUserAccountsResponse.data:type_name -> wallet.GetUserAccountsResponse.BankAccount
-	102, // 11: wallet.FetchBetRangeResponse.data:type_name -> wallet.FetchBetRangeResponse.Data
-	103, // 12: wallet.FetchDepositCountResponse.data:type_name -> wallet.FetchDepositCountResponse.Data
-	104, // 13: wallet.FetchDepositRangeResponse.data:type_name -> wallet.FetchDepositRangeResponse.Data
-	68,  // 14: wallet.GetPaymentMethodResponse.data:type_name -> wallet.PaymentMethod
-	68,  // 15: wallet.PaymentMethodResponse.data:type_name -> wallet.PaymentMethod
-	74,  // 16: wallet.WalletResponse.data:type_name -> wallet.Wallet
-	105, // 17: wallet.InitiateDepositResponse.data:type_name -> wallet.InitiateDepositResponse.Data
-	83,  // 18: wallet.WithdrawResponse.data:type_name -> wallet.Withdraw
-	107, // 19: wallet.GetTransactionResponse.data:type_name -> google.protobuf.Struct
-	106, // 20: wallet.OpayWebhookResponse.data:type_name -> wallet.OpayWebhookResponse.Data
-	90,  // 21: wallet.ListWithdrawalRequestResponse.data:type_name -> wallet.WithdrawalRequest
-	93,  // 22: wallet.UserTransactionResponse.data:type_name -> wallet.TransactionData
-	100, // 23: wallet.UserTransactionResponse.meta:type_name -> wallet.MetaData
-	107, // 24: wallet.CommonResponseObj.data:type_name -> google.protobuf.Struct
-	107, // 25: wallet.CommonResponseArray.data:type_name -> google.protobuf.Struct
-	107, // 26: wallet.PaginationResponse.data:type_name -> google.protobuf.Struct
-	17,  // 27: wallet.WalletService.CashbookVerifyFinalTransaction:input_type -> wallet.FetchLastApprovedRequest
-	17,  // 28: wallet.WalletService.CashbookFetchLastApproved:input_type -> wallet.FetchLastApprovedRequest
-	18,  // 29: wallet.WalletService.CashbookFetchSalesReport:input_type -> wallet.FetchSalesReportRequest
-	23,  // 30: wallet.WalletService.CashbookFetchReport:input_type -> wallet.FetchReportRequest
-	24,  // 31: wallet.WalletService.CashbookHandleReport:input_type -> wallet.HandleReportRequest
-	23,  // 32: wallet.WalletService.CashbookFetchMonthlyShopReport:input_type -> wallet.FetchReportRequest
-	23,  // 33: wallet.WalletService.CurrentReport:input_type -> wallet.FetchReportRequest
-	34,  // 34: wallet.WalletService.CashbookApproveExpense:input_type -> wallet.CashbookApproveExpenseRequest
-	35,  // 35: wallet.WalletService.CashbookCreateExpense:input_type -> wallet.CashbookCreateExpenseRequest
-	30,  // 36: wallet.WalletService.CashbookFindAllExpense:input_type -> wallet.EmptyRequest
-	32,  // 37: wallet.WalletService.CashbookFindOneExpense:input_type -> wallet.CashbookIdRequest
-	32,  // 38: wallet.WalletService.CashbookDeleteOneExpense:input_type -> wallet.CashbookIdRequest
-	35,  // 39: wallet.WalletService.CashbookUpdateOneExpense:input_type -> wallet.CashbookCreateExpenseRequest
-	31,  // 40: wallet.WalletService.CashbookFindAllBranchExpense:input_type -> wallet.BranchRequest
-	44,  // 41: wallet.WalletService.CashbookCreateExpenseType:input_type -> wallet.CashbookCreateExpenseTypeRequest
-	30,  // 42: wallet.WalletService.CashbookFindAllExpenseType:input_type -> wallet.EmptyRequest
-	39,  // 43: wallet.WalletService.CashbookApproveCashIn:input_type -> wallet.CashbookApproveCashInOutRequest
-	40,  // 44: wallet.WalletService.CashbookCreateCashIn:input_type -> wallet.CashbookCreateCashInOutRequest
-	40,  // 45: wallet.WalletService.CashbookUpdateCashIn:input_type -> wallet.CashbookCreateCashInOutRequest
-	32,  // 46: wallet.WalletService.CashbookDeleteOneCashIn:input_type -> wallet.CashbookIdRequest
-	32,  // 47: wallet.WalletService.CashbookFindOneCashIn:input_type -> wallet.CashbookIdRequest
-	30,  // 48: wallet.WalletService.CashbookFindAllCashIn:input_type -> wallet.EmptyRequest
-	31,  // 49: wallet.WalletService.CashbookFindAllBranchCashIn:input_type -> wallet.BranchRequest
-	31,  // 50: wallet.WalletService.FindAllBranchApprovedCashinWDate:input_type -> wallet.BranchRequest
-	31,  // 51: wallet.WalletService.FindAllBranchPendingCashinWDate:input_type -> wallet.BranchRequest
-	39,  // 52: wallet.WalletService.CashbookApproveCashOut:input_type -> wallet.CashbookApproveCashInOutRequest
-	40,  // 53: wallet.WalletService.CashbookCreateCashOut:input_type -> wallet.CashbookCreateCashInOutRequest
-	40,  // 54: wallet.WalletService.CashbookUpdateCashOut:input_type -> wallet.CashbookCreateCashInOutRequest
-	32,  // 55: wallet.WalletService.CashbookDeleteOneCashOut:input_type -> wallet.CashbookIdRequest
-	32,  // 56: wallet.WalletService.CashbookFindOneCashOut:input_type -> wallet.CashbookIdRequest
-	30,  // 57: wallet.WalletService.CashbookFindAllCashOut:input_type -> wallet.EmptyRequest
-	31,  // 58: wallet.WalletService.CashbookFindAllBranchCashOut:input_type -> wallet.BranchRequest
-	8,   // 59: wallet.WalletService.HandleCreatePawaPay:input_type -> wallet.CreatePawapayRequest
-	14,  // 60: wallet.WalletService.HandleCreateBulkPawaPay:input_type -> wallet.CreateBulkPawapayRequest
-	15,  // 61: wallet.WalletService.HandleFetchPawaPay:input_type -> wallet.FetchPawapayRequest
-	15,  // 62: wallet.WalletService.HandlePawaPayResendCallback:input_type -> wallet.FetchPawapayRequest
-	16,  // 63: wallet.WalletService.HandlePawaPayBalances:input_type -> wallet.PawapayCountryRequest
-	16,  // 64: wallet.WalletService.HandlePawaPayCountryBalances:input_type -> wallet.PawapayCountryRequest
-	7,   // 65: wallet.WalletService.HandlePawaPayPredCorr:input_type -> wallet.PawapayPredCorrRequest
-	6,   // 66: wallet.WalletService.HandlePawaPayToolkit:input_type -> wallet.PawapayToolkitRequest
-	16,  // 67: wallet.WalletService.HandlePawaPayActiveConf:input_type -> wallet.PawapayCountryRequest
-	10,  // 68: wallet.WalletService.CreateVirtualAccount:input_type -> wallet.WayaBankRequest
-	10,  // 69: wallet.WalletService.WayabankAccountEnquiry:input_type -> wallet.WayaBankRequest
-	11,  // 70: wallet.WalletService.StkDepositNotification:input_type -> wallet.StkTransactionRequest
-	11,  // 71: wallet.WalletService.StkWithdrawNotification:input_type -> wallet.StkTransactionRequest
-	11,  // 72: wallet.WalletService.StkStatusNotification:input_type -> wallet.StkTransactionRequest
-	12,  // 73: wallet.WalletService.StkRegisterUrl:input_type -> wallet.StkRegisterUrlRequest
-	13,  // 74: wallet.WalletService.HandleWayaQuickInit:input_type -> wallet.WayaQuickRequest
-	13,  // 75: wallet.WalletService.HandleWayaQuickVerify:input_type -> wallet.WayaQuickRequest
-	9,   // 76: wallet.WalletService.FetchUsersWithdrawal:input_type -> wallet.FetchUsersWithdrawalRequest
-	71,  // 77: wallet.WalletService.GetBalance:input_type -> wallet.GetBalanceRequest
-	69,  // 78: wallet.WalletService.CreateWallet:input_type -> wallet.CreateWalletRequest
-	51,  // 79: wallet.WalletService.FetchBetRange:input_type -> wallet.FetchBetRangeRequest
-	57,  // 80: wallet.WalletService.FetchPlayerDeposit:input_type -> wallet.FetchPlayerDepositRequest
-	53,  // 81: wallet.WalletService.FetchDepositRange:input_type -> wallet.FetchDepositRangeRequest
-	54,  // 82: wallet.WalletService.FetchDepositCount:input_type -> wallet.FetchDepositCountRequest
-	72,  // 83: wallet.WalletService.CreditUser:input_type -> wallet.CreditUserRequest
-	72,  // 84: wallet.WalletService.AwardBonusWinning:input_type -> wallet.CreditUserRequest
-	73,  // 85: wallet.WalletService.DebitUser:input_type -> wallet.DebitUserRequest
-	75,  // 86: wallet.WalletService.InititateDeposit:input_type -> wallet.InitiateDepositRequest
-	60,  // 87: wallet.WalletService.VerifyDeposit:input_type -> wallet.VerifyDepositRequest
-	81,  // 88: wallet.WalletService.RequestWithdrawal:input_type -> wallet.WithdrawRequest
-	79,  // 89: wallet.WalletService.VerifyBankAccount:input_type -> wallet.VerifyBankAccountRequest
-	30,  // 90: wallet.WalletService.ListBanks:input_type -> wallet.EmptyRequest
-	84,  // 91: wallet.WalletService.GetTransactions:input_type -> wallet.GetTransactionRequest
-	65,  // 92: wallet.WalletService.GetPaymentMethods:input_type -> wallet.GetPaymentMethodRequest
-	59,  // 93: wallet.WalletService.SavePaymentMethod:input_type -> wallet.PaymentMethodRequest
-	62,  // 94: wallet.WalletService.PaystackWebhook:input_type -> wallet.PaystackWebhookRequest
-	63,  // 95: wallet.WalletService.MonnifyWebhook:input_type -> wallet.MonnifyWebhookRequest
-	86,  // 96: wallet.WalletService.OpayDepositWebhook:input_type -> wallet.OpayWebhookRequest
-	86,  // 97: wallet.WalletService.OpayLookUpWebhook:input_type -> wallet.OpayWebhookRequest
-	88,  // 98: wallet.WalletService.ListWithdrawals:input_type -> wallet.ListWithdrawalRequests
-	98,  // 99: wallet.WalletService.ListDeposits:input_type -> wallet.ListDepositRequests
-	91,  // 100: wallet.WalletService.UserTransactions:input_type -> wallet.UserTransactionRequest
-	94,  // 101: wallet.WalletService.UpdateWithdrawal:input_type -> wallet.UpdateWithdrawalRequest
-	71,  // 102: wallet.WalletService.GetPlayerWalletData:input_type -> wallet.GetBalanceRequest
-	33,  // 103: wallet.WalletService.DeletePlayerData:input_type -> wallet.IdRequest
-	71,  // 104: wallet.WalletService.GetUserAccounts:input_type -> wallet.GetBalanceRequest
-	49,  // 105: wallet.WalletService.GetNetworkBalance:input_type -> wallet.GetNetworkBalanceRequest
-	26,  // 106: wallet.WalletService.GetMoneyTransaction:input_type -> wallet.GetTransactionsRequest
-	26,  // 107: wallet.WalletService.GetSystemTransaction:input_type -> wallet.GetTransactionsRequest
-	28,  // 108: wallet.WalletService.WalletTransfer:input_type -> wallet.WalletTransferRequest
-	29,  // 109: wallet.WalletService.ValidateDepositCode:input_type -> wallet.ValidateTransactionRequest
-	27,  // 110: wallet.WalletService.ProcessShopDeposit:input_type -> wallet.ProcessRetailTransaction
-	29,  // 111: wallet.WalletService.ValidateWithdrawalCode:input_type -> wallet.ValidateTransactionRequest
-	27,  // 112: wallet.WalletService.ProcessShopWithdrawal:input_type -> wallet.ProcessRetailTransaction
-	73,  // 113: wallet.WalletService.DebitAgentBalance:input_type -> wallet.DebitUserRequest
-	2,   // 114: wallet.WalletService.FlutterWaveWebhook:input_type -> wallet.FlutterwaveWebhookRequest
-	5,   // 115: wallet.WalletService.KorapayWebhook:input_type -> wallet.KoraPayWebhookRequest
-	3,   // 116: wallet.WalletService.TigoWebhook:input_type -> wallet.TigoWebhookRequest
-	0,   // 117: wallet.WalletService.PawapayCallback:input_type -> wallet.PawapayRequest
-	95,  // 118: wallet.WalletService.CashbookVerifyFinalTransaction:output_type -> wallet.CommonResponseObj
-	19,  // 119: wallet.WalletService.CashbookFetchLastApproved:output_type -> wallet.LastApprovedResponse
-	20,  // 120: wallet.WalletService.CashbookFetchSalesReport:output_type -> wallet.SalesReportResponseArray
-	25,  // 121: wallet.WalletService.CashbookFetchReport:output_type -> wallet.FetchReportResponse
-	21,  // 122: wallet.WalletService.CashbookHandleReport:output_type -> wallet.LastApprovedResponseObj
-	95,  // 123: wallet.WalletService.CashbookFetchMonthlyShopReport:output_type -> wallet.CommonResponseObj
-	95,  // 124: wallet.WalletService.CurrentReport:output_type -> wallet.CommonResponseObj
-	36,  // 125: wallet.WalletService.CashbookApproveExpense:output_type -> wallet.ExpenseSingleResponse
-	36,  // 126: wallet.WalletService.CashbookCreateExpense:output_type -> wallet.ExpenseSingleResponse
-	37,  // 127: wallet.WalletService.CashbookFindAllExpense:output_type -> wallet.ExpenseRepeatedResponse
-	36,  // 128: wallet.WalletService.CashbookFindOneExpense:output_type -> wallet.ExpenseSingleResponse
-	36,  // 129: wallet.WalletService.CashbookDeleteOneExpense:output_type -> wallet.ExpenseSingleResponse
-	36,  // 130: wallet.WalletService.CashbookUpdateOneExpense:output_type -> wallet.ExpenseSingleResponse
-	37,  // 131: wallet.WalletService.CashbookFindAllBranchExpense:output_type -> wallet.ExpenseRepeatedResponse
-	45,  // 132: wallet.WalletService.CashbookCreateExpenseType:output_type -> wallet.ExpenseTypeSingleResponse
-	46,  // 133: wallet.WalletService.CashbookFindAllExpenseType:output_type -> wallet.ExpenseTypeRepeatedResponse
-	41,  // 134: wallet.WalletService.CashbookApproveCashIn:output_type -> wallet.CashInOutSingleResponse
-	41,  // 135: wallet.WalletService.CashbookCreateCashIn:output_type -> wallet.CashInOutSingleResponse
-	41,  // 136: wallet.WalletService.CashbookUpdateCashIn:output_type -> wallet.CashInOutSingleResponse
-	41,  // 137: wallet.WalletService.CashbookDeleteOneCashIn:output_type -> wallet.CashInOutSingleResponse
-	41,  // 138: wallet.WalletService.CashbookFindOneCashIn:output_type -> wallet.CashInOutSingleResponse
-	42,  // 139: wallet.WalletService.CashbookFindAllCashIn:output_type -> wallet.CashInOutRepeatedResponse
-	42,  // 140: wallet.WalletService.CashbookFindAllBranchCashIn:output_type -> wallet.CashInOutRepeatedResponse
-	42,  // 141: wallet.WalletService.FindAllBranchApprovedCashinWDate:output_type -> wallet.CashInOutRepeatedResponse
-	42,  // 142: wallet.WalletService.FindAllBranchPendingCashinWDate:output_type -> wallet.CashInOutRepeatedResponse
-	41,  // 143: wallet.WalletService.CashbookApproveCashOut:output_type -> wallet.CashInOutSingleResponse
-	41,  // 144: wallet.WalletService.CashbookCreateCashOut:output_type -> wallet.CashInOutSingleResponse
-	41,  // 145: wallet.WalletService.CashbookUpdateCashOut:output_type -> wallet.CashInOutSingleResponse
-	41,  // 146: wallet.WalletService.CashbookDeleteOneCashOut:output_type -> wallet.CashInOutSingleResponse
-	41,  // 147: wallet.WalletService.CashbookFindOneCashOut:output_type -> wallet.CashInOutSingleResponse
-	42,  // 148: wallet.WalletService.CashbookFindAllCashOut:output_type -> wallet.CashInOutRepeatedResponse
-	42,  // 149: wallet.WalletService.CashbookFindAllBranchCashOut:output_type -> wallet.CashInOutRepeatedResponse
-	95,  // 150: wallet.WalletService.HandleCreatePawaPay:output_type -> wallet.CommonResponseObj
-	96,  // 151: wallet.WalletService.HandleCreateBulkPawaPay:output_type -> wallet.CommonResponseArray
-	96,  // 152: wallet.WalletService.HandleFetchPawaPay:output_type -> wallet.CommonResponseArray
-	95,  // 153: wallet.WalletService.HandlePawaPayResendCallback:output_type -> wallet.CommonResponseObj
-	96,  // 154: wallet.WalletService.HandlePawaPayBalances:output_type -> wallet.CommonResponseArray
-	96,  // 155: wallet.WalletService.HandlePawaPayCountryBalances:output_type -> wallet.CommonResponseArray
-	95,  // 156: wallet.WalletService.HandlePawaPayPredCorr:output_type -> wallet.CommonResponseObj
-	96,  // 157: wallet.WalletService.HandlePawaPayToolkit:output_type -> wallet.CommonResponseArray
-	95,  // 158: wallet.WalletService.HandlePawaPayActiveConf:output_type -> wallet.CommonResponseObj
-	95,  // 159: wallet.WalletService.CreateVirtualAccount:output_type -> wallet.CommonResponseObj
-	95,  // 160: wallet.WalletService.WayabankAccountEnquiry:output_type -> wallet.CommonResponseObj
-	95,  // 161: wallet.WalletService.StkDepositNotification:output_type -> wallet.CommonResponseObj
-	95,  // 162: wallet.WalletService.StkWithdrawNotification:output_type -> wallet.CommonResponseObj
-	95,  // 163: wallet.WalletService.StkStatusNotification:output_type -> wallet.CommonResponseObj
-	95,  // 164: wallet.WalletService.StkRegisterUrl:output_type -> wallet.CommonResponseObj
-	95,  // 165: wallet.WalletService.HandleWayaQuickInit:output_type -> wallet.CommonResponseObj
-	95,  // 166: wallet.WalletService.HandleWayaQuickVerify:output_type -> wallet.CommonResponseObj
-	96,  // 167: wallet.WalletService.FetchUsersWithdrawal:output_type -> wallet.CommonResponseArray
-	70,  // 168: wallet.WalletService.GetBalance:output_type -> wallet.WalletResponse
-	70,  // 169: wallet.WalletService.CreateWallet:output_type -> wallet.WalletResponse
-	52,  // 170: wallet.WalletService.FetchBetRange:output_type -> wallet.FetchBetRangeResponse
-	70,  // 171: wallet.WalletService.FetchPlayerDeposit:output_type -> wallet.WalletResponse
-	56,  // 172: wallet.WalletService.FetchDepositRange:output_type -> wallet.FetchDepositRangeResponse
-	55,  // 173: wallet.WalletService.FetchDepositCount:output_type -> wallet.FetchDepositCountResponse
-	70,  // 174: wallet.WalletService.CreditUser:output_type -> wallet.WalletResponse
-	70,  // 175: wallet.WalletService.AwardBonusWinning:output_type -> wallet.WalletResponse
-	70,  // 176: wallet.WalletService.DebitUser:output_type -> wallet.WalletResponse
-	76,  // 177: wallet.WalletService.InititateDeposit:output_type -> wallet.InitiateDepositResponse
-	61,  // 178: wallet.WalletService.VerifyDeposit:output_type -> wallet.VerifyDepositResponse
-	82,  // 179: wallet.WalletService.RequestWithdrawal:output_type -> wallet.WithdrawResponse
-	80,  // 180: wallet.WalletService.VerifyBankAccount:output_type -> wallet.VerifyBankAccountResponse
-	96,  // 181: wallet.WalletService.ListBanks:output_type -> wallet.CommonResponseArray
-	85,  // 182: wallet.WalletService.GetTransactions:output_type -> wallet.GetTransactionResponse
-	66,  // 183: wallet.WalletService.GetPaymentMethods:output_type -> wallet.GetPaymentMethodResponse
-	67,  // 184: wallet.WalletService.SavePaymentMethod:output_type -> wallet.PaymentMethodResponse
-	64,  // 185: wallet.WalletService.PaystackWebhook:output_type -> wallet.WebhookResponse
-	64,  // 186: wallet.WalletService.MonnifyWebhook:output_type -> wallet.WebhookResponse
-	87,  // 187: wallet.WalletService.OpayDepositWebhook:output_type -> wallet.OpayWebhookResponse
-	87,  // 188: wallet.WalletService.OpayLookUpWebhook:output_type -> wallet.OpayWebhookResponse
-	89,  // 189: wallet.WalletService.ListWithdrawals:output_type -> wallet.ListWithdrawalRequestResponse
-	99,  // 190: wallet.WalletService.ListDeposits:output_type -> wallet.PaginationResponse
-	92,  // 191: wallet.WalletService.UserTransactions:output_type -> wallet.UserTransactionResponse
-	95,  // 192: wallet.WalletService.UpdateWithdrawal:output_type -> wallet.CommonResponseObj
-	97,  // 193: wallet.WalletService.GetPlayerWalletData:output_type -> wallet.PlayerWalletData
-	95,  // 194: wallet.WalletService.DeletePlayerData:output_type -> wallet.CommonResponseObj
-	48,  // 195: wallet.WalletService.GetUserAccounts:output_type -> wallet.GetUserAccountsResponse
-	50,  // 196: wallet.WalletService.GetNetworkBalance:output_type -> wallet.GetNetworkBalanceResponse
-	95,  // 197: wallet.WalletService.GetMoneyTransaction:output_type -> wallet.CommonResponseObj
-	95,  // 198: wallet.WalletService.GetSystemTransaction:output_type -> wallet.CommonResponseObj
-	95,  // 199: wallet.WalletService.WalletTransfer:output_type -> wallet.CommonResponseObj
-	95,  // 200: wallet.WalletService.ValidateDepositCode:output_type -> wallet.CommonResponseObj
-	95,  // 201: wallet.WalletService.ProcessShopDeposit:output_type -> wallet.CommonResponseObj
-	95,  // 202: wallet.WalletService.ValidateWithdrawalCode:output_type -> wallet.CommonResponseObj
-	95,  // 203: wallet.WalletService.ProcessShopWithdrawal:output_type -> wallet.CommonResponseObj
-	95,  // 204: wallet.WalletService.DebitAgentBalance:output_type -> wallet.CommonResponseObj
-	64,  // 205: wallet.WalletService.FlutterWaveWebhook:output_type -> wallet.WebhookResponse
-	64,  // 206: wallet.WalletService.KorapayWebhook:output_type -> wallet.WebhookResponse
-	4,   // 207: wallet.WalletService.TigoWebhook:output_type -> wallet.TigoResponse
-	1,   // 208: wallet.WalletService.PawapayCallback:output_type -> wallet.PawapayResponse
-	118, // [118:209] is the sub-list for method output_type
-	27,  // [27:118] is the sub-list for method input_type
-	27,  // [27:27] is the sub-list for extension type_name
-	27,  // [27:27] is the sub-list for extension extendee
-	0,   // [0:27] is the sub-list for field type_name
+	119, // 3: wallet.FetchReportResponse.data:type_name -> google.protobuf.Struct
+	41,  // 4: wallet.ExpenseSingleResponse.data:type_name -> wallet.Expense
+	41,  // 5: wallet.ExpenseRepeatedResponse.data:type_name -> wallet.Expense
+	46,  // 6: wallet.CashInOutSingleResponse.data:type_name -> wallet.CashInOut
+	46,  // 7: wallet.CashInOutRepeatedResponse.data:type_name -> wallet.CashInOut
+	52,  // 8: wallet.ExpenseTypeSingleResponse.data:type_name -> wallet.ExpenseType
+	52,  // 9: wallet.ExpenseTypeRepeatedResponse.data:type_name -> wallet.ExpenseType
+	113, // 10: wallet.GetUserAccountsResponse.data:type_name -> wallet.GetUserAccountsResponse.BankAccount
+	114, // 11: wallet.FetchBetRangeResponse.data:type_name -> wallet.FetchBetRangeResponse.Data
+	115, // 12: wallet.FetchDepositCountResponse.data:type_name -> wallet.FetchDepositCountResponse.Data
+	116, // 13: wallet.FetchDepositRangeResponse.data:type_name -> wallet.FetchDepositRangeResponse.Data
+	73,  // 14: wallet.GetPaymentMethodResponse.data:type_name -> wallet.PaymentMethod
+	73,  // 15: wallet.PaymentMethodResponse.data:type_name -> wallet.PaymentMethod
+	79,  // 16: wallet.WalletResponse.data:type_name -> wallet.Wallet
+	80,  // 17: wallet.Wallet.wallets:type_name -> wallet.SubWallet
+	79,  // 18: wallet.Wallet.currencies:type_name -> wallet.Wallet
+	117, // 19: wallet.InitiateDepositResponse.data:type_name -> wallet.InitiateDepositResponse.Data
+	95,  // 20: wallet.WithdrawResponse.data:type_name -> wallet.Withdraw
+	119, // 21: wallet.GetTransactionResponse.data:type_name -> google.protobuf.Struct
+	118, // 22: wallet.OpayWebhookResponse.data:type_name -> wallet.OpayWebhookResponse.Data
+	102, // 23: wallet.ListWithdrawalRequestResponse.data:type_name -> wallet.WithdrawalRequest
+	105, // 24: wallet.UserTransactionResponse.data:type_name -> wallet.TransactionData
+	112, // 25: wallet.UserTransactionResponse.meta:type_name -> wallet.MetaData
+	119, // 26: wallet.CommonResponseObj.data:type_name -> google.protobuf.Struct
+	119, // 27: wallet.CommonResponseArray.data:type_name -> google.protobuf.Struct
+	119, // 28: wallet.PaginationResponse.data:type_name -> google.protobuf.Struct
+	17,  // 29: wallet.WalletService.CashbookVerifyFinalTransaction:input_type -> wallet.FetchLastApprovedRequest
+	17,  // 30: wallet.WalletService.CashbookFetchLastApproved:input_type -> wallet.FetchLastApprovedRequest
+	18,  // 31: wallet.WalletService.CashbookFetchSalesReport:input_type -> wallet.FetchSalesReportRequest
+	23,  // 32: wallet.WalletService.CashbookFetchReport:input_type -> wallet.FetchReportRequest
+	24,  // 33: wallet.WalletService.CashbookHandleReport:input_type -> wallet.HandleReportRequest
+	23,  // 34: wallet.WalletService.CashbookFetchMonthlyShopReport:input_type -> wallet.FetchReportRequest
+	23,  // 35: wallet.WalletService.CurrentReport:input_type -> wallet.FetchReportRequest
+	37,  // 36: wallet.WalletService.CashbookApproveExpense:input_type -> wallet.CashbookApproveExpenseRequest
+	38,  // 37: wallet.WalletService.CashbookCreateExpense:input_type -> wallet.CashbookCreateExpenseRequest
+	32,  // 38: wallet.WalletService.CashbookFindAllExpense:input_type -> wallet.ClientRequest
+	34,  // 39: wallet.WalletService.CashbookFindOneExpense:input_type -> wallet.CashbookIdRequest
+	34,  // 40: wallet.WalletService.CashbookDeleteOneExpense:input_type -> wallet.CashbookIdRequest
+	38,  // 41: wallet.WalletService.CashbookUpdateOneExpense:input_type -> wallet.CashbookCreateExpenseRequest
+	33,  // 42: wallet.WalletService.CashbookFindAllBranchExpense:input_type -> wallet.BranchRequest
+	47,  // 43: wallet.WalletService.CashbookCreateExpenseType:input_type -> wallet.CashbookCreateExpenseTypeRequest
+	32,  // 44: wallet.WalletService.CashbookFindAllExpenseType:input_type -> wallet.ClientRequest
+	47,  // 45: wallet.WalletService.CashbookUpdateExpenseType:input_type -> wallet.CashbookCreateExpenseTypeRequest
+	34,  // 46: wallet.WalletService.CashbookDeleteExpenseType:input_type -> wallet.CashbookIdRequest
+	33,  // 47: wallet.WalletService.CashbookFindAllClientExpenseType:input_type -> wallet.BranchRequest
+	49,  // 48: wallet.WalletService.CashbookSetExpenseBudget:input_type -> wallet.ExpenseBudgetRequest
+	48,  // 49: wallet.WalletService.CashbookSetExpenseApprover:input_type -> wallet.ExpenseApproverRequest
+	42,  // 50: wallet.WalletService.CashbookApproveCashIn:input_type -> wallet.CashbookApproveCashInOutRequest
+	43,  // 51: wallet.WalletService.CashbookCreateCashIn:input_type -> wallet.CashbookCreateCashInOutRequest
+	43,  // 52: wallet.WalletService.CashbookUpdateCashIn:input_type -> wallet.CashbookCreateCashInOutRequest
+	34,  // 53: wallet.WalletService.CashbookDeleteOneCashIn:input_type -> wallet.CashbookIdRequest
+	34,  // 54: wallet.WalletService.CashbookFindOneCashIn:input_type -> wallet.CashbookIdRequest
+	31,  // 55: wallet.WalletService.CashbookFindAllCashIn:input_type -> wallet.EmptyRequest
+	33,  // 56: wallet.WalletService.CashbookFindAllBranchCashIn:input_type -> wallet.BranchRequest
+	33,  // 57: wallet.WalletService.FindAllBranchApprovedCashinWDate:input_type -> wallet.BranchRequest
+	33,  // 58: wallet.WalletService.FindAllBranchPendingCashinWDate:input_type -> wallet.BranchRequest
+	42,  // 59: wallet.WalletService.CashbookApproveCashOut:input_type -> wallet.CashbookApproveCashInOutRequest
+	43,  // 60: wallet.WalletService.CashbookCreateCashOut:input_type -> wallet.CashbookCreateCashInOutRequest
+	43,  // 61: wallet.WalletService.CashbookUpdateCashOut:input_type -> wallet.CashbookCreateCashInOutRequest
+	34,  // 62: wallet.WalletService.CashbookDeleteOneCashOut:input_type -> wallet.CashbookIdRequest
+	34,  // 63: wallet.WalletService.CashbookFindOneCashOut:input_type -> wallet.CashbookIdRequest
+	31,  // 64: wallet.WalletService.CashbookFindAllCashOut:input_type -> wallet.EmptyRequest
+	33,  // 65: wallet.WalletService.CashbookFindAllBranchCashOut:input_type -> wallet.BranchRequest
+	8,   // 66: wallet.WalletService.HandleCreatePawaPay:input_type -> wallet.CreatePawapayRequest
+	14,  // 67: wallet.WalletService.HandleCreateBulkPawaPay:input_type -> wallet.CreateBulkPawapayRequest
+	15,  // 68: wallet.WalletService.HandleFetchPawaPay:input_type -> wallet.FetchPawapayRequest
+	15,  // 69: wallet.WalletService.HandlePawaPayResendCallback:input_type -> wallet.FetchPawapayRequest
+	16,  // 70: wallet.WalletService.HandlePawaPayBalances:input_type -> wallet.PawapayCountryRequest
+	16,  // 71: wallet.WalletService.HandlePawaPayCountryBalances:input_type -> wallet.PawapayCountryRequest
+	7,   // 72: wallet.WalletService.HandlePawaPayPredCorr:input_type -> wallet.PawapayPredCorrRequest
+	6,   // 73: wallet.WalletService.HandlePawaPayToolkit:input_type -> wallet.PawapayToolkitRequest
+	16,  // 74: wallet.WalletService.HandlePawaPayActiveConf:input_type -> wallet.PawapayCountryRequest
+	10,  // 75: wallet.WalletService.CreateVirtualAccount:input_type -> wallet.WayaBankRequest
+	10,  // 76: wallet.WalletService.WayabankAccountEnquiry:input_type -> wallet.WayaBankRequest
+	11,  // 77: wallet.WalletService.StkDepositNotification:input_type -> wallet.StkTransactionRequest
+	11,  // 78: wallet.WalletService.StkWithdrawNotification:input_type -> wallet.StkTransactionRequest
+	11,  // 79: wallet.WalletService.StkStatusNotification:input_type -> wallet.StkTransactionRequest
+	12,  // 80: wallet.WalletService.StkRegisterUrl:input_type -> wallet.StkRegisterUrlRequest
+	13,  // 81: wallet.WalletService.HandleWayaQuickInit:input_type -> wallet.WayaQuickRequest
+	13,  // 82: wallet.WalletService.HandleWayaQuickVerify:input_type -> wallet.WayaQuickRequest
+	9,   // 83: wallet.WalletService.FetchUsersWithdrawal:input_type -> wallet.FetchUsersWithdrawalRequest
+	76,  // 84: wallet.WalletService.GetBalance:input_type -> wallet.GetBalanceRequest
+	84,  // 85: wallet.WalletService.SaveWalletType:input_type -> wallet.WalletTypeRequest
+	84,  // 86: wallet.WalletService.GetWalletTypes:input_type -> wallet.WalletTypeRequest
+	81,  // 87: wallet.WalletService.SaveClientCurrency:input_type -> wallet.ClientCurrencyRequest
+	81,  // 88: wallet.WalletService.GetClientCurrencies:input_type -> wallet.ClientCurrencyRequest
+	82,  // 89: wallet.WalletService.SaveExchangeRate:input_type -> wallet.ExchangeRateRequest
+	83,  // 90: wallet.WalletService.ImportExchangeRates:input_type -> wallet.ExchangeRateImportRequest
+	82,  // 91: wallet.WalletService.GetExchangeRates:input_type -> wallet.ExchangeRateRequest
+	74,  // 92: wallet.WalletService.CreateWallet:input_type -> wallet.CreateWalletRequest
+	56,  // 93: wallet.WalletService.FetchBetRange:input_type -> wallet.FetchBetRangeRequest
+	62,  // 94: wallet.WalletService.FetchPlayerDeposit:input_type -> wallet.FetchPlayerDepositRequest
+	58,  // 95: wallet.WalletService.FetchDepositRange:input_type -> wallet.FetchDepositRangeRequest
+	59,  // 96: wallet.WalletService.FetchDepositCount:input_type -> wallet.FetchDepositCountRequest
+	77,  // 97: wallet.WalletService.CreditUser:input_type -> wallet.CreditUserRequest
+	77,  // 98: wallet.WalletService.AwardBonusWinning:input_type -> wallet.CreditUserRequest
+	78,  // 99: wallet.WalletService.DebitUser:input_type -> wallet.DebitUserRequest
+	85,  // 100: wallet.WalletService.InititateDeposit:input_type -> wallet.InitiateDepositRequest
+	65,  // 101: wallet.WalletService.VerifyDeposit:input_type -> wallet.VerifyDepositRequest
+	93,  // 102: wallet.WalletService.RequestWithdrawal:input_type -> wallet.WithdrawRequest
+	91,  // 103: wallet.WalletService.VerifyBankAccount:input_type -> wallet.VerifyBankAccountRequest
+	89,  // 104: wallet.WalletService.ListBanks:input_type -> wallet.ListBanksRequest
+	90,  // 105: wallet.WalletService.SavePlayerName:input_type -> wallet.PlayerNameRequest
+	96,  // 106: wallet.WalletService.GetTransactions:input_type -> wallet.GetTransactionRequest
+	70,  // 107: wallet.WalletService.GetPaymentMethods:input_type -> wallet.GetPaymentMethodRequest
+	64,  // 108: wallet.WalletService.SavePaymentMethod:input_type -> wallet.PaymentMethodRequest
+	64,  // 109: wallet.WalletService.DeletePaymentMethod:input_type -> wallet.PaymentMethodRequest
+	67,  // 110: wallet.WalletService.PaystackWebhook:input_type -> wallet.PaystackWebhookRequest
+	68,  // 111: wallet.WalletService.MonnifyWebhook:input_type -> wallet.MonnifyWebhookRequest
+	98,  // 112: wallet.WalletService.OpayDepositWebhook:input_type -> wallet.OpayWebhookRequest
+	98,  // 113: wallet.WalletService.OpayLookUpWebhook:input_type -> wallet.OpayWebhookRequest
+	100, // 114: wallet.WalletService.ListWithdrawals:input_type -> wallet.ListWithdrawalRequests
+	110, // 115: wallet.WalletService.ListDeposits:input_type -> wallet.ListDepositRequests
+	103, // 116: wallet.WalletService.UserTransactions:input_type -> wallet.UserTransactionRequest
+	106, // 117: wallet.WalletService.UpdateWithdrawal:input_type -> wallet.UpdateWithdrawalRequest
+	76,  // 118: wallet.WalletService.GetPlayerWalletData:input_type -> wallet.GetBalanceRequest
+	36,  // 119: wallet.WalletService.DeletePlayerData:input_type -> wallet.DeletePlayerDataRequest
+	76,  // 120: wallet.WalletService.GetUserAccounts:input_type -> wallet.GetBalanceRequest
+	54,  // 121: wallet.WalletService.GetNetworkBalance:input_type -> wallet.GetNetworkBalanceRequest
+	26,  // 122: wallet.WalletService.GetMoneyTransaction:input_type -> wallet.GetTransactionsRequest
+	26,  // 123: wallet.WalletService.GetSystemTransaction:input_type -> wallet.GetTransactionsRequest
+	29,  // 124: wallet.WalletService.WalletTransfer:input_type -> wallet.WalletTransferRequest
+	30,  // 125: wallet.WalletService.ValidateDepositCode:input_type -> wallet.ValidateTransactionRequest
+	27,  // 126: wallet.WalletService.ProcessShopDeposit:input_type -> wallet.ProcessRetailTransaction
+	30,  // 127: wallet.WalletService.ValidateWithdrawalCode:input_type -> wallet.ValidateTransactionRequest
+	27,  // 128: wallet.WalletService.ProcessShopWithdrawal:input_type -> wallet.ProcessRetailTransaction
+	78,  // 129: wallet.WalletService.DebitAgentBalance:input_type -> wallet.DebitUserRequest
+	28,  // 130: wallet.WalletService.OpenTillSession:input_type -> wallet.TillSessionRequest
+	28,  // 131: wallet.WalletService.CloseTillSession:input_type -> wallet.TillSessionRequest
+	28,  // 132: wallet.WalletService.HandoverTillSession:input_type -> wallet.TillSessionRequest
+	28,  // 133: wallet.WalletService.GetTillSession:input_type -> wallet.TillSessionRequest
+	33,  // 134: wallet.WalletService.ListTillSessions:input_type -> wallet.BranchRequest
+	2,   // 135: wallet.WalletService.FlutterWaveWebhook:input_type -> wallet.FlutterwaveWebhookRequest
+	5,   // 136: wallet.WalletService.KorapayWebhook:input_type -> wallet.KoraPayWebhookRequest
+	3,   // 137: wallet.WalletService.TigoWebhook:input_type -> wallet.TigoWebhookRequest
+	0,   // 138: wallet.WalletService.PawapayCallback:input_type -> wallet.PawapayRequest
+	107, // 139: wallet.WalletService.CashbookVerifyFinalTransaction:output_type -> wallet.CommonResponseObj
+	19,  // 140: wallet.WalletService.CashbookFetchLastApproved:output_type -> wallet.LastApprovedResponse
+	20,  // 141: wallet.WalletService.CashbookFetchSalesReport:output_type -> wallet.SalesReportResponseArray
+	25,  // 142: wallet.WalletService.CashbookFetchReport:output_type -> wallet.FetchReportResponse
+	21,  // 143: wallet.WalletService.CashbookHandleReport:output_type -> wallet.LastApprovedResponseObj
+	107, // 144: wallet.WalletService.CashbookFetchMonthlyShopReport:output_type -> wallet.CommonResponseObj
+	107, // 145: wallet.WalletService.CurrentReport:output_type -> wallet.CommonResponseObj
+	39,  // 146: wallet.WalletService.CashbookApproveExpense:output_type -> wallet.ExpenseSingleResponse
+	39,  // 147: wallet.WalletService.CashbookCreateExpense:output_type -> wallet.ExpenseSingleResponse
+	40,  // 148: wallet.WalletService.CashbookFindAllExpense:output_type -> wallet.ExpenseRepeatedResponse
+	39,  // 149: wallet.WalletService.CashbookFindOneExpense:output_type -> wallet.ExpenseSingleResponse
+	39,  // 150: wallet.WalletService.CashbookDeleteOneExpense:output_type -> wallet.ExpenseSingleResponse
+	39,  // 151: wallet.WalletService.CashbookUpdateOneExpense:output_type -> wallet.ExpenseSingleResponse
+	40,  // 152: wallet.WalletService.CashbookFindAllBranchExpense:output_type -> wallet.ExpenseRepeatedResponse
+	50,  // 153: wallet.WalletService.CashbookCreateExpenseType:output_type -> wallet.ExpenseTypeSingleResponse
+	51,  // 154: wallet.WalletService.CashbookFindAllExpenseType:output_type -> wallet.ExpenseTypeRepeatedResponse
+	50,  // 155: wallet.WalletService.CashbookUpdateExpenseType:output_type -> wallet.ExpenseTypeSingleResponse
+	50,  // 156: wallet.WalletService.CashbookDeleteExpenseType:output_type -> wallet.ExpenseTypeSingleResponse
+	51,  // 157: wallet.WalletService.CashbookFindAllClientExpenseType:output_type -> wallet.ExpenseTypeRepeatedResponse
+	107, // 158: wallet.WalletService.CashbookSetExpenseBudget:output_type -> wallet.CommonResponseObj
+	107, // 159: wallet.WalletService.CashbookSetExpenseApprover:output_type -> wallet.CommonResponseObj
+	44,  // 160: wallet.WalletService.CashbookApproveCashIn:output_type -> wallet.CashInOutSingleResponse
+	44,  // 161: wallet.WalletService.CashbookCreateCashIn:output_type -> wallet.CashInOutSingleResponse
+	44,  // 162: wallet.WalletService.CashbookUpdateCashIn:output_type -> wallet.CashInOutSingleResponse
+	44,  // 163: wallet.WalletService.CashbookDeleteOneCashIn:output_type -> wallet.CashInOutSingleResponse
+	44,  // 164: wallet.WalletService.CashbookFindOneCashIn:output_type -> wallet.CashInOutSingleResponse
+	45,  // 165: wallet.WalletService.CashbookFindAllCashIn:output_type -> wallet.CashInOutRepeatedResponse
+	45,  // 166: wallet.WalletService.CashbookFindAllBranchCashIn:output_type -> wallet.CashInOutRepeatedResponse
+	45,  // 167: wallet.WalletService.FindAllBranchApprovedCashinWDate:output_type -> wallet.CashInOutRepeatedResponse
+	45,  // 168: wallet.WalletService.FindAllBranchPendingCashinWDate:output_type -> wallet.CashInOutRepeatedResponse
+	44,  // 169: wallet.WalletService.CashbookApproveCashOut:output_type -> wallet.CashInOutSingleResponse
+	44,  // 170: wallet.WalletService.CashbookCreateCashOut:output_type -> wallet.CashInOutSingleResponse
+	44,  // 171: wallet.WalletService.CashbookUpdateCashOut:output_type -> wallet.CashInOutSingleResponse
+	44,  // 172: wallet.WalletService.CashbookDeleteOneCashOut:output_type -> wallet.CashInOutSingleResponse
+	44,  // 173: wallet.WalletService.CashbookFindOneCashOut:output_type -> wallet.CashInOutSingleResponse
+	45,  // 174: wallet.WalletService.CashbookFindAllCashOut:output_type -> wallet.CashInOutRepeatedResponse
+	45,  // 175: wallet.WalletService.CashbookFindAllBranchCashOut:output_type -> wallet.CashInOutRepeatedResponse
+	107, // 176: wallet.WalletService.HandleCreatePawaPay:output_type -> wallet.CommonResponseObj
+	108, // 177: wallet.WalletService.HandleCreateBulkPawaPay:output_type -> wallet.CommonResponseArray
+	108, // 178: wallet.WalletService.HandleFetchPawaPay:output_type -> wallet.CommonResponseArray
+	107, // 179: wallet.WalletService.HandlePawaPayResendCallback:output_type -> wallet.CommonResponseObj
+	108, // 180: wallet.WalletService.HandlePawaPayBalances:output_type -> wallet.CommonResponseArray
+	108, // 181: wallet.WalletService.HandlePawaPayCountryBalances:output_type -> wallet.CommonResponseArray
+	107, // 182: wallet.WalletService.HandlePawaPayPredCorr:output_type -> wallet.CommonResponseObj
+	108, // 183: wallet.WalletService.HandlePawaPayToolkit:output_type -> wallet.CommonResponseArray
+	107, // 184: wallet.WalletService.HandlePawaPayActiveConf:output_type -> wallet.CommonResponseObj
+	107, // 185: wallet.WalletService.CreateVirtualAccount:output_type -> wallet.CommonResponseObj
+	107, // 186: wallet.WalletService.WayabankAccountEnquiry:output_type -> wallet.CommonResponseObj
+	107, // 187: wallet.WalletService.StkDepositNotification:output_type -> wallet.CommonResponseObj
+	107, // 188: wallet.WalletService.StkWithdrawNotification:output_type -> wallet.CommonResponseObj
+	107, // 189: wallet.WalletService.StkStatusNotification:output_type -> wallet.CommonResponseObj
+	107, // 190: wallet.WalletService.StkRegisterUrl:output_type -> wallet.CommonResponseObj
+	107, // 191: wallet.WalletService.HandleWayaQuickInit:output_type -> wallet.CommonResponseObj
+	107, // 192: wallet.WalletService.HandleWayaQuickVerify:output_type -> wallet.CommonResponseObj
+	108, // 193: wallet.WalletService.FetchUsersWithdrawal:output_type -> wallet.CommonResponseArray
+	75,  // 194: wallet.WalletService.GetBalance:output_type -> wallet.WalletResponse
+	107, // 195: wallet.WalletService.SaveWalletType:output_type -> wallet.CommonResponseObj
+	108, // 196: wallet.WalletService.GetWalletTypes:output_type -> wallet.CommonResponseArray
+	107, // 197: wallet.WalletService.SaveClientCurrency:output_type -> wallet.CommonResponseObj
+	108, // 198: wallet.WalletService.GetClientCurrencies:output_type -> wallet.CommonResponseArray
+	107, // 199: wallet.WalletService.SaveExchangeRate:output_type -> wallet.CommonResponseObj
+	107, // 200: wallet.WalletService.ImportExchangeRates:output_type -> wallet.CommonResponseObj
+	108, // 201: wallet.WalletService.GetExchangeRates:output_type -> wallet.CommonResponseArray
+	75,  // 202: wallet.WalletService.CreateWallet:output_type -> wallet.WalletResponse
+	57,  // 203: wallet.WalletService.FetchBetRange:output_type -> wallet.FetchBetRangeResponse
+	75,  // 204: wallet.WalletService.FetchPlayerDeposit:output_type -> wallet.WalletResponse
+	61,  // 205: wallet.WalletService.FetchDepositRange:output_type -> wallet.FetchDepositRangeResponse
+	60,  // 206: wallet.WalletService.FetchDepositCount:output_type -> wallet.FetchDepositCountResponse
+	75,  // 207: wallet.WalletService.CreditUser:output_type -> wallet.WalletResponse
+	75,  // 208: wallet.WalletService.AwardBonusWinning:output_type -> wallet.WalletResponse
+	75,  // 209: wallet.WalletService.DebitUser:output_type -> wallet.WalletResponse
+	86,  // 210: wallet.WalletService.InititateDeposit:output_type -> wallet.InitiateDepositResponse
+	66,  // 211: wallet.WalletService.VerifyDeposit:output_type -> wallet.VerifyDepositResponse
+	94,  // 212: wallet.WalletService.RequestWithdrawal:output_type -> wallet.WithdrawResponse
+	92,  // 213: wallet.WalletService.VerifyBankAccount:output_type -> wallet.VerifyBankAccountResponse
+	108, // 214: wallet.WalletService.ListBanks:output_type -> wallet.CommonResponseArray
+	107, // 215: wallet.WalletService.SavePlayerName:output_type -> wallet.CommonResponseObj
+	97,  // 216: wallet.WalletService.GetTransactions:output_type -> wallet.GetTransactionResponse
+	71,  // 217: wallet.WalletService.GetPaymentMethods:output_type -> wallet.GetPaymentMethodResponse
+	72,  // 218: wallet.WalletService.SavePaymentMethod:output_type -> wallet.PaymentMethodResponse
+	72,  // 219: wallet.WalletService.DeletePaymentMethod:output_type -> wallet.PaymentMethodResponse
+	69,  // 220: wallet.WalletService.PaystackWebhook:output_type -> wallet.WebhookResponse
+	69,  // 221: wallet.WalletService.MonnifyWebhook:output_type -> wallet.WebhookResponse
+	99,  // 222: wallet.WalletService.OpayDepositWebhook:output_type -> wallet.OpayWebhookResponse
+	99,  // 223: wallet.WalletService.OpayLookUpWebhook:output_type -> wallet.OpayWebhookResponse
+	101, // 224: wallet.WalletService.ListWithdrawals:output_type -> wallet.ListWithdrawalRequestResponse
+	111, // 225: wallet.WalletService.ListDeposits:output_type -> wallet.PaginationResponse
+	104, // 226: wallet.WalletService.UserTransactions:output_type -> wallet.UserTransactionResponse
+	107, // 227: wallet.WalletService.UpdateWithdrawal:output_type -> wallet.CommonResponseObj
+	109, // 228: wallet.WalletService.GetPlayerWalletData:output_type -> wallet.PlayerWalletData
+	107, // 229: wallet.WalletService.DeletePlayerData:output_type -> wallet.CommonResponseObj
+	53,  // 230: wallet.WalletService.GetUserAccounts:output_type -> wallet.GetUserAccountsResponse
+	55,  // 231: wallet.WalletService.GetNetworkBalance:output_type -> wallet.GetNetworkBalanceResponse
+	107, // 232: wallet.WalletService.GetMoneyTransaction:output_type -> wallet.CommonResponseObj
+	107, // 233: wallet.WalletService.GetSystemTransaction:output_type -> wallet.CommonResponseObj
+	107, // 234: wallet.WalletService.WalletTransfer:output_type -> wallet.CommonResponseObj
+	107, // 235: wallet.WalletService.ValidateDepositCode:output_type -> wallet.CommonResponseObj
+	107, // 236: wallet.WalletService.ProcessShopDeposit:output_type -> wallet.CommonResponseObj
+	107, // 237: wallet.WalletService.ValidateWithdrawalCode:output_type -> wallet.CommonResponseObj
+	107, // 238: wallet.WalletService.ProcessShopWithdrawal:output_type -> wallet.CommonResponseObj
+	107, // 239: wallet.WalletService.DebitAgentBalance:output_type -> wallet.CommonResponseObj
+	107, // 240: wallet.WalletService.OpenTillSession:output_type -> wallet.CommonResponseObj
+	107, // 241: wallet.WalletService.CloseTillSession:output_type -> wallet.CommonResponseObj
+	107, // 242: wallet.WalletService.HandoverTillSession:output_type -> wallet.CommonResponseObj
+	107, // 243: wallet.WalletService.GetTillSession:output_type -> wallet.CommonResponseObj
+	108, // 244: wallet.WalletService.ListTillSessions:output_type -> wallet.CommonResponseArray
+	69,  // 245: wallet.WalletService.FlutterWaveWebhook:output_type -> wallet.WebhookResponse
+	69,  // 246: wallet.WalletService.KorapayWebhook:output_type -> wallet.WebhookResponse
+	4,   // 247: wallet.WalletService.TigoWebhook:output_type -> wallet.TigoResponse
+	1,   // 248: wallet.WalletService.PawapayCallback:output_type -> wallet.PawapayResponse
+	139, // [139:249] is the sub-list for method output_type
+	29,  // [29:139] is the sub-list for method input_type
+	29,  // [29:29] is the sub-list for extension type_name
+	29,  // [29:29] is the sub-list for extension extendee
+	0,   // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_grpc_proto_wallet_proto_init() }
@@ -9408,48 +10714,59 @@ func file_grpc_proto_wallet_proto_init() {
 	file_grpc_proto_wallet_proto_msgTypes[8].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[9].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[13].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[17].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[19].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[21].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[22].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[25].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[26].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[27].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[28].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[29].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[31].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[35].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[36].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[30].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[33].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[38].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[40].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[39].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[41].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[43].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[45].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[44].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[46].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[47].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[50].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[52].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[55].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[56].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[65].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[67].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[69].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[57].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[60].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[61].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[64].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[70].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[71].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[72].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[74].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[75].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[76].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[77].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[80].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[81].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[78].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[82].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[84].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[86].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[87].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[91].OneofWrappers = []any{}
 	file_grpc_proto_wallet_proto_msgTypes[92].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[95].OneofWrappers = []any{}
-	file_grpc_proto_wallet_proto_msgTypes[105].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[93].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[94].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[98].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[99].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[103].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[104].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[107].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[110].OneofWrappers = []any{}
+	file_grpc_proto_wallet_proto_msgTypes[117].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grpc_proto_wallet_proto_rawDesc), len(file_grpc_proto_wallet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   107,
+			NumMessages:   119,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,11 @@ const (
 	WalletService_CashbookFindAllBranchExpense_FullMethodName     = "/wallet.WalletService/CashbookFindAllBranchExpense"
 	WalletService_CashbookCreateExpenseType_FullMethodName        = "/wallet.WalletService/CashbookCreateExpenseType"
 	WalletService_CashbookFindAllExpenseType_FullMethodName       = "/wallet.WalletService/CashbookFindAllExpenseType"
+	WalletService_CashbookUpdateExpenseType_FullMethodName        = "/wallet.WalletService/CashbookUpdateExpenseType"
+	WalletService_CashbookDeleteExpenseType_FullMethodName        = "/wallet.WalletService/CashbookDeleteExpenseType"
+	WalletService_CashbookFindAllClientExpenseType_FullMethodName = "/wallet.WalletService/CashbookFindAllClientExpenseType"
+	WalletService_CashbookSetExpenseBudget_FullMethodName         = "/wallet.WalletService/CashbookSetExpenseBudget"
+	WalletService_CashbookSetExpenseApprover_FullMethodName       = "/wallet.WalletService/CashbookSetExpenseApprover"
 	WalletService_CashbookApproveCashIn_FullMethodName            = "/wallet.WalletService/CashbookApproveCashIn"
 	WalletService_CashbookCreateCashIn_FullMethodName             = "/wallet.WalletService/CashbookCreateCashIn"
 	WalletService_CashbookUpdateCashIn_FullMethodName             = "/wallet.WalletService/CashbookUpdateCashIn"
@@ -70,6 +75,13 @@ const (
 	WalletService_HandleWayaQuickVerify_FullMethodName            = "/wallet.WalletService/HandleWayaQuickVerify"
 	WalletService_FetchUsersWithdrawal_FullMethodName             = "/wallet.WalletService/FetchUsersWithdrawal"
 	WalletService_GetBalance_FullMethodName                       = "/wallet.WalletService/GetBalance"
+	WalletService_SaveWalletType_FullMethodName                   = "/wallet.WalletService/SaveWalletType"
+	WalletService_GetWalletTypes_FullMethodName                   = "/wallet.WalletService/GetWalletTypes"
+	WalletService_SaveClientCurrency_FullMethodName               = "/wallet.WalletService/SaveClientCurrency"
+	WalletService_GetClientCurrencies_FullMethodName              = "/wallet.WalletService/GetClientCurrencies"
+	WalletService_SaveExchangeRate_FullMethodName                 = "/wallet.WalletService/SaveExchangeRate"
+	WalletService_ImportExchangeRates_FullMethodName              = "/wallet.WalletService/ImportExchangeRates"
+	WalletService_GetExchangeRates_FullMethodName                 = "/wallet.WalletService/GetExchangeRates"
 	WalletService_CreateWallet_FullMethodName                     = "/wallet.WalletService/CreateWallet"
 	WalletService_FetchBetRange_FullMethodName                    = "/wallet.WalletService/FetchBetRange"
 	WalletService_FetchPlayerDeposit_FullMethodName               = "/wallet.WalletService/FetchPlayerDeposit"
@@ -83,9 +95,11 @@ const (
 	WalletService_RequestWithdrawal_FullMethodName                = "/wallet.WalletService/RequestWithdrawal"
 	WalletService_VerifyBankAccount_FullMethodName                = "/wallet.WalletService/VerifyBankAccount"
 	WalletService_ListBanks_FullMethodName                        = "/wallet.WalletService/ListBanks"
+	WalletService_SavePlayerName_FullMethodName                   = "/wallet.WalletService/SavePlayerName"
 	WalletService_GetTransactions_FullMethodName                  = "/wallet.WalletService/GetTransactions"
 	WalletService_GetPaymentMethods_FullMethodName                = "/wallet.WalletService/GetPaymentMethods"
 	WalletService_SavePaymentMethod_FullMethodName                = "/wallet.WalletService/SavePaymentMethod"
+	WalletService_DeletePaymentMethod_FullMethodName              = "/wallet.WalletService/DeletePaymentMethod"
 	WalletService_PaystackWebhook_FullMethodName                  = "/wallet.WalletService/PaystackWebhook"
 	WalletService_MonnifyWebhook_FullMethodName                   = "/wallet.WalletService/MonnifyWebhook"
 	WalletService_OpayDepositWebhook_FullMethodName               = "/wallet.WalletService/OpayDepositWebhook"
@@ -106,6 +120,11 @@ const (
 	WalletService_ValidateWithdrawalCode_FullMethodName           = "/wallet.WalletService/ValidateWithdrawalCode"
 	WalletService_ProcessShopWithdrawal_FullMethodName            = "/wallet.WalletService/ProcessShopWithdrawal"
 	WalletService_DebitAgentBalance_FullMethodName                = "/wallet.WalletService/DebitAgentBalance"
+	WalletService_OpenTillSession_FullMethodName                  = "/wallet.WalletService/OpenTillSession"
+	WalletService_CloseTillSession_FullMethodName                 = "/wallet.WalletService/CloseTillSession"
+	WalletService_HandoverTillSession_FullMethodName              = "/wallet.WalletService/HandoverTillSession"
+	WalletService_GetTillSession_FullMethodName                   = "/wallet.WalletService/GetTillSession"
+	WalletService_ListTillSessions_FullMethodName                 = "/wallet.WalletService/ListTillSessions"
 	WalletService_FlutterWaveWebhook_FullMethodName               = "/wallet.WalletService/FlutterWaveWebhook"
 	WalletService_KorapayWebhook_FullMethodName                   = "/wallet.WalletService/KorapayWebhook"
 	WalletService_TigoWebhook_FullMethodName                      = "/wallet.WalletService/TigoWebhook"
@@ -125,13 +144,18 @@ type WalletServiceClient interface {
 	CurrentReport(ctx context.Context, in *FetchReportRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	CashbookApproveExpense(ctx context.Context, in *CashbookApproveExpenseRequest, opts ...grpc.CallOption) (*ExpenseSingleResponse, error)
 	CashbookCreateExpense(ctx context.Context, in *CashbookCreateExpenseRequest, opts ...grpc.CallOption) (*ExpenseSingleResponse, error)
-	CashbookFindAllExpense(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExpenseRepeatedResponse, error)
+	CashbookFindAllExpense(ctx context.Context, in *ClientRequest, opts ...grpc.CallOption) (*ExpenseRepeatedResponse, error)
 	CashbookFindOneExpense(ctx context.Context, in *CashbookIdRequest, opts ...grpc.CallOption) (*ExpenseSingleResponse, error)
 	CashbookDeleteOneExpense(ctx context.Context, in *CashbookIdRequest, opts ...grpc.CallOption) (*ExpenseSingleResponse, error)
 	CashbookUpdateOneExpense(ctx context.Context, in *CashbookCreateExpenseRequest, opts ...grpc.CallOption) (*ExpenseSingleResponse, error)
 	CashbookFindAllBranchExpense(ctx context.Context, in *BranchRequest, opts ...grpc.CallOption) (*ExpenseRepeatedResponse, error)
 	CashbookCreateExpenseType(ctx context.Context, in *CashbookCreateExpenseTypeRequest, opts ...grpc.CallOption) (*ExpenseTypeSingleResponse, error)
-	CashbookFindAllExpenseType(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExpenseTypeRepeatedResponse, error)
+	CashbookFindAllExpenseType(ctx context.Context, in *ClientRequest, opts ...grpc.CallOption) (*ExpenseTypeRepeatedResponse, error)
+	CashbookUpdateExpenseType(ctx context.Context, in *CashbookCreateExpenseTypeRequest, opts ...grpc.CallOption) (*ExpenseTypeSingleResponse, error)
+	CashbookDeleteExpenseType(ctx context.Context, in *CashbookIdRequest, opts ...grpc.CallOption) (*ExpenseTypeSingleResponse, error)
+	CashbookFindAllClientExpenseType(ctx context.Context, in *BranchRequest, opts ...grpc.CallOption) (*ExpenseTypeRepeatedResponse, error)
+	CashbookSetExpenseBudget(ctx context.Context, in *ExpenseBudgetRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	CashbookSetExpenseApprover(ctx context.Context, in *ExpenseApproverRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	CashbookApproveCashIn(ctx context.Context, in *CashbookApproveCashInOutRequest, opts ...grpc.CallOption) (*CashInOutSingleResponse, error)
 	CashbookCreateCashIn(ctx context.Context, in *CashbookCreateCashInOutRequest, opts ...grpc.CallOption) (*CashInOutSingleResponse, error)
 	CashbookUpdateCashIn(ctx context.Context, in *CashbookCreateCashInOutRequest, opts ...grpc.CallOption) (*CashInOutSingleResponse, error)
@@ -167,6 +191,13 @@ type WalletServiceClient interface {
 	HandleWayaQuickVerify(ctx context.Context, in *WayaQuickRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	FetchUsersWithdrawal(ctx context.Context, in *FetchUsersWithdrawalRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	SaveWalletType(ctx context.Context, in *WalletTypeRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	GetWalletTypes(ctx context.Context, in *WalletTypeRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
+	SaveClientCurrency(ctx context.Context, in *ClientCurrencyRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	GetClientCurrencies(ctx context.Context, in *ClientCurrencyRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
+	SaveExchangeRate(ctx context.Context, in *ExchangeRateRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	ImportExchangeRates(ctx context.Context, in *ExchangeRateImportRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	GetExchangeRates(ctx context.Context, in *ExchangeRateRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
 	FetchBetRange(ctx context.Context, in *FetchBetRangeRequest, opts ...grpc.CallOption) (*FetchBetRangeResponse, error)
 	FetchPlayerDeposit(ctx context.Context, in *FetchPlayerDepositRequest, opts ...grpc.CallOption) (*WalletResponse, error)
@@ -179,10 +210,12 @@ type WalletServiceClient interface {
 	VerifyDeposit(ctx context.Context, in *VerifyDepositRequest, opts ...grpc.CallOption) (*VerifyDepositResponse, error)
 	RequestWithdrawal(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
 	VerifyBankAccount(ctx context.Context, in *VerifyBankAccountRequest, opts ...grpc.CallOption) (*VerifyBankAccountResponse, error)
-	ListBanks(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
+	ListBanks(ctx context.Context, in *ListBanksRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
+	SavePlayerName(ctx context.Context, in *PlayerNameRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	GetTransactions(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
 	GetPaymentMethods(ctx context.Context, in *GetPaymentMethodRequest, opts ...grpc.CallOption) (*GetPaymentMethodResponse, error)
 	SavePaymentMethod(ctx context.Context, in *PaymentMethodRequest, opts ...grpc.CallOption) (*PaymentMethodResponse, error)
+	DeletePaymentMethod(ctx context.Context, in *PaymentMethodRequest, opts ...grpc.CallOption) (*PaymentMethodResponse, error)
 	PaystackWebhook(ctx context.Context, in *PaystackWebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
 	MonnifyWebhook(ctx context.Context, in *MonnifyWebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
 	OpayDepositWebhook(ctx context.Context, in *OpayWebhookRequest, opts ...grpc.CallOption) (*OpayWebhookResponse, error)
@@ -192,7 +225,7 @@ type WalletServiceClient interface {
 	UserTransactions(ctx context.Context, in *UserTransactionRequest, opts ...grpc.CallOption) (*UserTransactionResponse, error)
 	UpdateWithdrawal(ctx context.Context, in *UpdateWithdrawalRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	GetPlayerWalletData(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*PlayerWalletData, error)
-	DeletePlayerData(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	DeletePlayerData(ctx context.Context, in *DeletePlayerDataRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	GetUserAccounts(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetUserAccountsResponse, error)
 	GetNetworkBalance(ctx context.Context, in *GetNetworkBalanceRequest, opts ...grpc.CallOption) (*GetNetworkBalanceResponse, error)
 	GetMoneyTransaction(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
@@ -204,6 +237,11 @@ type WalletServiceClient interface {
 	ValidateWithdrawalCode(ctx context.Context, in *ValidateTransactionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	ProcessShopWithdrawal(ctx context.Context, in *ProcessRetailTransaction, opts ...grpc.CallOption) (*CommonResponseObj, error)
 	DebitAgentBalance(ctx context.Context, in *DebitUserRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	OpenTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	CloseTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	HandoverTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	GetTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error)
+	ListTillSessions(ctx context.Context, in *BranchRequest, opts ...grpc.CallOption) (*CommonResponseArray, error)
 	FlutterWaveWebhook(ctx context.Context, in *FlutterwaveWebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
 	KorapayWebhook(ctx context.Context, in *KoraPayWebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error)
 	TigoWebhook(ctx context.Context, in *TigoWebhookRequest, opts ...grpc.CallOption) (*TigoResponse, error)
@@ -308,7 +346,7 @@ func (c *walletServiceClient) CashbookCreateExpense(ctx context.Context, in *Cas
 	return out, nil
 }
 
-func (c *walletServiceClient) CashbookFindAllExpense(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExpenseRepeatedResponse, error) {
+func (c *walletServiceClient) CashbookFindAllExpense(ctx context.Context, in *ClientRequest, opts ...grpc.CallOption) (*ExpenseRepeatedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExpenseRepeatedResponse)
 	err := c.cc.Invoke(ctx, WalletService_CashbookFindAllExpense_FullMethodName, in, out, cOpts...)
@@ -368,7 +406,7 @@ func (c *walletServiceClient) CashbookCreateExpenseType(ctx context.Context, in
 	return out, nil
 }
 
-func (c *walletServiceClient) CashbookFindAllExpenseType(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ExpenseTypeRepeatedResponse, error) {
+func (c *walletServiceClient) CashbookFindAllExpenseType(ctx context.Context, in *ClientRequest, opts ...grpc.CallOption) (*ExpenseTypeRepeatedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExpenseTypeRepeatedResponse)
 	err := c.cc.Invoke(ctx, WalletService_CashbookFindAllExpenseType_FullMethodName, in, out, cOpts...)
@@ -378,6 +416,56 @@ func (c *walletServiceClient) CashbookFindAllExpenseType(ctx context.Context, in
 	return out, nil
 }
 
+func (c *walletServiceClient) CashbookUpdateExpenseType(ctx context.Context, in *CashbookCreateExpenseTypeRequest, opts ...grpc.CallOption) (*ExpenseTypeSingleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseTypeSingleResponse)
+	err := c.cc.Invoke(ctx, WalletService_CashbookUpdateExpenseType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CashbookDeleteExpenseType(ctx context.Context, in *CashbookIdRequest, opts ...grpc.CallOption) (*ExpenseTypeSingleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseTypeSingleResponse)
+	err := c.cc.Invoke(ctx, WalletService_CashbookDeleteExpenseType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CashbookFindAllClientExpenseType(ctx context.Context, in *BranchRequest, opts ...grpc.CallOption) (*ExpenseTypeRepeatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseTypeRepeatedResponse)
+	err := c.cc.Invoke(ctx, WalletService_CashbookFindAllClientExpenseType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CashbookSetExpenseBudget(ctx context.Context, in *ExpenseBudgetRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_CashbookSetExpenseBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CashbookSetExpenseApprover(ctx context.Context, in *ExpenseApproverRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_CashbookSetExpenseApprover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) CashbookApproveCashIn(ctx context.Context, in *CashbookApproveCashInOutRequest, opts ...grpc.CallOption) (*CashInOutSingleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CashInOutSingleResponse)
@@ -728,6 +816,76 @@ func (c *walletServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequ
 	return out, nil
 }
 
+func (c *walletServiceClient) SaveWalletType(ctx context.Context, in *WalletTypeRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_SaveWalletType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetWalletTypes(ctx context.Context, in *WalletTypeRequest, opts ...grpc.CallOption) (*CommonResponseArray, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseArray)
+	err := c.cc.Invoke(ctx, WalletService_GetWalletTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SaveClientCurrency(ctx context.Context, in *ClientCurrencyRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_SaveClientCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetClientCurrencies(ctx context.Context, in *ClientCurrencyRequest, opts ...grpc.CallOption) (*CommonResponseArray, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseArray)
+	err := c.cc.Invoke(ctx, WalletService_GetClientCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SaveExchangeRate(ctx context.Context, in *ExchangeRateRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_SaveExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportExchangeRates(ctx context.Context, in *ExchangeRateImportRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetExchangeRates(ctx context.Context, in *ExchangeRateRequest, opts ...grpc.CallOption) (*CommonResponseArray, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseArray)
+	err := c.cc.Invoke(ctx, WalletService_GetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WalletResponse)
@@ -848,7 +1006,7 @@ func (c *walletServiceClient) VerifyBankAccount(ctx context.Context, in *VerifyB
 	return out, nil
 }
 
-func (c *walletServiceClient) ListBanks(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CommonResponseArray, error) {
+func (c *walletServiceClient) ListBanks(ctx context.Context, in *ListBanksRequest, opts ...grpc.CallOption) (*CommonResponseArray, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CommonResponseArray)
 	err := c.cc.Invoke(ctx, WalletService_ListBanks_FullMethodName, in, out, cOpts...)
@@ -858,6 +1016,16 @@ func (c *walletServiceClient) ListBanks(ctx context.Context, in *EmptyRequest, o
 	return out, nil
 }
 
+func (c *walletServiceClient) SavePlayerName(ctx context.Context, in *PlayerNameRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_SavePlayerName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTransactionResponse)
@@ -888,6 +1056,16 @@ func (c *walletServiceClient) SavePaymentMethod(ctx context.Context, in *Payment
 	return out, nil
 }
 
+func (c *walletServiceClient) DeletePaymentMethod(ctx context.Context, in *PaymentMethodRequest, opts ...grpc.CallOption) (*PaymentMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentMethodResponse)
+	err := c.cc.Invoke(ctx, WalletService_DeletePaymentMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) PaystackWebhook(ctx context.Context, in *PaystackWebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WebhookResponse)
@@ -978,7 +1156,7 @@ func (c *walletServiceClient) GetPlayerWalletData(ctx context.Context, in *GetBa
 	return out, nil
 }
 
-func (c *walletServiceClient) DeletePlayerData(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+func (c *walletServiceClient) DeletePlayerData(ctx context.Context, in *DeletePlayerDataRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CommonResponseObj)
 	err := c.cc.Invoke(ctx, WalletService_DeletePlayerData_FullMethodName, in, out, cOpts...)
@@ -1088,6 +1266,56 @@ func (c *walletServiceClient) DebitAgentBalance(ctx context.Context, in *DebitUs
 	return out, nil
 }
 
+func (c *walletServiceClient) OpenTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_OpenTillSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CloseTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_CloseTillSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) HandoverTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_HandoverTillSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTillSession(ctx context.Context, in *TillSessionRequest, opts ...grpc.CallOption) (*CommonResponseObj, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseObj)
+	err := c.cc.Invoke(ctx, WalletService_GetTillSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListTillSessions(ctx context.Context, in *BranchRequest, opts ...grpc.CallOption) (*CommonResponseArray, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommonResponseArray)
+	err := c.cc.Invoke(ctx, WalletService_ListTillSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) FlutterWaveWebhook(ctx context.Context, in *FlutterwaveWebhookRequest, opts ...grpc.CallOption) (*WebhookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WebhookResponse)
@@ -1141,13 +1369,18 @@ type WalletServiceServer interface {
 	CurrentReport(context.Context, *FetchReportRequest) (*CommonResponseObj, error)
 	CashbookApproveExpense(context.Context, *CashbookApproveExpenseRequest) (*ExpenseSingleResponse, error)
 	CashbookCreateExpense(context.Context, *CashbookCreateExpenseRequest) (*ExpenseSingleResponse, error)
-	CashbookFindAllExpense(context.Context, *EmptyRequest) (*ExpenseRepeatedResponse, error)
+	CashbookFindAllExpense(context.Context, *ClientRequest) (*ExpenseRepeatedResponse, error)
 	CashbookFindOneExpense(context.Context, *CashbookIdRequest) (*ExpenseSingleResponse, error)
 	CashbookDeleteOneExpense(context.Context, *CashbookIdRequest) (*ExpenseSingleResponse, error)
 	CashbookUpdateOneExpense(context.Context, *CashbookCreateExpenseRequest) (*ExpenseSingleResponse, error)
 	CashbookFindAllBranchExpense(context.Context, *BranchRequest) (*ExpenseRepeatedResponse, error)
 	CashbookCreateExpenseType(context.Context, *CashbookCreateExpenseTypeRequest) (*ExpenseTypeSingleResponse, error)
-	CashbookFindAllExpenseType(context.Context, *EmptyRequest) (*ExpenseTypeRepeatedResponse, error)
+	CashbookFindAllExpenseType(context.Context, *ClientRequest) (*ExpenseTypeRepeatedResponse, error)
+	CashbookUpdateExpenseType(context.Context, *CashbookCreateExpenseTypeRequest) (*ExpenseTypeSingleResponse, error)
+	CashbookDeleteExpenseType(context.Context, *CashbookIdRequest) (*ExpenseTypeSingleResponse, error)
+	CashbookFindAllClientExpenseType(context.Context, *BranchRequest) (*ExpenseTypeRepeatedResponse, error)
+	CashbookSetExpenseBudget(context.Context, *ExpenseBudgetRequest) (*CommonResponseObj, error)
+	CashbookSetExpenseApprover(context.Context, *ExpenseApproverRequest) (*CommonResponseObj, error)
 	CashbookApproveCashIn(context.Context, *CashbookApproveCashInOutRequest) (*CashInOutSingleResponse, error)
 	CashbookCreateCashIn(context.Context, *CashbookCreateCashInOutRequest) (*CashInOutSingleResponse, error)
 	CashbookUpdateCashIn(context.Context, *CashbookCreateCashInOutRequest) (*CashInOutSingleResponse, error)
@@ -1183,6 +1416,13 @@ type WalletServiceServer interface {
 	HandleWayaQuickVerify(context.Context, *WayaQuickRequest) (*CommonResponseObj, error)
 	FetchUsersWithdrawal(context.Context, *FetchUsersWithdrawalRequest) (*CommonResponseArray, error)
 	GetBalance(context.Context, *GetBalanceRequest) (*WalletResponse, error)
+	SaveWalletType(context.Context, *WalletTypeRequest) (*CommonResponseObj, error)
+	GetWalletTypes(context.Context, *WalletTypeRequest) (*CommonResponseArray, error)
+	SaveClientCurrency(context.Context, *ClientCurrencyRequest) (*CommonResponseObj, error)
+	GetClientCurrencies(context.Context, *ClientCurrencyRequest) (*CommonResponseArray, error)
+	SaveExchangeRate(context.Context, *ExchangeRateRequest) (*CommonResponseObj, error)
+	ImportExchangeRates(context.Context, *ExchangeRateImportRequest) (*CommonResponseObj, error)
+	GetExchangeRates(context.Context, *ExchangeRateRequest) (*CommonResponseArray, error)
 	CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error)
 	FetchBetRange(context.Context, *FetchBetRangeRequest) (*FetchBetRangeResponse, error)
 	FetchPlayerDeposit(context.Context, *FetchPlayerDepositRequest) (*WalletResponse, error)
@@ -1195,10 +1435,12 @@ type WalletServiceServer interface {
 	VerifyDeposit(context.Context, *VerifyDepositRequest) (*VerifyDepositResponse, error)
 	RequestWithdrawal(context.Context, *WithdrawRequest) (*WithdrawResponse, error)
 	VerifyBankAccount(context.Context, *VerifyBankAccountRequest) (*VerifyBankAccountResponse, error)
-	ListBanks(context.Context, *EmptyRequest) (*CommonResponseArray, error)
+	ListBanks(context.Context, *ListBanksRequest) (*CommonResponseArray, error)
+	SavePlayerName(context.Context, *PlayerNameRequest) (*CommonResponseObj, error)
 	GetTransactions(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
 	GetPaymentMethods(context.Context, *GetPaymentMethodRequest) (*GetPaymentMethodResponse, error)
 	SavePaymentMethod(context.Context, *PaymentMethodRequest) (*PaymentMethodResponse, error)
+	DeletePaymentMethod(context.Context, *PaymentMethodRequest) (*PaymentMethodResponse, error)
 	PaystackWebhook(context.Context, *PaystackWebhookRequest) (*WebhookResponse, error)
 	MonnifyWebhook(context.Context, *MonnifyWebhookRequest) (*WebhookResponse, error)
 	OpayDepositWebhook(context.Context, *OpayWebhookRequest) (*OpayWebhookResponse, error)
@@ -1208,7 +1450,7 @@ type WalletServiceServer interface {
 	UserTransactions(context.Context, *UserTransactionRequest) (*UserTransactionResponse, error)
 	UpdateWithdrawal(context.Context, *UpdateWithdrawalRequest) (*CommonResponseObj, error)
 	GetPlayerWalletData(context.Context, *GetBalanceRequest) (*PlayerWalletData, error)
-	DeletePlayerData(context.Context, *IdRequest) (*CommonResponseObj, error)
+	DeletePlayerData(context.Context, *DeletePlayerDataRequest) (*CommonResponseObj, error)
 	GetUserAccounts(context.Context, *GetBalanceRequest) (*GetUserAccountsResponse, error)
 	GetNetworkBalance(context.Context, *GetNetworkBalanceRequest) (*GetNetworkBalanceResponse, error)
 	GetMoneyTransaction(context.Context, *GetTransactionsRequest) (*CommonResponseObj, error)
@@ -1220,6 +1462,11 @@ type WalletServiceServer interface {
 	ValidateWithdrawalCode(context.Context, *ValidateTransactionRequest) (*CommonResponseObj, error)
 	ProcessShopWithdrawal(context.Context, *ProcessRetailTransaction) (*CommonResponseObj, error)
 	DebitAgentBalance(context.Context, *DebitUserRequest) (*CommonResponseObj, error)
+	OpenTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error)
+	CloseTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error)
+	HandoverTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error)
+	GetTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error)
+	ListTillSessions(context.Context, *BranchRequest) (*CommonResponseArray, error)
 	FlutterWaveWebhook(context.Context, *FlutterwaveWebhookRequest) (*WebhookResponse, error)
 	KorapayWebhook(context.Context, *KoraPayWebhookRequest) (*WebhookResponse, error)
 	TigoWebhook(context.Context, *TigoWebhookRequest) (*TigoResponse, error)
@@ -1261,7 +1508,7 @@ func (UnimplementedWalletServiceServer) CashbookApproveExpense(context.Context,
 func (UnimplementedWalletServiceServer) CashbookCreateExpense(context.Context, *CashbookCreateExpenseRequest) (*ExpenseSingleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CashbookCreateExpense not implemented")
 }
-func (UnimplementedWalletServiceServer) CashbookFindAllExpense(context.Context, *EmptyRequest) (*ExpenseRepeatedResponse, error) {
+func (UnimplementedWalletServiceServer) CashbookFindAllExpense(context.Context, *ClientRequest) (*ExpenseRepeatedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CashbookFindAllExpense not implemented")
 }
 func (UnimplementedWalletServiceServer) CashbookFindOneExpense(context.Context, *CashbookIdRequest) (*ExpenseSingleResponse, error) {
@@ -1279,9 +1526,24 @@ func (UnimplementedWalletServiceServer) CashbookFindAllBranchExpense(context.Con
 func (UnimplementedWalletServiceServer) CashbookCreateExpenseType(context.Context, *CashbookCreateExpenseTypeRequest) (*ExpenseTypeSingleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CashbookCreateExpenseType not implemented")
 }
-func (UnimplementedWalletServiceServer) CashbookFindAllExpenseType(context.Context, *EmptyRequest) (*ExpenseTypeRepeatedResponse, error) {
+func (UnimplementedWalletServiceServer) CashbookFindAllExpenseType(context.Context, *ClientRequest) (*ExpenseTypeRepeatedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CashbookFindAllExpenseType not implemented")
 }
+func (UnimplementedWalletServiceServer) CashbookUpdateExpenseType(context.Context, *CashbookCreateExpenseTypeRequest) (*ExpenseTypeSingleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashbookUpdateExpenseType not implemented")
+}
+func (UnimplementedWalletServiceServer) CashbookDeleteExpenseType(context.Context, *CashbookIdRequest) (*ExpenseTypeSingleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashbookDeleteExpenseType not implemented")
+}
+func (UnimplementedWalletServiceServer) CashbookFindAllClientExpenseType(context.Context, *BranchRequest) (*ExpenseTypeRepeatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashbookFindAllClientExpenseType not implemented")
+}
+func (UnimplementedWalletServiceServer) CashbookSetExpenseBudget(context.Context, *ExpenseBudgetRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashbookSetExpenseBudget not implemented")
+}
+func (UnimplementedWalletServiceServer) CashbookSetExpenseApprover(context.Context, *ExpenseApproverRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashbookSetExpenseApprover not implemented")
+}
 func (UnimplementedWalletServiceServer) CashbookApproveCashIn(context.Context, *CashbookApproveCashInOutRequest) (*CashInOutSingleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CashbookApproveCashIn not implemented")
 }
@@ -1387,6 +1649,27 @@ func (UnimplementedWalletServiceServer) FetchUsersWithdrawal(context.Context, *F
 func (UnimplementedWalletServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*WalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
+func (UnimplementedWalletServiceServer) SaveWalletType(context.Context, *WalletTypeRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWalletType not implemented")
+}
+func (UnimplementedWalletServiceServer) GetWalletTypes(context.Context, *WalletTypeRequest) (*CommonResponseArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletTypes not implemented")
+}
+func (UnimplementedWalletServiceServer) SaveClientCurrency(context.Context, *ClientCurrencyRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveClientCurrency not implemented")
+}
+func (UnimplementedWalletServiceServer) GetClientCurrencies(context.Context, *ClientCurrencyRequest) (*CommonResponseArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientCurrencies not implemented")
+}
+func (UnimplementedWalletServiceServer) SaveExchangeRate(context.Context, *ExchangeRateRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveExchangeRate not implemented")
+}
+func (UnimplementedWalletServiceServer) ImportExchangeRates(context.Context, *ExchangeRateImportRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedWalletServiceServer) GetExchangeRates(context.Context, *ExchangeRateRequest) (*CommonResponseArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
 func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
 }
@@ -1423,9 +1706,12 @@ func (UnimplementedWalletServiceServer) RequestWithdrawal(context.Context, *With
 func (UnimplementedWalletServiceServer) VerifyBankAccount(context.Context, *VerifyBankAccountRequest) (*VerifyBankAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyBankAccount not implemented")
 }
-func (UnimplementedWalletServiceServer) ListBanks(context.Context, *EmptyRequest) (*CommonResponseArray, error) {
+func (UnimplementedWalletServiceServer) ListBanks(context.Context, *ListBanksRequest) (*CommonResponseArray, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBanks not implemented")
 }
+func (UnimplementedWalletServiceServer) SavePlayerName(context.Context, *PlayerNameRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePlayerName not implemented")
+}
 func (UnimplementedWalletServiceServer) GetTransactions(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
 }
@@ -1435,6 +1721,9 @@ func (UnimplementedWalletServiceServer) GetPaymentMethods(context.Context, *GetP
 func (UnimplementedWalletServiceServer) SavePaymentMethod(context.Context, *PaymentMethodRequest) (*PaymentMethodResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SavePaymentMethod not implemented")
 }
+func (UnimplementedWalletServiceServer) DeletePaymentMethod(context.Context, *PaymentMethodRequest) (*PaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentMethod not implemented")
+}
 func (UnimplementedWalletServiceServer) PaystackWebhook(context.Context, *PaystackWebhookRequest) (*WebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PaystackWebhook not implemented")
 }
@@ -1462,7 +1751,7 @@ func (UnimplementedWalletServiceServer) UpdateWithdrawal(context.Context, *Updat
 func (UnimplementedWalletServiceServer) GetPlayerWalletData(context.Context, *GetBalanceRequest) (*PlayerWalletData, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerWalletData not implemented")
 }
-func (UnimplementedWalletServiceServer) DeletePlayerData(context.Context, *IdRequest) (*CommonResponseObj, error) {
+func (UnimplementedWalletServiceServer) DeletePlayerData(context.Context, *DeletePlayerDataRequest) (*CommonResponseObj, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePlayerData not implemented")
 }
 func (UnimplementedWalletServiceServer) GetUserAccounts(context.Context, *GetBalanceRequest) (*GetUserAccountsResponse, error) {
@@ -1495,6 +1784,21 @@ func (UnimplementedWalletServiceServer) ProcessShopWithdrawal(context.Context, *
 func (UnimplementedWalletServiceServer) DebitAgentBalance(context.Context, *DebitUserRequest) (*CommonResponseObj, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebitAgentBalance not implemented")
 }
+func (UnimplementedWalletServiceServer) OpenTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenTillSession not implemented")
+}
+func (UnimplementedWalletServiceServer) CloseTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTillSession not implemented")
+}
+func (UnimplementedWalletServiceServer) HandoverTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoverTillSession not implemented")
+}
+func (UnimplementedWalletServiceServer) GetTillSession(context.Context, *TillSessionRequest) (*CommonResponseObj, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTillSession not implemented")
+}
+func (UnimplementedWalletServiceServer) ListTillSessions(context.Context, *BranchRequest) (*CommonResponseArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTillSessions not implemented")
+}
 func (UnimplementedWalletServiceServer) FlutterWaveWebhook(context.Context, *FlutterwaveWebhookRequest) (*WebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlutterWaveWebhook not implemented")
 }
@@ -1691,7 +1995,7 @@ func _WalletService_CashbookCreateExpense_Handler(srv interface{}, ctx context.C
 }
 
 func _WalletService_CashbookFindAllExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(ClientRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1703,7 +2007,7 @@ func _WalletService_CashbookFindAllExpense_Handler(srv interface{}, ctx context.
 		FullMethod: WalletService_CashbookFindAllExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CashbookFindAllExpense(ctx, req.(*EmptyRequest))
+		return srv.(WalletServiceServer).CashbookFindAllExpense(ctx, req.(*ClientRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1799,7 +2103,7 @@ func _WalletService_CashbookCreateExpenseType_Handler(srv interface{}, ctx conte
 }
 
 func _WalletService_CashbookFindAllExpenseType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(ClientRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1811,7 +2115,97 @@ func _WalletService_CashbookFindAllExpenseType_Handler(srv interface{}, ctx cont
 		FullMethod: WalletService_CashbookFindAllExpenseType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CashbookFindAllExpenseType(ctx, req.(*EmptyRequest))
+		return srv.(WalletServiceServer).CashbookFindAllExpenseType(ctx, req.(*ClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CashbookUpdateExpenseType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashbookCreateExpenseTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CashbookUpdateExpenseType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CashbookUpdateExpenseType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CashbookUpdateExpenseType(ctx, req.(*CashbookCreateExpenseTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CashbookDeleteExpenseType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashbookIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CashbookDeleteExpenseType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CashbookDeleteExpenseType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CashbookDeleteExpenseType(ctx, req.(*CashbookIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CashbookFindAllClientExpenseType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CashbookFindAllClientExpenseType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CashbookFindAllClientExpenseType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CashbookFindAllClientExpenseType(ctx, req.(*BranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CashbookSetExpenseBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CashbookSetExpenseBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CashbookSetExpenseBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CashbookSetExpenseBudget(ctx, req.(*ExpenseBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CashbookSetExpenseApprover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseApproverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CashbookSetExpenseApprover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CashbookSetExpenseApprover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CashbookSetExpenseApprover(ctx, req.(*ExpenseApproverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2446,6 +2840,132 @@ func _WalletService_GetBalance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_SaveWalletType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SaveWalletType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SaveWalletType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SaveWalletType(ctx, req.(*WalletTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetWalletTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetWalletTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetWalletTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetWalletTypes(ctx, req.(*WalletTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SaveClientCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SaveClientCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SaveClientCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SaveClientCurrency(ctx, req.(*ClientCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetClientCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetClientCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetClientCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetClientCurrencies(ctx, req.(*ClientCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SaveExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SaveExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SaveExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SaveExchangeRate(ctx, req.(*ExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportExchangeRates(ctx, req.(*ExchangeRateImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetExchangeRates(ctx, req.(*ExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWalletRequest)
 	if err := dec(in); err != nil {
@@ -2663,7 +3183,7 @@ func _WalletService_VerifyBankAccount_Handler(srv interface{}, ctx context.Conte
 }
 
 func _WalletService_ListBanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(ListBanksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2675,7 +3195,25 @@ func _WalletService_ListBanks_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: WalletService_ListBanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ListBanks(ctx, req.(*EmptyRequest))
+		return srv.(WalletServiceServer).ListBanks(ctx, req.(*ListBanksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SavePlayerName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SavePlayerName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SavePlayerName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SavePlayerName(ctx, req.(*PlayerNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2734,6 +3272,24 @@ func _WalletService_SavePaymentMethod_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_DeletePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeletePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DeletePaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeletePaymentMethod(ctx, req.(*PaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_PaystackWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PaystackWebhookRequest)
 	if err := dec(in); err != nil {
@@ -2897,7 +3453,7 @@ func _WalletService_GetPlayerWalletData_Handler(srv interface{}, ctx context.Con
 }
 
 func _WalletService_DeletePlayerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IdRequest)
+	in := new(DeletePlayerDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2909,7 +3465,7 @@ func _WalletService_DeletePlayerData_Handler(srv interface{}, ctx context.Contex
 		FullMethod: WalletService_DeletePlayerData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).DeletePlayerData(ctx, req.(*IdRequest))
+		return srv.(WalletServiceServer).DeletePlayerData(ctx, req.(*DeletePlayerDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3094,6 +3650,96 @@ func _WalletService_DebitAgentBalance_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_OpenTillSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TillSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).OpenTillSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_OpenTillSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).OpenTillSession(ctx, req.(*TillSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CloseTillSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TillSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CloseTillSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CloseTillSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CloseTillSession(ctx, req.(*TillSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_HandoverTillSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TillSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).HandoverTillSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_HandoverTillSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).HandoverTillSession(ctx, req.(*TillSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTillSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TillSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTillSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetTillSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTillSession(ctx, req.(*TillSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListTillSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListTillSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListTillSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListTillSessions(ctx, req.(*BranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_FlutterWaveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FlutterwaveWebhookRequest)
 	if err := dec(in); err != nil {
@@ -3237,6 +3883,26 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CashbookFindAllExpenseType",
 			Handler:    _WalletService_CashbookFindAllExpenseType_Handler,
 		},
+		{
+			MethodName: "CashbookUpdateExpenseType",
+			Handler:    _WalletService_CashbookUpdateExpenseType_Handler,
+		},
+		{
+			MethodName: "CashbookDeleteExpenseType",
+			Handler:    _WalletService_CashbookDeleteExpenseType_Handler,
+		},
+		{
+			MethodName: "CashbookFindAllClientExpenseType",
+			Handler:    _WalletService_CashbookFindAllClientExpenseType_Handler,
+		},
+		{
+			MethodName: "CashbookSetExpenseBudget",
+			Handler:    _WalletService_CashbookSetExpenseBudget_Handler,
+		},
+		{
+			MethodName: "CashbookSetExpenseApprover",
+			Handler:    _WalletService_CashbookSetExpenseApprover_Handler,
+		},
 		{
 			MethodName: "CashbookApproveCashIn",
 			Handler:    _WalletService_CashbookApproveCashIn_Handler,
@@ -3377,6 +4043,34 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBalance",
 			Handler:    _WalletService_GetBalance_Handler,
 		},
+		{
+			MethodName: "SaveWalletType",
+			Handler:    _WalletService_SaveWalletType_Handler,
+		},
+		{
+			MethodName: "GetWalletTypes",
+			Handler:    _WalletService_GetWalletTypes_Handler,
+		},
+		{
+			MethodName: "SaveClientCurrency",
+			Handler:    _WalletService_SaveClientCurrency_Handler,
+		},
+		{
+			MethodName: "GetClientCurrencies",
+			Handler:    _WalletService_GetClientCurrencies_Handler,
+		},
+		{
+			MethodName: "SaveExchangeRate",
+			Handler:    _WalletService_SaveExchangeRate_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _WalletService_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _WalletService_GetExchangeRates_Handler,
+		},
 		{
 			MethodName: "CreateWallet",
 			Handler:    _WalletService_CreateWallet_Handler,
@@ -3429,6 +4123,10 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBanks",
 			Handler:    _WalletService_ListBanks_Handler,
 		},
+		{
+			MethodName: "SavePlayerName",
+			Handler:    _WalletService_SavePlayerName_Handler,
+		},
 		{
 			MethodName: "GetTransactions",
 			Handler:    _WalletService_GetTransactions_Handler,
@@ -3441,6 +4139,10 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SavePaymentMethod",
 			Handler:    _WalletService_SavePaymentMethod_Handler,
 		},
+		{
+			MethodName: "DeletePaymentMethod",
+			Handler:    _WalletService_DeletePaymentMethod_Handler,
+		},
 		{
 			MethodName: "PaystackWebhook",
 			Handler:    _WalletService_PaystackWebhook_Handler,
@@ -3521,6 +4223,26 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DebitAgentBalance",
 			Handler:    _WalletService_DebitAgentBalance_Handler,
 		},
+		{
+			MethodName: "OpenTillSession",
+			Handler:    _WalletService_OpenTillSession_Handler,
+		},
+		{
+			MethodName: "CloseTillSession",
+			Handler:    _WalletService_CloseTillSession_Handler,
+		},
+		{
+			MethodName: "HandoverTillSession",
+			Handler:    _WalletService_HandoverTillSession_Handler,
+		},
+		{
+			MethodName: "GetTillSession",
+			Handler:    _WalletService_GetTillSession_Handler,
+		},
+		{
+			MethodName: "ListTillSessions",
+			Handler:    _WalletService_ListTillSessions_Handler,
+		},
 		{
 			MethodName: "FlutterWaveWebhook",
 			Handler:    _WalletService_FlutterWaveWebhook_Handler,
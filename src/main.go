@@ -42,6 +42,7 @@ func main() {
 	var a routes.App
 	a.Initialize()
 	go a.GRPC()
+	go a.Jobs()
 	a.Run()
 
 }
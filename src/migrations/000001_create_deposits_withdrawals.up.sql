@@ -0,0 +1,68 @@
+CREATE TABLE IF NOT EXISTS payment_methods (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  title VARCHAR(100) NOT NULL DEFAULT '',
+  provider VARCHAR(50) NOT NULL,
+  secret_key TEXT NULL,
+  public_key TEXT NULL,
+  merchant_id VARCHAR(150) NULL,
+  base_url VARCHAR(255) NULL,
+  status TINYINT NOT NULL DEFAULT 1,
+  for_disbursement TINYINT NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_payment_methods_client (client_id, provider)
+);
+
+CREATE TABLE IF NOT EXISTS deposits (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  username VARCHAR(100) NOT NULL DEFAULT '',
+  provider VARCHAR(50) NOT NULL,
+  reference VARCHAR(100) NOT NULL,
+  provider_reference VARCHAR(100) NULL,
+  amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  currency VARCHAR(10) NOT NULL DEFAULT '',
+  msisdn VARCHAR(30) NULL,
+  source VARCHAR(50) NOT NULL DEFAULT '',
+  status TINYINT NOT NULL DEFAULT 0,
+  provider_status VARCHAR(50) NULL,
+  transaction_no VARCHAR(50) NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_deposits_reference (provider, reference),
+  KEY idx_deposits_user (client_id, user_id),
+  KEY idx_deposits_status (status, created_at)
+);
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  username VARCHAR(100) NOT NULL DEFAULT '',
+  withdrawal_code VARCHAR(50) NOT NULL,
+  amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  currency VARCHAR(10) NOT NULL DEFAULT '',
+  account_number VARCHAR(50) NULL,
+  account_name VARCHAR(150) NULL,
+  bank_code VARCHAR(50) NULL,
+  bank_name VARCHAR(150) NULL,
+  msisdn VARCHAR(30) NULL,
+  provider VARCHAR(50) NULL,
+  provider_reference VARCHAR(100) NULL,
+  source VARCHAR(50) NOT NULL DEFAULT '',
+  status TINYINT NOT NULL DEFAULT 0,
+  provider_status VARCHAR(50) NULL,
+  comment VARCHAR(255) NULL,
+  updated_by VARCHAR(100) NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_withdrawals_code (withdrawal_code),
+  KEY idx_withdrawals_provider_reference (provider, provider_reference),
+  KEY idx_withdrawals_user (client_id, user_id),
+  KEY idx_withdrawals_status (status, created_at)
+);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS withdrawal_payouts (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  withdrawal_id INT UNSIGNED NOT NULL,
+  provider VARCHAR(50) NOT NULL,
+  payout_id VARCHAR(100) NOT NULL,
+  amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  status TINYINT NOT NULL DEFAULT 0,
+  provider_status VARCHAR(50) NULL,
+  failure_reason VARCHAR(255) NULL,
+  refunded TINYINT NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_withdrawal_payouts_payout (provider, payout_id),
+  KEY idx_withdrawal_payouts_withdrawal (withdrawal_id)
+);
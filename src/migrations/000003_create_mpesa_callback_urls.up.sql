@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS mpesa_callback_urls (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  action VARCHAR(50) NOT NULL,
+  url VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_mpesa_callback_urls_client (client_id, action, created_at)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS callback_logs (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  provider VARCHAR(50) NOT NULL,
+  reference VARCHAR(100) NOT NULL,
+  event VARCHAR(100) NULL,
+  status VARCHAR(50) NULL,
+  body TEXT NULL,
+  response VARCHAR(255) NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_callback_logs_reference (provider, reference)
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS virtual_accounts (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  username VARCHAR(100) NOT NULL DEFAULT '',
+  provider VARCHAR(50) NOT NULL,
+  account_number VARCHAR(20) NULL,
+  account_name VARCHAR(150) NULL,
+  bank_name VARCHAR(150) NULL,
+  provider_reference VARCHAR(100) NULL,
+  status TINYINT NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_virtual_accounts_user (client_id, user_id, provider),
+  UNIQUE KEY uniq_virtual_accounts_number (provider, account_number)
+);
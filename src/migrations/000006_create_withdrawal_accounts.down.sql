@@ -0,0 +1,3 @@
+ALTER TABLE withdrawals MODIFY account_number VARCHAR(50) NULL;
+
+DROP TABLE IF EXISTS withdrawal_accounts;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS withdrawal_accounts (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  bank_code VARCHAR(50) NOT NULL,
+  bank_name VARCHAR(150) NULL,
+  account_number TEXT NOT NULL,
+  account_number_hash CHAR(64) NOT NULL,
+  account_name VARCHAR(150) NOT NULL,
+  provider VARCHAR(50) NOT NULL,
+  name_matched TINYINT NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_withdrawal_accounts_account (client_id, user_id, bank_code, account_number_hash)
+);
+
+ALTER TABLE withdrawals MODIFY account_number VARCHAR(255) NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE payment_methods
+  DROP COLUMN min_amount,
+  DROP COLUMN max_amount,
+  DROP COLUMN display_order;
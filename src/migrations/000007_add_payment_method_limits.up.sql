@@ -0,0 +1,4 @@
+ALTER TABLE payment_methods
+  ADD COLUMN min_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  ADD COLUMN max_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  ADD COLUMN display_order INT NOT NULL DEFAULT 0;
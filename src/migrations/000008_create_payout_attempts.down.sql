@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS payout_attempts;
+
+ALTER TABLE withdrawals DROP COLUMN country;
+
+ALTER TABLE payment_methods
+  DROP COLUMN country,
+  DROP COLUMN bank_codes;
@@ -0,0 +1,22 @@
+ALTER TABLE payment_methods
+  ADD COLUMN country VARCHAR(3) NULL,
+  ADD COLUMN bank_codes TEXT NULL;
+
+ALTER TABLE withdrawals ADD COLUMN country VARCHAR(3) NULL;
+
+CREATE TABLE IF NOT EXISTS payout_attempts (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  withdrawal_id INT UNSIGNED NOT NULL,
+  payment_method_id INT UNSIGNED NOT NULL,
+  provider VARCHAR(50) NOT NULL,
+  provider_reference VARCHAR(100) NULL,
+  status VARCHAR(20) NOT NULL,
+  message VARCHAR(255) NULL,
+  latency_ms INT NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_payout_attempts_withdrawal (withdrawal_id),
+  KEY idx_payout_attempts_health (client_id, provider, created_at)
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS cashbook_expenses (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  branch_id INT NOT NULL,
+  expense_type_id INT NOT NULL DEFAULT 0,
+  requested_amount INT NOT NULL,
+  amount INT NOT NULL DEFAULT 0,
+  status TINYINT NOT NULL DEFAULT 0,
+  branch_comment VARCHAR(255) NULL,
+  admin_comment VARCHAR(255) NULL,
+  verified_by INT NULL,
+  verified_at DATETIME NULL,
+  balance DECIMAL(20,2) NULL,
+  transaction_no VARCHAR(50) NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_cashbook_expenses_branch (client_id, branch_id, status)
+);
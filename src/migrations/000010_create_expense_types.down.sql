@@ -0,0 +1,4 @@
+ALTER TABLE cashbook_expenses DROP COLUMN approval_level;
+
+DROP TABLE IF EXISTS expense_type_budgets;
+DROP TABLE IF EXISTS expense_types;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS expense_types (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  title VARCHAR(150) NOT NULL,
+  fixed TINYINT NOT NULL DEFAULT 0,
+  amount INT NOT NULL DEFAULT 0,
+  status TINYINT NOT NULL DEFAULT 1,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_expense_types_client (client_id, status)
+);
+
+CREATE TABLE IF NOT EXISTS expense_type_budgets (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  expense_type_id INT NOT NULL,
+  branch_id INT NOT NULL DEFAULT 0,
+  monthly_budget INT NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_expense_type_budgets (expense_type_id, branch_id)
+);
+
+ALTER TABLE cashbook_expenses ADD COLUMN approval_level TINYINT NOT NULL DEFAULT 1;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS cashbook_cash_in_out (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  branch_id INT NOT NULL,
+  user_id INT NOT NULL,
+  type VARCHAR(10) NOT NULL,
+  amount INT NOT NULL,
+  comment VARCHAR(255) NULL,
+  status TINYINT NOT NULL DEFAULT 0,
+  verified_by INT NULL,
+  verified_at DATETIME NULL,
+  balance DECIMAL(20,2) NULL,
+  transaction_no VARCHAR(50) NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_cashbook_cash_in_out_branch (client_id, branch_id, type, status, created_at)
+);
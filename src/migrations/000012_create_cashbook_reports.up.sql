@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS cashbook_reports (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  branch_id INT NOT NULL,
+  date DATE NOT NULL,
+  opening_balance INT NOT NULL DEFAULT 0,
+  closing_balance INT NOT NULL DEFAULT 0,
+  expected_closing_balance INT NOT NULL DEFAULT 0,
+  variance INT NOT NULL DEFAULT 0,
+  online_sales INT NOT NULL DEFAULT 0,
+  online_payouts INT NOT NULL DEFAULT 0,
+  normal_sales INT NOT NULL DEFAULT 0,
+  normal_payouts INT NOT NULL DEFAULT 0,
+  other_sales INT NOT NULL DEFAULT 0,
+  other_payouts INT NOT NULL DEFAULT 0,
+  cashin INT NOT NULL DEFAULT 0,
+  cashout INT NOT NULL DEFAULT 0,
+  expenses INT NOT NULL DEFAULT 0,
+  status TINYINT NOT NULL DEFAULT 0,
+  verified_by INT NULL,
+  verified_at DATETIME NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_cashbook_reports_day (client_id, branch_id, date),
+  KEY idx_cashbook_reports_status (client_id, status, date)
+);
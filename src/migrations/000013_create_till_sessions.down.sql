@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS till_session_entries;
+DROP TABLE IF EXISTS till_sessions;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS till_sessions (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  branch_id INT NOT NULL,
+  cashier_id INT NOT NULL,
+  opening_float DECIMAL(20,2) NOT NULL DEFAULT 0,
+  expected_cash DECIMAL(20,2) NULL,
+  counted_cash DECIMAL(20,2) NULL,
+  variance DECIMAL(20,2) NULL,
+  status TINYINT NOT NULL DEFAULT 0,
+  open_branch_id INT NULL,
+  handed_over_from INT UNSIGNED NULL,
+  handed_over_to INT UNSIGNED NULL,
+  comment VARCHAR(255) NULL,
+  opened_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  closed_at DATETIME NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_till_sessions_open (client_id, open_branch_id),
+  KEY idx_till_sessions_branch (client_id, branch_id, opened_at)
+);
+
+CREATE TABLE IF NOT EXISTS till_session_entries (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  session_id INT UNSIGNED NOT NULL,
+  transaction_no VARCHAR(50) NOT NULL,
+  subject VARCHAR(100) NOT NULL,
+  category VARCHAR(30) NOT NULL,
+  amount DECIMAL(20,2) NOT NULL,
+  cash DECIMAL(20,2) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_till_session_entries_session (session_id)
+);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS player_daily_totals (
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  date DATE NOT NULL,
+  bet_total DECIMAL(20,2) NOT NULL DEFAULT 0,
+  bet_count INT NOT NULL DEFAULT 0,
+  deposit_total DECIMAL(20,2) NOT NULL DEFAULT 0,
+  deposit_count INT NOT NULL DEFAULT 0,
+  withdrawal_total DECIMAL(20,2) NOT NULL DEFAULT 0,
+  withdrawal_count INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (client_id, date, user_id),
+  KEY idx_player_daily_totals_user (client_id, user_id, date)
+);
+
+INSERT INTO player_daily_totals (client_id, user_id, date, bet_total, bet_count, deposit_total, deposit_count, withdrawal_total, withdrawal_count)
+SELECT client_id, user_id, DATE(created_at),
+  SUM(CASE WHEN subject = 'Bet Deposit' AND tranasaction_type = 'debit' THEN amount ELSE 0 END),
+  SUM(CASE WHEN subject = 'Bet Deposit' AND tranasaction_type = 'debit' THEN 1 ELSE 0 END),
+  SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN amount ELSE 0 END),
+  SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN 1 ELSE 0 END),
+  SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN amount WHEN subject = 'Withdrawal Refund' THEN -amount ELSE 0 END),
+  SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN 1 WHEN subject = 'Withdrawal Refund' THEN -1 ELSE 0 END)
+FROM transactions
+WHERE status = 1 AND subject IN ('Bet Deposit', 'Deposit', 'Withdrawal', 'Withdrawal Refund')
+GROUP BY client_id, user_id, DATE(created_at);
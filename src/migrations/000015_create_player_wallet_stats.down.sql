@@ -0,0 +1,3 @@
+ALTER TABLE player_daily_totals DROP KEY idx_player_daily_totals_user_date;
+
+DROP TABLE IF EXISTS player_wallet_stats;
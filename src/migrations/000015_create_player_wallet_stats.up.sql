@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS player_wallet_stats (
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  total_deposits DECIMAL(20,2) NOT NULL DEFAULT 0,
+  deposit_count INT NOT NULL DEFAULT 0,
+  last_deposit_date DATETIME NULL,
+  last_deposit_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  total_withdrawals DECIMAL(20,2) NOT NULL DEFAULT 0,
+  withdrawal_count INT NOT NULL DEFAULT 0,
+  last_withdrawal_date DATETIME NULL,
+  last_withdrawal_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+  first_activity_date DATETIME NULL,
+  last_activity_date DATETIME NULL,
+  PRIMARY KEY (client_id, user_id)
+);
+
+INSERT INTO player_wallet_stats (client_id, user_id, total_deposits, deposit_count, total_withdrawals, withdrawal_count, first_activity_date, last_activity_date)
+SELECT client_id, user_id,
+  SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN amount ELSE 0 END),
+  SUM(CASE WHEN subject = 'Deposit' AND tranasaction_type = 'credit' THEN 1 ELSE 0 END),
+  SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN amount WHEN subject = 'Withdrawal Refund' THEN -amount ELSE 0 END),
+  SUM(CASE WHEN subject = 'Withdrawal' AND tranasaction_type = 'debit' THEN 1 WHEN subject = 'Withdrawal Refund' THEN -1 ELSE 0 END),
+  MIN(created_at), MAX(created_at)
+FROM transactions
+WHERE status = 1
+GROUP BY client_id, user_id;
+
+UPDATE player_wallet_stats s
+  JOIN transactions t ON t.id = (SELECT MAX(id) FROM transactions WHERE client_id = s.client_id AND user_id = s.user_id AND subject = 'Deposit' AND tranasaction_type = 'credit')
+  SET s.last_deposit_date = t.created_at, s.last_deposit_amount = t.amount;
+
+UPDATE player_wallet_stats s
+  JOIN transactions t ON t.id = (SELECT MAX(id) FROM transactions WHERE client_id = s.client_id AND user_id = s.user_id AND subject = 'Withdrawal' AND tranasaction_type = 'debit')
+  SET s.last_withdrawal_date = t.created_at, s.last_withdrawal_amount = t.amount;
+
+ALTER TABLE player_daily_totals ADD KEY idx_player_daily_totals_user_date (user_id, date);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS player_erasures (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  anonymised_username VARCHAR(100) NOT NULL,
+  summary TEXT NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_player_erasures_user (client_id, user_id)
+);
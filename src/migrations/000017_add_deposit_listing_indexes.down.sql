@@ -0,0 +1,3 @@
+ALTER TABLE deposits
+  DROP KEY idx_deposits_client_created,
+  DROP KEY idx_deposits_transaction_no;
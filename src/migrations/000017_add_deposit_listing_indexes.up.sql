@@ -0,0 +1,3 @@
+ALTER TABLE deposits
+  ADD KEY idx_deposits_client_created (client_id, created_at),
+  ADD KEY idx_deposits_transaction_no (transaction_no);
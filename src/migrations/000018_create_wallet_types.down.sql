@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS sub_wallets;
+DROP TABLE IF EXISTS wallet_types;
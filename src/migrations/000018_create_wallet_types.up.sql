@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS wallet_types (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL DEFAULT 0,
+  name VARCHAR(50) NOT NULL,
+  title VARCHAR(100) NOT NULL DEFAULT '',
+  balance_column VARCHAR(50) NULL,
+  withdrawable TINYINT NOT NULL DEFAULT 0,
+  bonus TINYINT NOT NULL DEFAULT 0,
+  can_go_negative TINYINT NOT NULL DEFAULT 0,
+  status TINYINT NOT NULL DEFAULT 1,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_wallet_types_name (client_id, name)
+);
+
+-- client 0 holds the types every client has, stored in the original wallets columns
+INSERT IGNORE INTO wallet_types (client_id, name, title, balance_column, withdrawable, bonus, can_go_negative) VALUES
+  (0, 'main', 'Main', 'available_balance', 1, 0, 0),
+  (0, 'sport-bonus', 'Sport Bonus', 'sport_bonus_balance', 0, 1, 0),
+  (0, 'virtual', 'Virtual Bonus', 'virtual_bonus_balance', 0, 1, 0),
+  (0, 'casino', 'Casino Bonus', 'casino_bonus_balance', 0, 1, 0),
+  (0, 'trust', 'Trust', 'trust_balance', 0, 0, 0);
+
+CREATE TABLE IF NOT EXISTS sub_wallets (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  wallet_type VARCHAR(50) NOT NULL,
+  balance DECIMAL(20,2) NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_sub_wallets_user (client_id, user_id, wallet_type)
+);
@@ -0,0 +1,13 @@
+ALTER TABLE transactions DROP COLUMN currency;
+
+ALTER TABLE sub_wallets
+  DROP INDEX uniq_sub_wallets_user,
+  DROP COLUMN currency,
+  ADD UNIQUE KEY uniq_sub_wallets_user (client_id, user_id, wallet_type);
+
+ALTER TABLE wallets
+  DROP INDEX uniq_wallets_currency,
+  DROP COLUMN currency;
+
+DROP TABLE IF EXISTS client_currencies;
+DROP TABLE IF EXISTS currencies;
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS currencies (
+  code CHAR(3) NOT NULL,
+  name VARCHAR(100) NOT NULL DEFAULT '',
+  minor_units TINYINT NOT NULL DEFAULT 2,
+  PRIMARY KEY (code)
+);
+
+INSERT IGNORE INTO currencies (code, name, minor_units) VALUES
+  ('NGN', 'Nigerian Naira', 2),
+  ('GHS', 'Ghanaian Cedi', 2),
+  ('KES', 'Kenyan Shilling', 2),
+  ('TZS', 'Tanzanian Shilling', 2),
+  ('UGX', 'Ugandan Shilling', 0),
+  ('RWF', 'Rwandan Franc', 0),
+  ('ZMW', 'Zambian Kwacha', 2),
+  ('MWK', 'Malawian Kwacha', 2),
+  ('CDF', 'Congolese Franc', 2),
+  ('XOF', 'West African CFA Franc', 0),
+  ('XAF', 'Central African CFA Franc', 0),
+  ('SLE', 'Sierra Leonean Leone', 2),
+  ('MZN', 'Mozambican Metical', 2),
+  ('ZAR', 'South African Rand', 2),
+  ('USD', 'US Dollar', 2),
+  ('EUR', 'Euro', 2),
+  ('GBP', 'Pound Sterling', 2);
+
+-- the currencies a client's players can hold; the default one is used when a request names none
+CREATE TABLE IF NOT EXISTS client_currencies (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  currency CHAR(3) NOT NULL,
+  is_default TINYINT NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uniq_client_currencies (client_id, currency)
+);
+
+-- existing wallets keep an empty currency until the client's default currency is set
+ALTER TABLE wallets
+  ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '',
+  ADD UNIQUE KEY uniq_wallets_currency (client_id, user_id, currency);
+
+ALTER TABLE sub_wallets
+  ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '',
+  DROP INDEX uniq_sub_wallets_user,
+  ADD UNIQUE KEY uniq_sub_wallets_user (client_id, user_id, wallet_type, currency);
+
+ALTER TABLE transactions ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+ALTER TABLE transactions
+  DROP COLUMN conversion_id,
+  DROP COLUMN exchange_rate;
+
+DROP TABLE IF EXISTS currency_conversions;
+DROP TABLE IF EXISTS exchange_rates;
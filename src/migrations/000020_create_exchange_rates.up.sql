@@ -0,0 +1,39 @@
+-- rate is how many quote currency units one base currency unit buys. client 0 rates apply to every
+-- client without its own, spread is a percentage kept off converted amounts
+CREATE TABLE IF NOT EXISTS exchange_rates (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL DEFAULT 0,
+  base_currency CHAR(3) NOT NULL,
+  quote_currency CHAR(3) NOT NULL,
+  rate DECIMAL(20,8) NOT NULL,
+  spread DECIMAL(8,4) NULL,
+  effective_at DATETIME NOT NULL,
+  source VARCHAR(20) NOT NULL DEFAULT '',
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_exchange_rates_pair (base_currency, quote_currency, client_id, effective_at)
+);
+
+CREATE TABLE IF NOT EXISTS currency_conversions (
+  id INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  client_id INT NOT NULL,
+  from_user_id INT NOT NULL,
+  to_user_id INT NOT NULL,
+  from_currency CHAR(3) NOT NULL,
+  to_currency CHAR(3) NOT NULL,
+  from_amount DECIMAL(20,2) NOT NULL,
+  to_amount DECIMAL(20,2) NOT NULL,
+  rate_id INT UNSIGNED NOT NULL,
+  mid_rate DECIMAL(20,8) NOT NULL,
+  spread DECIMAL(8,4) NOT NULL DEFAULT 0,
+  applied_rate DECIMAL(20,8) NOT NULL,
+  debit_transaction_no VARCHAR(50) NOT NULL,
+  credit_transaction_no VARCHAR(50) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_currency_conversions_client (client_id, created_at)
+);
+
+ALTER TABLE transactions
+  ADD COLUMN exchange_rate DECIMAL(20,8) NULL,
+  ADD COLUMN conversion_id INT UNSIGNED NULL;
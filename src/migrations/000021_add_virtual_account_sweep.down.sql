@@ -0,0 +1,3 @@
+ALTER TABLE virtual_accounts
+  DROP KEY idx_virtual_accounts_sweep,
+  DROP COLUMN last_checked_at;
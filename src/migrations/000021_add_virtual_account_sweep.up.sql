@@ -0,0 +1,4 @@
+-- last_checked_at is when the inflow sweep last asked wayabank for an account's transfers
+ALTER TABLE virtual_accounts
+  ADD COLUMN last_checked_at DATETIME NULL,
+  ADD KEY idx_virtual_accounts_sweep (provider, status, last_checked_at);
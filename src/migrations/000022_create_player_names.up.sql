@@ -0,0 +1,9 @@
+-- the name a player registered with, which withdrawal accounts must carry
+CREATE TABLE IF NOT EXISTS player_names (
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  full_name VARCHAR(150) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (client_id, user_id)
+);
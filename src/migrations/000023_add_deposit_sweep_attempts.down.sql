@@ -0,0 +1,4 @@
+ALTER TABLE deposits
+  DROP KEY idx_deposits_sweep,
+  DROP COLUMN attempts,
+  DROP COLUMN last_checked_at;
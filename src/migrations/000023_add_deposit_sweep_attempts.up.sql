@@ -0,0 +1,6 @@
+-- the pending deposit sweep checks the deposits it has gone longest without checking first and gives
+-- up on a deposit after a number of attempts
+ALTER TABLE deposits
+  ADD COLUMN last_checked_at DATETIME NULL,
+  ADD COLUMN attempts INT NOT NULL DEFAULT 0,
+  ADD KEY idx_deposits_sweep (provider, status, last_checked_at);
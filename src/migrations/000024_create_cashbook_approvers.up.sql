@@ -0,0 +1,9 @@
+-- the level each expense approver may approve at, approvers not listed approve at level 1
+CREATE TABLE IF NOT EXISTS cashbook_approvers (
+  client_id INT NOT NULL,
+  user_id INT NOT NULL,
+  level TINYINT NOT NULL DEFAULT 1,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (client_id, user_id)
+);
@@ -0,0 +1,4 @@
+ALTER TABLE deposits
+  DROP KEY uniq_deposits_reference,
+  ADD UNIQUE KEY uniq_deposits_reference (provider, reference),
+  DROP COLUMN account_number;
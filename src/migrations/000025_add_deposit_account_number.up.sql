@@ -0,0 +1,10 @@
+-- account_number is the virtual account a bank transfer was paid into. Transfer references are only
+-- unique per account, so the account is part of the deposit key. Other deposits keep it empty
+ALTER TABLE deposits
+  ADD COLUMN account_number VARCHAR(32) NOT NULL DEFAULT '' AFTER provider_reference;
+
+UPDATE deposits SET account_number = provider_reference WHERE source = 'bank-transfer' AND provider_reference IS NOT NULL;
+
+ALTER TABLE deposits
+  DROP KEY uniq_deposits_reference,
+  ADD UNIQUE KEY uniq_deposits_reference (provider, reference, account_number);
@@ -0,0 +1,5 @@
+ALTER TABLE cashbook_cash_in_out
+  DROP KEY idx_cashbook_cash_in_out_verified;
+
+ALTER TABLE cashbook_expenses
+  DROP KEY idx_cashbook_expenses_verified;
@@ -0,0 +1,6 @@
+-- day closing counts approved cashbook entries on the day they were approved
+ALTER TABLE cashbook_cash_in_out
+  ADD KEY idx_cashbook_cash_in_out_verified (client_id, status, verified_at);
+
+ALTER TABLE cashbook_expenses
+  ADD KEY idx_cashbook_expenses_verified (client_id, status, verified_at);
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS player_totals_backfill;
+
+ALTER TABLE player_wallet_stats
+  DROP PRIMARY KEY,
+  ADD PRIMARY KEY (client_id, user_id),
+  DROP COLUMN currency;
+
+ALTER TABLE player_daily_totals
+  DROP PRIMARY KEY,
+  ADD PRIMARY KEY (client_id, date, user_id),
+  DROP COLUMN currency;
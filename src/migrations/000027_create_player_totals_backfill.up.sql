@@ -0,0 +1,28 @@
+-- player totals are kept per currency
+ALTER TABLE player_daily_totals
+  ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '' AFTER date,
+  DROP PRIMARY KEY,
+  ADD PRIMARY KEY (client_id, date, user_id, currency);
+
+ALTER TABLE player_wallet_stats
+  ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '' AFTER user_id,
+  DROP PRIMARY KEY,
+  ADD PRIMARY KEY (client_id, user_id, currency);
+
+-- the totals filled in when the tables were created have no currency and count cancelled bets, they
+-- are rebuilt by the background backfill
+TRUNCATE TABLE player_daily_totals;
+
+TRUNCATE TABLE player_wallet_stats;
+
+-- transactions up to cutoff_id are added by the background backfill in id chunks, last_id is how far
+-- it got. cutoff_id is recorded by the service when it starts keeping the running totals
+CREATE TABLE IF NOT EXISTS player_totals_backfill (
+  id TINYINT NOT NULL,
+  last_id BIGINT NOT NULL DEFAULT 0,
+  cutoff_id BIGINT NULL,
+  completed_at DATETIME NULL,
+  PRIMARY KEY (id)
+);
+
+INSERT INTO player_totals_backfill (id) VALUES (1);
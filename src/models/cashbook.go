@@ -0,0 +1,149 @@
+package models
+
+// cashbook entry statuses
+const (
+	CashbookPending  = 0
+	CashbookApproved = 1
+	CashbookRejected = 2
+)
+
+// expense approval levels. Expenses over their monthly budget need a senior approver
+const (
+	ApproverLevel       = 1
+	SeniorApproverLevel = 2
+)
+
+// Expense is money a branch spent from its till. RequestedAmount is what the branch asked for and
+// Amount what was approved, which is debited from the branch wallet
+type Expense struct {
+	ID              int64   `json:"id"`
+	ClientID        int64   `json:"client_id"`
+	BranchID        int64   `json:"branch_id"`
+	ExpenseTypeID   int64   `json:"expense_type_id"`
+	RequestedAmount int64   `json:"requested_amount"`
+	Amount          int64   `json:"amount"`
+	Status          int64   `json:"status"`
+	BranchComment   string  `json:"branch_comment"`
+	AdminComment    string  `json:"admin_comment"`
+	VerifiedBy      int64   `json:"verified_by"`
+	VerifiedAt      string  `json:"verified_at"`
+	Balance         float64 `json:"balance"`
+	TransactionNo   string  `json:"transaction_no"`
+	ApprovalLevel   int64   `json:"approval_level"`
+	ExpenseType     string  `json:"expense_type"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// ExpenseType is a category of expense. A fixed type only allows its configured amount
+type ExpenseType struct {
+	ID        int64  `json:"id"`
+	ClientID  int64  `json:"client_id"`
+	Title     string `json:"title"`
+	Fixed     int64  `json:"fixed"`
+	Amount    int64  `json:"amount"`
+	Status    int64  `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+// cash movements between a branch and head office
+const (
+	CashIn  = "cashin"
+	CashOut = "cashout"
+)
+
+// CashInOut is cash a branch received from (cash in) or handed to (cash out) head office. Approved
+// entries are credited or debited to the branch wallet
+type CashInOut struct {
+	ID            int64   `json:"id"`
+	ClientID      int64   `json:"client_id"`
+	BranchID      int64   `json:"branch_id"`
+	UserID        int64   `json:"user_id"`
+	Type          string  `json:"type"`
+	Amount        int64   `json:"amount"`
+	Comment       string  `json:"comment"`
+	Status        int64   `json:"status"`
+	VerifiedBy    int64   `json:"verified_by"`
+	VerifiedAt    string  `json:"verified_at"`
+	Balance       float64 `json:"balance"`
+	TransactionNo string  `json:"transaction_no"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// ShopReport is the closing of a branch's day. ExpectedClosingBalance is worked out from the previous
+// approved day and the day's flows, Variance is what the branch counted less that
+type ShopReport struct {
+	ID                     int64  `json:"id"`
+	ClientID               int64  `json:"client_id"`
+	BranchID               int64  `json:"branch_id"`
+	Date                   string `json:"date"`
+	OpeningBalance         int64  `json:"opening_balance"`
+	ClosingBalance         int64  `json:"closing_balance"`
+	ExpectedClosingBalance int64  `json:"expected_closing_balance"`
+	Variance               int64  `json:"variance"`
+	OnlineSales            int64  `json:"online_sales"`
+	OnlinePayouts          int64  `json:"online_payouts"`
+	NormalSales            int64  `json:"normal_sales"`
+	NormalPayouts          int64  `json:"normal_payouts"`
+	OtherSales             int64  `json:"other_sales"`
+	OtherPayouts           int64  `json:"other_payouts"`
+	CashIn                 int64  `json:"cashin"`
+	CashOut                int64  `json:"cashout"`
+	Expenses               int64  `json:"expenses"`
+	Status                 int64  `json:"status"`
+	VerifiedBy             int64  `json:"verified_by"`
+	CreatedAt              string `json:"created_at"`
+	// Currency is the currency the flows were worked out in, it is not stored
+	Currency string `json:"currency"`
+}
+
+// Expected returns the closing balance the day should end with, before it is compared with the counted cash
+func (r *ShopReport) Expected() int64 {
+
+	return r.OpeningBalance + r.OnlineSales + r.NormalSales + r.OtherSales - r.OnlinePayouts - r.NormalPayouts - r.OtherPayouts +
+		r.CashIn - r.CashOut - r.Expenses
+}
+
+// sales report columns a shop wallet transaction can count towards
+const (
+	NormalSales   = "normal_sales"
+	NormalPayouts = "normal_payouts"
+	OnlineSales   = "online_sales"
+	OnlinePayouts = "online_payouts"
+)
+
+// ShopSubjects maps the subjects of shop wallet transactions to the sales report. Tickets sold and
+// paid at the counter are normal sales and payouts, deposits and withdrawals of online players handled
+// at the counter are online ones. Other shop transactions, apart from cashbook entries, are reported as
+// other sales (debits) and other payouts (credits)
+var ShopSubjects = map[string]string{
+	"Bet Deposit":     NormalSales,
+	"Sport Win":       NormalPayouts,
+	"Shop Deposit":    OnlineSales,
+	"Shop Withdrawal": OnlinePayouts,
+}
+
+// till session statuses
+const (
+	TillOpen   = 0
+	TillClosed = 1
+)
+
+// TillSession is a cashier's shift on a branch till. Money the shop handles while it is open is tagged
+// with it, and closing compares the cash counted with the opening float plus the tagged cash
+type TillSession struct {
+	ID             int64              `json:"id"`
+	ClientID       int64              `json:"client_id"`
+	BranchID       int64              `json:"branch_id"`
+	CashierID      int64              `json:"cashier_id"`
+	OpeningFloat   float64            `json:"opening_float"`
+	ExpectedCash   float64            `json:"expected_cash"`
+	CountedCash    float64            `json:"counted_cash"`
+	Variance       float64            `json:"variance"`
+	Status         int64              `json:"status"`
+	HandedOverFrom int64              `json:"handed_over_from"`
+	HandedOverTo   int64              `json:"handed_over_to"`
+	Comment        string             `json:"comment"`
+	OpenedAt       string             `json:"opened_at"`
+	ClosedAt       string             `json:"closed_at"`
+	Totals         map[string]float64 `json:"totals"`
+}
@@ -0,0 +1,51 @@
+package models
+
+// Currency is an ISO 4217 currency and the number of decimal places its amounts are kept to
+type Currency struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	MinorUnits int    `json:"minor_units"`
+}
+
+// ClientCurrency is a currency a client's players can hold a wallet in
+type ClientCurrency struct {
+	ClientID   int64  `json:"client_id"`
+	Currency   string `json:"currency"`
+	Name       string `json:"name"`
+	MinorUnits int    `json:"minor_units"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+// SubjectTransfer is the subject of both legs of a wallet to wallet transfer
+const SubjectTransfer = "Funds Transfer"
+
+// ExchangeRate is how many quote currency units one base currency unit buys from EffectiveAt
+type ExchangeRate struct {
+	ID            int64   `json:"id"`
+	ClientID      int64   `json:"client_id"`
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+	Spread        float64 `json:"spread"`
+	EffectiveAt   string  `json:"effective_at"`
+	Source        string  `json:"source"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// Conversion records the rate a cross-currency transfer was converted at
+type Conversion struct {
+	ID                  int64   `json:"id"`
+	ClientID            int64   `json:"client_id"`
+	FromUserID          int64   `json:"from_user_id"`
+	ToUserID            int64   `json:"to_user_id"`
+	FromCurrency        string  `json:"from_currency"`
+	ToCurrency          string  `json:"to_currency"`
+	FromAmount          float64 `json:"from_amount"`
+	ToAmount            float64 `json:"to_amount"`
+	RateID              int64   `json:"rate_id"`
+	MidRate             float64 `json:"mid_rate"`
+	Spread              float64 `json:"spread"`
+	AppliedRate         float64 `json:"applied_rate"`
+	DebitTransactionNo  string  `json:"debit_transaction_no"`
+	CreditTransactionNo string  `json:"credit_transaction_no"`
+}
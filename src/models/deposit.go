@@ -0,0 +1,39 @@
+package models
+
+// deposit and withdrawal statuses
+const (
+	StatusPending   = 0
+	StatusCompleted = 1
+	StatusFailed    = 2
+	// StatusPartial is set on a split withdrawal when some of its payouts failed and were refunded
+	StatusPartial = 3
+)
+
+type Deposit struct {
+	ID                int64   `json:"id"`
+	ClientID          int64   `json:"client_id"`
+	UserID            int64   `json:"user_id"`
+	Username          string  `json:"username"`
+	Provider          string  `json:"provider"`
+	Reference         string  `json:"reference"`
+	ProviderReference string  `json:"provider_reference"`
+	Amount            float64 `json:"amount"`
+	Currency          string  `json:"currency"`
+	Msisdn            string  `json:"msisdn"`
+	Source            string  `json:"source"`
+	Status            int64   `json:"status"`
+	ProviderStatus    string  `json:"provider_status"`
+	TransactionNo     string  `json:"transaction_no"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+// SweepStat counts what the pending deposit sweep did for a provider
+type SweepStat struct {
+	Provider string `json:"provider"`
+	Checked  int64  `json:"checked"`
+	Credited int64  `json:"credited"`
+	Failed   int64  `json:"failed"`
+	Errors   int64  `json:"errors"`
+	LastRun  string `json:"lastRun"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// MpesaCallbackUrl is a callback url registered with safaricom for a client
+type MpesaCallbackUrl struct {
+	ID        int64  `json:"id"`
+	ClientID  int64  `json:"client_id"`
+	Action    string `json:"action"`
+	Url       string `json:"url"`
+	CreatedAt string `json:"created_at"`
+}
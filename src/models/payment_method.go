@@ -0,0 +1,21 @@
+package models
+
+// PaymentMethod is a provider configured for a client. SecretKey is held encrypted
+type PaymentMethod struct {
+	ID              int64   `json:"id"`
+	ClientID        int64   `json:"client_id"`
+	Title           string  `json:"title"`
+	Provider        string  `json:"provider"`
+	SecretKey       string  `json:"secret_key"`
+	PublicKey       string  `json:"public_key"`
+	MerchantID      string  `json:"merchant_id"`
+	BaseURL         string  `json:"base_url"`
+	Status          int64   `json:"status"`
+	ForDisbursement int64   `json:"for_disbursement"`
+	MinAmount       float64 `json:"min_amount"`
+	MaxAmount       float64 `json:"max_amount"`
+	DisplayOrder    int64   `json:"display_order"`
+	Country         string  `json:"country"`
+	// BankCodes limits a disbursement method to a comma separated list of banks, empty means all
+	BankCodes string `json:"bank_codes"`
+}
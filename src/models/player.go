@@ -0,0 +1,33 @@
+package models
+
+// transaction subjects counted in the per player totals
+const (
+	SubjectBet              = "Bet Deposit"
+	SubjectDeposit          = "Deposit"
+	SubjectWithdrawal       = "Withdrawal"
+	SubjectWithdrawalRefund = "Withdrawal Refund"
+	SubjectBetCancelled     = "Bet Cancelled"
+	SubjectBetRefund        = "Bet Refund"
+)
+
+// PlayerRange is a player's total over a period with their current balance
+type PlayerRange struct {
+	UserID  int64
+	Total   float64
+	Count   int64
+	Balance float64
+}
+
+// PlayerStats is the running wallet profile of a player
+type PlayerStats struct {
+	TotalDeposits        float64
+	DepositCount         int64
+	LastDepositDate      string
+	LastDepositAmount    float64
+	TotalWithdrawals     float64
+	WithdrawalCount      int64
+	LastWithdrawalDate   string
+	LastWithdrawalAmount float64
+	FirstActivityDate    string
+	LastActivityDate     string
+}
@@ -0,0 +1,77 @@
+package models
+
+// transaction classes: real money moving in and out of the platform, or movements inside it
+const (
+	MoneyTransaction  = "money"
+	SystemTransaction = "system"
+)
+
+// TransactionType is how a transaction subject is reported
+type TransactionType struct {
+	Class string
+	Type  string
+}
+
+// TransactionSubjects classifies transaction subjects from sources not in TransactionSources. Subjects
+// not listed are matched on their wording by TransactionKeywords and are otherwise system transactions
+// of type "other"
+var TransactionSubjects = map[string]TransactionType{
+	SubjectDeposit:          {MoneyTransaction, "deposit"},
+	SubjectWithdrawal:       {MoneyTransaction, "withdrawal"},
+	SubjectWithdrawalRefund: {MoneyTransaction, "withdrawal_refund"},
+	SubjectBet:              {SystemTransaction, "bet"},
+	// the shop legs of counter deposits and withdrawals move money between a shop and a player, the
+	// player's deposit or withdrawal is what brings it in or takes it out
+	"Shop Deposit":          {SystemTransaction, "shop_transfer"},
+	"Shop Deposit Reversal": {SystemTransaction, "shop_transfer"},
+	"Shop Withdrawal":       {SystemTransaction, "shop_transfer"},
+}
+
+// TransactionSources classifies transactions by their source ahead of their subject. Cashbook entries
+// and wallet to wallet transfers move money inside the platform whatever their subject says
+var TransactionSources = map[string]TransactionType{
+	"cashbook": {SystemTransaction, "cashbook"},
+	"transfer": {SystemTransaction, "transfer"},
+}
+
+// TransactionKeywords types system transactions by a word in their subject, checked in order
+var TransactionKeywords = []struct {
+	Keyword string
+	Type    string
+}{
+	{"Bonus", "bonus"},
+	{"Win", "win"},
+	{"Bet", "bet"},
+	{"Transfer", "transfer"},
+	{"Commission", "commission"},
+}
+
+// Transaction is a row of the transactions table
+type Transaction struct {
+	ID              int64   `json:"id"`
+	ClientID        int64   `json:"client_id"`
+	UserID          int64   `json:"user_id"`
+	Username        string  `json:"username"`
+	TransactionNo   string  `json:"transaction_no"`
+	Amount          float64 `json:"amount"`
+	TransactionType string  `json:"transaction_type"`
+	Subject         string  `json:"subject"`
+	Description     string  `json:"description"`
+	Source          string  `json:"source"`
+	Channel         string  `json:"channel"`
+	Balance         float64 `json:"balance"`
+	Currency        string  `json:"currency"`
+	ExchangeRate    float64 `json:"exchange_rate"`
+	Status          int64   `json:"status"`
+	Type            string  `json:"type"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// TransactionTotal is the count and sum of credits and debits of one transaction type in one currency
+type TransactionTotal struct {
+	Type     string  `json:"type"`
+	Currency string  `json:"currency"`
+	Count    int64   `json:"count"`
+	Credit   float64 `json:"credit"`
+	Debit    float64 `json:"debit"`
+}
@@ -0,0 +1,16 @@
+package models
+
+// VirtualAccount is a dedicated deposit account number issued to a player
+type VirtualAccount struct {
+	ID                int64  `json:"id"`
+	ClientID          int64  `json:"client_id"`
+	UserID            int64  `json:"user_id"`
+	Username          string `json:"username"`
+	Provider          string `json:"provider"`
+	AccountNumber     string `json:"account_number"`
+	AccountName       string `json:"account_name"`
+	BankName          string `json:"bank_name"`
+	ProviderReference string `json:"provider_reference"`
+	Status            int64  `json:"status"`
+	CreatedAt         string `json:"created_at"`
+}
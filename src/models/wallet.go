@@ -10,5 +10,45 @@ type Wallet struct {
 	SportBonusBalance   float64 `json:"sport_bonus_balance"`
 	VirtualBonusBalance float64 `json:"virtual_bonus_balance"`
 	CasinoBonusBalance  float64 `json:"casino_bonus_balance"`
+	Currency            string  `json:"currency"`
 	Status              int64   `json:"status"`
 }
+
+// WalletClosed is the status of a wallet closed when the player's data was erased
+const WalletClosed = 2
+
+// Erasure records what was anonymised for a player data deletion request
+type Erasure struct {
+	ID                 int64            `json:"id"`
+	ClientID           int64            `json:"client_id"`
+	UserID             int64            `json:"user_id"`
+	AnonymisedUsername string           `json:"anonymised_username"`
+	Rows               map[string]int64 `json:"rows"`
+	CreatedAt          string           `json:"created_at"`
+}
+
+// MainWallet is the wallet type used when a request does not name one
+const MainWallet = "main"
+
+// WalletType is a kind of balance a player holds. The original types keep their balance in a column
+// of wallets, types added later keep it in sub_wallets
+type WalletType struct {
+	ID            int64  `json:"id"`
+	ClientID      int64  `json:"client_id"`
+	Name          string `json:"name"`
+	Title         string `json:"title"`
+	BalanceColumn string `json:"balance_column"`
+	Withdrawable  bool   `json:"withdrawable"`
+	Bonus         bool   `json:"bonus"`
+	CanGoNegative bool   `json:"can_go_negative"`
+	Status        int64  `json:"status"`
+}
+
+// WalletColumns are the wallets columns a wallet type may keep its balance in
+var WalletColumns = map[string]bool{
+	"available_balance":     true,
+	"sport_bonus_balance":   true,
+	"virtual_bonus_balance": true,
+	"casino_bonus_balance":  true,
+	"trust_balance":         true,
+}
@@ -0,0 +1,52 @@
+package models
+
+type Withdrawal struct {
+	ID                int64   `json:"id"`
+	ClientID          int64   `json:"client_id"`
+	UserID            int64   `json:"user_id"`
+	Username          string  `json:"username"`
+	WithdrawalCode    string  `json:"withdrawal_code"`
+	Amount            float64 `json:"amount"`
+	Currency          string  `json:"currency"`
+	Country           string  `json:"country"`
+	AccountNumber     string  `json:"account_number"`
+	AccountName       string  `json:"account_name"`
+	BankCode          string  `json:"bank_code"`
+	BankName          string  `json:"bank_name"`
+	Msisdn            string  `json:"msisdn"`
+	Provider          string  `json:"provider"`
+	ProviderReference string  `json:"provider_reference"`
+	Source            string  `json:"source"`
+	Status            int64   `json:"status"`
+	ProviderStatus    string  `json:"provider_status"`
+	Comment           string  `json:"comment"`
+	UpdatedBy         string  `json:"updated_by"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
+
+// WithdrawalPayout is one of the payouts a large withdrawal is split into
+type WithdrawalPayout struct {
+	ID             int64   `json:"id"`
+	WithdrawalID   int64   `json:"withdrawal_id"`
+	Provider       string  `json:"provider"`
+	PayoutID       string  `json:"payout_id"`
+	Amount         float64 `json:"amount"`
+	Status         int64   `json:"status"`
+	ProviderStatus string  `json:"provider_status"`
+	FailureReason  string  `json:"failure_reason"`
+	Refunded       int64   `json:"refunded"`
+}
+
+// PayoutAttempt is one try at paying a withdrawal out through a provider
+type PayoutAttempt struct {
+	ID                int64  `json:"id"`
+	WithdrawalID      int64  `json:"withdrawal_id"`
+	PaymentMethodID   int64  `json:"payment_method_id"`
+	Provider          string `json:"provider"`
+	ProviderReference string `json:"provider_reference"`
+	Status            string `json:"status"`
+	Message           string `json:"message"`
+	LatencyMs         int64  `json:"latency_ms"`
+	CreatedAt         string `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+// WithdrawalAccount is a bank account a player has verified for payouts. AccountNumber is held encrypted
+type WithdrawalAccount struct {
+	ID            int64  `json:"id"`
+	ClientID      int64  `json:"client_id"`
+	UserID        int64  `json:"user_id"`
+	BankCode      string `json:"bank_code"`
+	BankName      string `json:"bank_name"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+	Provider      string `json:"provider"`
+	NameMatched   int64  `json:"name_matched"`
+	CreatedAt     string `json:"created_at"`
+}
@@ -0,0 +1,113 @@
+package pawapay
+
+import (
+	"sync"
+	"time"
+)
+
+// cache bounds: expired entries are swept at most once per cacheSweepEvery, and when the cache is
+// full the entry closest to expiry makes room
+const (
+	cacheMaxEntries = 10000
+	cacheSweepEvery = time.Minute
+)
+
+// cache holds read-only responses shared by every client, since a client is created per request
+var cache = newResponseCache(cacheMaxEntries)
+
+type cacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+type responseCache struct {
+	mu        sync.Mutex
+	entries   map[string]cacheEntry
+	max       int
+	lastSweep time.Time
+}
+
+func newResponseCache(max int) *responseCache {
+
+	return &responseCache{entries: map[string]cacheEntry{}, max: max, lastSweep: time.Now()}
+}
+
+func (r *responseCache) get(key string) ([]byte, bool) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+
+		return nil, false
+	}
+
+	if time.Now().After(entry.expires) {
+
+		delete(r.entries, key)
+		return nil, false
+	}
+
+	return entry.data, true
+}
+
+func (r *responseCache) set(key string, data []byte, ttl time.Duration) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	if now.Sub(r.lastSweep) >= cacheSweepEvery || len(r.entries) >= r.max {
+
+		r.sweep(now)
+	}
+
+	if _, ok := r.entries[key]; !ok && len(r.entries) >= r.max {
+
+		r.evictOldest()
+	}
+
+	r.entries[key] = cacheEntry{data: data, expires: now.Add(ttl)}
+}
+
+// sweep drops expired entries, the caller holds the lock
+func (r *responseCache) sweep(now time.Time) {
+
+	for key, entry := range r.entries {
+
+		if now.After(entry.expires) {
+
+			delete(r.entries, key)
+		}
+	}
+
+	r.lastSweep = now
+}
+
+// evictOldest drops the entry that expires first, the caller holds the lock
+func (r *responseCache) evictOldest() {
+
+	var oldest string
+	var expires time.Time
+
+	for key, entry := range r.entries {
+
+		if oldest == "" || entry.expires.Before(expires) {
+
+			oldest, expires = key, entry.expires
+		}
+	}
+
+	delete(r.entries, oldest)
+}
+
+// len returns the number of cached entries
+func (r *responseCache) len() int {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.entries)
+}
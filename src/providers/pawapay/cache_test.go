@@ -0,0 +1,70 @@
+package pawapay
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheExpiry(t *testing.T) {
+
+	c := newResponseCache(10)
+	c.set("live", []byte("1"), time.Minute)
+	c.set("expired", []byte("2"), -time.Second)
+
+	if data, ok := c.get("live"); !ok || string(data) != "1" {
+
+		t.Fatalf("expected the live entry, got %q %v", data, ok)
+	}
+
+	if _, ok := c.get("expired"); ok {
+
+		t.Fatal("expected the expired entry to be gone")
+	}
+
+	if c.len() != 1 {
+
+		t.Fatalf("expected the expired entry to be evicted on read, %d entries left", c.len())
+	}
+}
+
+func TestCacheSizeCap(t *testing.T) {
+
+	c := newResponseCache(2)
+	c.set("first", []byte("1"), time.Minute)
+	c.set("second", []byte("2"), time.Hour)
+	c.set("third", []byte("3"), time.Hour)
+
+	if c.len() != 2 {
+
+		t.Fatalf("expected 2 entries, got %d", c.len())
+	}
+
+	if _, ok := c.get("first"); ok {
+
+		t.Fatal("expected the entry closest to expiry to be evicted")
+	}
+
+	// replacing an existing key does not evict anything
+	c.set("third", []byte("4"), time.Hour)
+	if _, ok := c.get("second"); !ok {
+
+		t.Fatal("expected the second entry to survive a replace")
+	}
+}
+
+func TestCacheSweep(t *testing.T) {
+
+	c := newResponseCache(10)
+	for _, key := range []string{"a", "b", "c"} {
+
+		c.set(key, []byte(key), -time.Second)
+	}
+
+	c.lastSweep = time.Now().Add(-2 * cacheSweepEvery)
+	c.set("d", []byte("d"), time.Minute)
+
+	if c.len() != 1 {
+
+		t.Fatalf("expected expired entries to be swept, %d entries left", c.len())
+	}
+}
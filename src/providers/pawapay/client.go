@@ -0,0 +1,258 @@
+package pawapay
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const SandboxURL = "https://api.sandbox.pawapay.io"
+
+// currencies maps the country suffix of a correspondent code (e.g. MTN_MOMO_UGA) to its currency
+var currencies = map[string]string{
+	"BEN": "XOF",
+	"BFA": "XOF",
+	"CIV": "XOF",
+	"CMR": "XAF",
+	"COD": "CDF",
+	"COG": "XAF",
+	"GAB": "XAF",
+	"GHA": "GHS",
+	"KEN": "KES",
+	"MOZ": "MZN",
+	"MWI": "MWK",
+	"NGA": "NGN",
+	"RWA": "RWF",
+	"SEN": "XOF",
+	"SLE": "SLE",
+	"TZA": "TZS",
+	"UGA": "UGX",
+	"ZMB": "ZMW",
+}
+
+// cache lifetimes of the read-only endpoints
+const (
+	balancesTTL     = 30 * time.Second
+	availabilityTTL = time.Minute
+	activeConfTTL   = 10 * time.Minute
+	predictionTTL   = 24 * time.Hour
+)
+
+// Doer sends HTTP requests, *http.Client satisfies it and StubDoer stands in for it in tests
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type Client struct {
+	BaseURL    string
+	Token      string
+	HTTPClient Doer
+}
+
+func NewClient(baseURL, token string) *Client {
+
+	if baseURL == "" {
+
+		baseURL = SandboxURL
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		Token:      token,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// NewID generates a UUIDv4, the format pawapay requires for deposit and payout ids
+func NewID() string {
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// Currency returns the currency a correspondent transacts in
+func Currency(correspondent string) string {
+
+	parts := strings.Split(correspondent, "_")
+	return currencies[parts[len(parts)-1]]
+}
+
+// CountryOf returns the ISO3 country of a correspondent
+func CountryOf(correspondent string) string {
+
+	parts := strings.Split(correspondent, "_")
+	if _, ok := currencies[parts[len(parts)-1]]; !ok {
+
+		return ""
+	}
+
+	return parts[len(parts)-1]
+}
+
+// Timestamp formats t the way pawapay expects customerTimestamp
+func Timestamp(t time.Time) string {
+
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+func (c *Client) InitiateDeposit(req DepositRequest) (*InitiateResponse, error) {
+
+	var res InitiateResponse
+	return &res, c.do(http.MethodPost, "/deposits", req, &res)
+}
+
+func (c *Client) InitiatePayout(req PayoutRequest) (*InitiateResponse, error) {
+
+	var res InitiateResponse
+	return &res, c.do(http.MethodPost, "/payouts", req, &res)
+}
+
+// InitiateBulkPayout requests several payouts in one call, pawapay answers with one response per payout
+func (c *Client) InitiateBulkPayout(req []PayoutRequest) ([]InitiateResponse, error) {
+
+	var res []InitiateResponse
+	return res, c.do(http.MethodPost, "/payouts/bulk", req, &res)
+}
+
+func (c *Client) CheckDeposit(depositID string) ([]Transaction, error) {
+
+	var res []Transaction
+	return res, c.do(http.MethodGet, "/deposits/"+depositID, nil, &res)
+}
+
+func (c *Client) CheckPayout(payoutID string) ([]Transaction, error) {
+
+	var res []Transaction
+	return res, c.do(http.MethodGet, "/payouts/"+payoutID, nil, &res)
+}
+
+func (c *Client) ResendDepositCallback(depositID string) (*InitiateResponse, error) {
+
+	var res InitiateResponse
+	return &res, c.do(http.MethodPost, "/deposits/resend-callback", map[string]string{"depositId": depositID}, &res)
+}
+
+func (c *Client) ResendPayoutCallback(payoutID string) (*InitiateResponse, error) {
+
+	var res InitiateResponse
+	return &res, c.do(http.MethodPost, "/payouts/resend-callback", map[string]string{"payoutId": payoutID}, &res)
+}
+
+func (c *Client) Balances() ([]Balance, error) {
+
+	var res BalancesResponse
+	return res.Balances, c.cached(balancesTTL, http.MethodGet, "/v1/wallet-balances", nil, &res)
+}
+
+func (c *Client) CountryBalances(country string) ([]Balance, error) {
+
+	var res BalancesResponse
+	return res.Balances, c.cached(balancesTTL, http.MethodGet, "/v1/wallet-balances/"+country, nil, &res)
+}
+
+func (c *Client) ActiveConf() (*ActiveConf, error) {
+
+	var res ActiveConf
+	return &res, c.cached(activeConfTTL, http.MethodGet, "/active-conf", nil, &res)
+}
+
+func (c *Client) Availability() ([]Country, error) {
+
+	var res []Country
+	return res, c.cached(availabilityTTL, http.MethodGet, "/availability", nil, &res)
+}
+
+// PredictCorrespondent resolves the operator a phone number belongs to
+func (c *Client) PredictCorrespondent(msisdn string) (*Prediction, error) {
+
+	var res Prediction
+	return &res, c.cached(predictionTTL, http.MethodPost, "/v1/predict-correspondent", map[string]string{"msisdn": msisdn}, &res)
+}
+
+// cached serves a read-only request from the shared cache, calling pawapay on a miss
+func (c *Client) cached(ttl time.Duration, method, path string, body, out interface{}) error {
+
+	token := sha256.Sum256([]byte(c.Token))
+	key := fmt.Sprintf("%s|%x|%s %s", c.BaseURL, token[:8], method, path)
+
+	if body != nil {
+
+		payload, _ := json.Marshal(body)
+		key += "|" + string(payload)
+	}
+
+	if data, ok := cache.get(key); ok {
+
+		return json.Unmarshal(data, out)
+	}
+
+	var raw json.RawMessage
+	if err := c.do(method, path, body, &raw); err != nil {
+
+		return err
+	}
+
+	cache.set(key, raw, ttl)
+	return json.Unmarshal(raw, out)
+}
+
+func (c *Client) do(method, path string, body, out interface{}) error {
+
+	var reader io.Reader
+
+	if body != nil {
+
+		payload, err := json.Marshal(body)
+		if err != nil {
+
+			return err
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+
+		return err
+	}
+
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+
+		return err
+	}
+
+	if res.StatusCode >= 300 {
+
+		return fmt.Errorf("pawapay %s %s returned %d: %s", method, path, res.StatusCode, string(data))
+	}
+
+	if out == nil {
+
+		return nil
+	}
+
+	return json.Unmarshal(data, out)
+}
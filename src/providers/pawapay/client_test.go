@@ -0,0 +1,178 @@
+package pawapay
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+// stubClient returns a client talking to a stub. The base URL is unique per test so cached
+// responses never leak between tests
+func stubClient(t *testing.T) (*Client, *StubDoer) {
+
+	stub := NewStubDoer()
+	client := NewClient("https://"+t.Name()+".stub", "token")
+	client.HTTPClient = stub
+
+	return client, stub
+}
+
+func TestInitiateDeposit(t *testing.T) {
+
+	client, stub := stubClient(t)
+	stub.On(http.MethodPost, "/deposits", 200, `{"depositId":"d-1","status":"ACCEPTED","created":"2024-01-01T00:00:00Z"}`)
+
+	res, err := client.InitiateDeposit(DepositRequest{DepositID: "d-1", Amount: "100", Currency: "UGX", Correspondent: "MTN_MOMO_UGA"})
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if res.DepositID != "d-1" || res.Status != StatusAccepted {
+
+		t.Fatalf("unexpected response %+v", res)
+	}
+
+	if len(stub.Requests) != 1 {
+
+		t.Fatalf("expected 1 request, got %d", len(stub.Requests))
+	}
+
+	req := stub.Requests[0]
+	if req.Header.Get("Authorization") != "Bearer token" {
+
+		t.Fatalf("missing bearer token, got %q", req.Header.Get("Authorization"))
+	}
+
+	body, _ := io.ReadAll(req.Body)
+
+	var sent DepositRequest
+	if err := json.Unmarshal(body, &sent); err != nil || sent.DepositID != "d-1" || sent.Amount != "100" {
+
+		t.Fatalf("unexpected request body %s", body)
+	}
+}
+
+func TestInitiatePayoutRejected(t *testing.T) {
+
+	client, stub := stubClient(t)
+	stub.On(http.MethodPost, "/payouts", 200, `{"payoutId":"p-1","status":"REJECTED","rejectionReason":{"rejectionCode":"AMOUNT_TOO_LARGE","rejectionMessage":"Amount too large"}}`)
+
+	res, err := client.InitiatePayout(PayoutRequest{PayoutID: "p-1", Amount: "100"})
+	if err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if res.Status != StatusRejected || res.RejectionReason == nil || res.RejectionReason.Message != "Amount too large" {
+
+		t.Fatalf("unexpected response %+v", res)
+	}
+}
+
+func TestCheckDepositAndPayout(t *testing.T) {
+
+	client, stub := stubClient(t)
+	stub.On(http.MethodGet, "/deposits/d-1", 200, `[{"depositId":"d-1","status":"COMPLETED","depositedAmount":"100","currency":"UGX"}]`)
+	stub.On(http.MethodGet, "/payouts/p-1", 200, `[{"payoutId":"p-1","status":"FAILED","failureReason":{"failureCode":"OTHER_ERROR","failureMessage":"Recipient not found"}}]`)
+
+	deposits, err := client.CheckDeposit("d-1")
+	if err != nil || len(deposits) != 1 {
+
+		t.Fatalf("unexpected deposits %+v %v", deposits, err)
+	}
+
+	if deposits[0].ID() != "d-1" || deposits[0].Status != StatusCompleted {
+
+		t.Fatalf("unexpected deposit %+v", deposits[0])
+	}
+
+	payouts, err := client.CheckPayout("p-1")
+	if err != nil || len(payouts) != 1 {
+
+		t.Fatalf("unexpected payouts %+v %v", payouts, err)
+	}
+
+	if payouts[0].ID() != "p-1" || payouts[0].FailureReason == nil || payouts[0].FailureReason.Message != "Recipient not found" {
+
+		t.Fatalf("unexpected payout %+v", payouts[0])
+	}
+}
+
+func TestCheckDepositUnknown(t *testing.T) {
+
+	client, stub := stubClient(t)
+	stub.On(http.MethodGet, "/deposits/missing", 200, `[]`)
+
+	deposits, err := client.CheckDeposit("missing")
+	if err != nil || len(deposits) != 0 {
+
+		t.Fatalf("expected no deposits, got %+v %v", deposits, err)
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+
+	client, _ := stubClient(t)
+
+	if _, err := client.ResendDepositCallback("d-1"); err == nil {
+
+		t.Fatal("expected an error for an unstubbed request")
+	}
+}
+
+func TestCachedResponses(t *testing.T) {
+
+	client, stub := stubClient(t)
+	stub.On(http.MethodGet, "/active-conf", 200, `{"merchantId":"m-1","countries":[{"country":"UGA","correspondents":[{"correspondent":"MTN_MOMO_UGA","operationTypes":[{"operationType":"PAYOUT","status":"OPERATIONAL"}]}]}]}`)
+	stub.On(http.MethodPost, "/v1/predict-correspondent", 200, `{"country":"UGA","operator":"MTN","correspondent":"MTN_MOMO_UGA","msisdn":"256700000000"}`)
+
+	for i := 0; i < 3; i++ {
+
+		conf, err := client.ActiveConf()
+		if err != nil {
+
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		c, ok := conf.Correspondent("MTN_MOMO_UGA")
+		if !ok {
+
+			t.Fatal("expected MTN_MOMO_UGA to be active")
+		}
+
+		if _, ok := c.Operation("PAYOUT"); !ok {
+
+			t.Fatal("expected payouts on MTN_MOMO_UGA")
+		}
+	}
+
+	if _, err := client.PredictCorrespondent("256700000000"); err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err := client.PredictCorrespondent("256700000000"); err != nil {
+
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(stub.Requests) != 2 {
+
+		t.Fatalf("expected one request per endpoint, got %d", len(stub.Requests))
+	}
+}
+
+func TestCurrencyAndCountry(t *testing.T) {
+
+	if Currency("MTN_MOMO_UGA") != "UGX" || CountryOf("MTN_MOMO_UGA") != "UGA" {
+
+		t.Fatal("unexpected currency or country for MTN_MOMO_UGA")
+	}
+
+	if Currency("UNKNOWN") != "" || CountryOf("UNKNOWN") != "" {
+
+		t.Fatal("expected no currency or country for an unknown correspondent")
+	}
+}
@@ -0,0 +1,57 @@
+package pawapay
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// StubDoer is an HTTP stand-in for tests and local runs. It answers requests with canned
+// responses keyed by method and path, e.g. "GET /active-conf", and records what it was sent
+type StubDoer struct {
+	mu        sync.Mutex
+	Responses map[string]StubResponse
+	Requests  []*http.Request
+}
+
+type StubResponse struct {
+	Status int
+	Body   string
+}
+
+func NewStubDoer() *StubDoer {
+
+	return &StubDoer{Responses: map[string]StubResponse{}}
+}
+
+// On registers the response returned for a method and path
+func (s *StubDoer) On(method, path string, status int, body string) *StubDoer {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Responses[method+" "+path] = StubResponse{Status: status, Body: body}
+	return s
+}
+
+func (s *StubDoer) Do(req *http.Request) (*http.Response, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Requests = append(s.Requests, req)
+
+	res, ok := s.Responses[req.Method+" "+req.URL.Path]
+	if !ok {
+
+		res = StubResponse{Status: http.StatusNotFound, Body: `{"errorMessage":"no stub for this request"}`}
+	}
+
+	return &http.Response{
+		StatusCode: res.Status,
+		Body:       io.NopCloser(bytes.NewBufferString(res.Body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    req,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package pawapay
+
+// Pawapay transaction statuses
+const (
+	StatusAccepted         = "ACCEPTED"
+	StatusRejected         = "REJECTED"
+	StatusDuplicateIgnored = "DUPLICATE_IGNORED"
+	StatusSubmitted        = "SUBMITTED"
+	StatusEnqueued         = "ENQUEUED"
+	StatusCompleted        = "COMPLETED"
+	StatusFailed           = "FAILED"
+)
+
+type Address struct {
+	Value string `json:"value"`
+}
+
+type Party struct {
+	Type    string  `json:"type"`
+	Address Address `json:"address"`
+}
+
+type DepositRequest struct {
+	DepositID            string `json:"depositId"`
+	Amount               string `json:"amount"`
+	Currency             string `json:"currency"`
+	Correspondent        string `json:"correspondent"`
+	Payer                Party  `json:"payer"`
+	CustomerTimestamp    string `json:"customerTimestamp"`
+	StatementDescription string `json:"statementDescription,omitempty"`
+}
+
+type PayoutRequest struct {
+	PayoutID             string `json:"payoutId"`
+	Amount               string `json:"amount"`
+	Currency             string `json:"currency"`
+	Correspondent        string `json:"correspondent"`
+	Recipient            Party  `json:"recipient"`
+	CustomerTimestamp    string `json:"customerTimestamp"`
+	StatementDescription string `json:"statementDescription,omitempty"`
+}
+
+type Reason struct {
+	Code    string `json:"rejectionCode,omitempty"`
+	Message string `json:"rejectionMessage,omitempty"`
+}
+
+type FailureReason struct {
+	Code    string `json:"failureCode,omitempty"`
+	Message string `json:"failureMessage,omitempty"`
+}
+
+// InitiateResponse is returned when a deposit or payout is requested or a callback resent
+type InitiateResponse struct {
+	DepositID       string  `json:"depositId,omitempty"`
+	PayoutID        string  `json:"payoutId,omitempty"`
+	Status          string  `json:"status"`
+	Created         string  `json:"created,omitempty"`
+	RejectionReason *Reason `json:"rejectionReason,omitempty"`
+}
+
+// Transaction is the state of a deposit or payout as reported by Pawapay
+type Transaction struct {
+	DepositID         string         `json:"depositId,omitempty"`
+	PayoutID          string         `json:"payoutId,omitempty"`
+	Status            string         `json:"status"`
+	Amount            string         `json:"amount,omitempty"`
+	RequestedAmount   string         `json:"requestedAmount,omitempty"`
+	DepositedAmount   string         `json:"depositedAmount,omitempty"`
+	Currency          string         `json:"currency"`
+	Country           string         `json:"country"`
+	Correspondent     string         `json:"correspondent"`
+	Payer             *Party         `json:"payer,omitempty"`
+	Recipient         *Party         `json:"recipient,omitempty"`
+	CustomerTimestamp string         `json:"customerTimestamp,omitempty"`
+	Created           string         `json:"created,omitempty"`
+	FailureReason     *FailureReason `json:"failureReason,omitempty"`
+}
+
+// ID returns the deposit or payout id of the transaction
+func (t Transaction) ID() string {
+
+	if t.DepositID != "" {
+
+		return t.DepositID
+	}
+
+	return t.PayoutID
+}
+
+type Balance struct {
+	Country  string `json:"country"`
+	Balance  string `json:"balance"`
+	Currency string `json:"currency"`
+	Mno      string `json:"mno"`
+}
+
+type BalancesResponse struct {
+	Balances []Balance `json:"balances"`
+}
+
+type OperationType struct {
+	OperationType       string `json:"operationType"`
+	Status              string `json:"status,omitempty"`
+	MinTransactionLimit string `json:"minTransactionLimit,omitempty"`
+	MaxTransactionLimit string `json:"maxTransactionLimit,omitempty"`
+}
+
+type Correspondent struct {
+	Correspondent  string          `json:"correspondent"`
+	Currency       string          `json:"currency,omitempty"`
+	OwnerName      string          `json:"ownerName,omitempty"`
+	OperationTypes []OperationType `json:"operationTypes"`
+}
+
+type Country struct {
+	Country        string          `json:"country"`
+	Correspondents []Correspondent `json:"correspondents"`
+}
+
+// ActiveConf is the merchant configuration: the countries and correspondents enabled for it
+type ActiveConf struct {
+	MerchantID   string    `json:"merchantId"`
+	MerchantName string    `json:"merchantName"`
+	Countries    []Country `json:"countries"`
+}
+
+// Correspondent returns the configuration of a correspondent if it is active for the merchant
+func (a ActiveConf) Correspondent(code string) (*Correspondent, bool) {
+
+	for _, country := range a.Countries {
+
+		for _, c := range country.Correspondents {
+
+			if c.Correspondent == code {
+
+				return &c, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// Operation returns the limits of an operation type on a correspondent
+func (c Correspondent) Operation(operationType string) (*OperationType, bool) {
+
+	for _, op := range c.OperationTypes {
+
+		if op.OperationType == operationType {
+
+			return &op, true
+		}
+	}
+
+	return nil, false
+}
+
+type Prediction struct {
+	Country       string `json:"country"`
+	Operator      string `json:"operator"`
+	Correspondent string `json:"correspondent"`
+	Msisdn        string `json:"msisdn"`
+}
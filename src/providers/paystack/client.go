@@ -0,0 +1,161 @@
+package paystack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const BaseURL = "https://api.paystack.co"
+
+type Client struct {
+	BaseURL    string
+	SecretKey  string
+	HTTPClient *http.Client
+}
+
+type Bank struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+	Slug string `json:"slug"`
+}
+
+type ResolvedAccount struct {
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+}
+
+type TransferRecipient struct {
+	RecipientCode string `json:"recipient_code"`
+}
+
+// Transfer is a payout to a bank account, its status is pending, success, failed, reversed or otp
+type Transfer struct {
+	Reference    string `json:"reference"`
+	TransferCode string `json:"transfer_code"`
+	Status       string `json:"status"`
+	Amount       int64  `json:"amount"`
+}
+
+type response struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func NewClient(baseURL, secretKey string) *Client {
+
+	if baseURL == "" {
+
+		baseURL = BaseURL
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		SecretKey:  secretKey,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (c *Client) ListBanks(country string) ([]Bank, error) {
+
+	var banks []Bank
+	return banks, c.get("/bank?country="+url.QueryEscape(country), &banks)
+}
+
+// ResolveAccount returns the name a bank account is registered under
+func (c *Client) ResolveAccount(accountNumber, bankCode string) (*ResolvedAccount, error) {
+
+	var account ResolvedAccount
+	query := url.Values{"account_number": {accountNumber}, "bank_code": {bankCode}}
+	return &account, c.get("/bank/resolve?"+query.Encode(), &account)
+}
+
+// CreateRecipient registers a bank account so transfers can be sent to it
+func (c *Client) CreateRecipient(name, accountNumber, bankCode, currency string) (*TransferRecipient, error) {
+
+	var recipient TransferRecipient
+	body := map[string]string{"type": "nuban", "name": name, "account_number": accountNumber, "bank_code": bankCode, "currency": currency}
+	return &recipient, c.send(http.MethodPost, "/transferrecipient", body, &recipient)
+}
+
+// InitiateTransfer sends amount, in the lowest currency unit, to a recipient
+func (c *Client) InitiateTransfer(recipientCode, reference, reason string, amount int64) (*Transfer, error) {
+
+	var transfer Transfer
+	body := map[string]interface{}{"source": "balance", "recipient": recipientCode, "reference": reference, "reason": reason, "amount": amount}
+	return &transfer, c.send(http.MethodPost, "/transfer", body, &transfer)
+}
+
+// VerifyTransfer returns the current state of a transfer by the reference it was sent with
+func (c *Client) VerifyTransfer(reference string) (*Transfer, error) {
+
+	var transfer Transfer
+	return &transfer, c.get("/transfer/verify/"+url.PathEscape(reference), &transfer)
+}
+
+func (c *Client) get(path string, out interface{}) error {
+
+	return c.send(http.MethodGet, path, nil, out)
+}
+
+func (c *Client) send(method, path string, body, out interface{}) error {
+
+	var reader io.Reader
+
+	if body != nil {
+
+		payload, err := json.Marshal(body)
+		if err != nil {
+
+			return err
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.SecretKey != "" {
+
+		req.Header.Set("Authorization", "Bearer "+c.SecretKey)
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+
+		return err
+	}
+
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+
+		return err
+	}
+
+	var r response
+	if err := json.Unmarshal(data, &r); err != nil {
+
+		return fmt.Errorf("paystack %s %s returned %d: %s", method, path, res.StatusCode, string(data))
+	}
+
+	if res.StatusCode >= 300 || !r.Status {
+
+		return fmt.Errorf("paystack %s %s returned %d: %s", method, path, res.StatusCode, r.Message)
+	}
+
+	return json.Unmarshal(r.Data, out)
+}
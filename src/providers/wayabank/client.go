@@ -0,0 +1,142 @@
+package wayabank
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const SandboxURL = "https://services.staging.wayabank.ng"
+
+type Client struct {
+	BaseURL    string
+	SecretKey  string
+	MerchantID string
+	HTTPClient *http.Client
+}
+
+type CreateAccountRequest struct {
+	AccountName string `json:"accountName"`
+	Reference   string `json:"reference"`
+	MerchantID  string `json:"merchantId"`
+}
+
+type Account struct {
+	AccountNumber string `json:"accountNumber"`
+	AccountName   string `json:"accountName"`
+	BankName      string `json:"bankName"`
+	Reference     string `json:"reference"`
+	Status        string `json:"status"`
+}
+
+// Inflow is a transfer received into a virtual account
+type Inflow struct {
+	Reference  string  `json:"reference"`
+	Amount     float64 `json:"amount"`
+	Type       string  `json:"type"`
+	Narration  string  `json:"narration"`
+	SenderName string  `json:"senderName"`
+	Date       string  `json:"date"`
+}
+
+type response struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func NewClient(baseURL, secretKey, merchantID string) *Client {
+
+	if baseURL == "" {
+
+		baseURL = SandboxURL
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		SecretKey:  secretKey,
+		MerchantID: merchantID,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (c *Client) CreateVirtualAccount(accountName, reference string) (*Account, error) {
+
+	var account Account
+	req := CreateAccountRequest{AccountName: accountName, Reference: reference, MerchantID: c.MerchantID}
+	return &account, c.do(http.MethodPost, "/api/v1/virtual-account/create", req, &account)
+}
+
+func (c *Client) GetVirtualAccount(accountNumber string) (*Account, error) {
+
+	var account Account
+	return &account, c.do(http.MethodGet, "/api/v1/virtual-account/"+accountNumber, nil, &account)
+}
+
+// Inflows returns the most recent transfers into a virtual account
+func (c *Client) Inflows(accountNumber string) ([]Inflow, error) {
+
+	var inflows []Inflow
+	return inflows, c.do(http.MethodGet, "/api/v1/virtual-account/"+accountNumber+"/transactions", nil, &inflows)
+}
+
+func (c *Client) do(method, path string, body, out interface{}) error {
+
+	var reader io.Reader
+
+	if body != nil {
+
+		payload, err := json.Marshal(body)
+		if err != nil {
+
+			return err
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+
+		return err
+	}
+
+	req.Header.Set("Authorization", c.SecretKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+
+		return err
+	}
+
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+
+		return err
+	}
+
+	var r response
+	if err := json.Unmarshal(data, &r); err != nil {
+
+		return fmt.Errorf("wayabank %s %s returned %d: %s", method, path, res.StatusCode, string(data))
+	}
+
+	if res.StatusCode >= 300 || !r.Status {
+
+		return fmt.Errorf("wayabank %s %s returned %d: %s", method, path, res.StatusCode, r.Message)
+	}
+
+	if out == nil || len(r.Data) == 0 {
+
+		return nil
+	}
+
+	return json.Unmarshal(r.Data, out)
+}
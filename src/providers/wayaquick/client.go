@@ -0,0 +1,173 @@
+package wayaquick
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	SandboxURL  = "https://services.staging.wayapay.ng/payment-gateway"
+	CheckoutURL = "https://pay.wayapay.ng/?_tranId="
+)
+
+// payment statuses reported by wayaquick
+const (
+	StatusSuccessful = "SUCCESSFUL"
+	StatusFailed     = "FAILED"
+	StatusPending    = "PENDING"
+	StatusAbandoned  = "ABANDONED"
+)
+
+// currencies are the currencies wayaquick checkouts are paid in, by ISO3 country
+var currencies = map[string]string{
+	"NGA": "NGN",
+}
+
+// Currency returns the currency checkouts of a country are paid in, empty when wayaquick does not
+// collect there
+func Currency(country string) string {
+
+	return currencies[strings.ToUpper(strings.TrimSpace(country))]
+}
+
+type Client struct {
+	BaseURL    string
+	SecretKey  string
+	PublicKey  string
+	MerchantID string
+	HTTPClient *http.Client
+}
+
+type InitRequest struct {
+	Amount        string `json:"amount"`
+	Narration     string `json:"narration"`
+	Currency      string `json:"currency"`
+	CustomerName  string `json:"fullName"`
+	CustomerPhone string `json:"phoneNumber"`
+	MerchantID    string `json:"merchantId"`
+	PublicKey     string `json:"wayaPublicKey"`
+	Reference     string `json:"merchantReference"`
+}
+
+type Payment struct {
+	TranID string  `json:"tranId"`
+	Status string  `json:"status"`
+	Amount float64 `json:"amount"`
+}
+
+type response struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func NewClient(baseURL, secretKey, publicKey, merchantID string) *Client {
+
+	if baseURL == "" {
+
+		baseURL = SandboxURL
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		SecretKey:  secretKey,
+		PublicKey:  publicKey,
+		MerchantID: merchantID,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Initialize creates a checkout session and returns its transaction id and link
+func (c *Client) Initialize(req InitRequest) (tranId, link string, err error) {
+
+	req.MerchantID = c.MerchantID
+	req.PublicKey = c.PublicKey
+
+	var payment Payment
+	if err := c.do(http.MethodPost, "/api/v1/request/transaction", req, &payment); err != nil {
+
+		return "", "", err
+	}
+
+	if payment.TranID == "" {
+
+		return "", "", fmt.Errorf("wayaquick returned no transaction id")
+	}
+
+	return payment.TranID, CheckoutURL + payment.TranID, nil
+}
+
+// Verify returns the current state of a checkout session
+func (c *Client) Verify(tranId string) (*Payment, error) {
+
+	var payment Payment
+	if err := c.do(http.MethodGet, "/api/v1/reference/query/"+tranId, nil, &payment); err != nil {
+
+		return nil, err
+	}
+
+	payment.Status = strings.ToUpper(payment.Status)
+	return &payment, nil
+}
+
+func (c *Client) do(method, path string, body, out interface{}) error {
+
+	var reader io.Reader
+
+	if body != nil {
+
+		payload, err := json.Marshal(body)
+		if err != nil {
+
+			return err
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+
+		return err
+	}
+
+	req.Header.Set("Authorization", c.SecretKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+
+		return err
+	}
+
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+
+		return err
+	}
+
+	var r response
+	if err := json.Unmarshal(data, &r); err != nil {
+
+		return fmt.Errorf("wayaquick %s %s returned %d: %s", method, path, res.StatusCode, string(data))
+	}
+
+	if res.StatusCode >= 300 || !r.Status {
+
+		return fmt.Errorf("wayaquick %s %s returned %d: %s", method, path, res.StatusCode, r.Message)
+	}
+
+	if out == nil || len(r.Data) == 0 {
+
+		return nil
+	}
+
+	return json.Unmarshal(r.Data, out)
+}
@@ -0,0 +1,83 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) ListBanks(ctx context.Context, in *pbWallet.ListBanksRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("ListBanks request")
+	success, status, message, data := controllers.ListBanks(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) SavePlayerName(ctx context.Context, in *pbWallet.PlayerNameRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("SavePlayerName request")
+	success, status, message, data := controllers.SavePlayerName(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) VerifyBankAccount(ctx context.Context, in *pbWallet.VerifyBankAccountRequest) (*pbWallet.VerifyBankAccountResponse, error) {
+
+	log.Printf("VerifyBankAccount request")
+	success, status, message, accountName := controllers.VerifyBankAccount(a.DB, in)
+
+	return &pbWallet.VerifyBankAccountResponse{
+		Status:      status,
+		Success:     success,
+		Message:     message,
+		AccountName: &accountName,
+	}, nil
+}
+
+func (a *App) GetUserAccounts(ctx context.Context, in *pbWallet.GetBalanceRequest) (*pbWallet.GetUserAccountsResponse, error) {
+
+	log.Printf("GetUserAccounts request")
+
+	return &pbWallet.GetUserAccountsResponse{
+		Data: controllers.GetUserAccounts(a.DB, in),
+	}, nil
+}
+
+func (a *App) RequestWithdrawal(ctx context.Context, in *pbWallet.WithdrawRequest) (*pbWallet.WithdrawResponse, error) {
+
+	log.Printf("RequestWithdrawal request")
+	success, status, message, data := controllers.RequestWithdrawal(a.DB, in)
+
+	return &pbWallet.WithdrawResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) UpdateWithdrawal(ctx context.Context, in *pbWallet.UpdateWithdrawalRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("UpdateWithdrawal request")
+	success, status, message, data := controllers.UpdateWithdrawal(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
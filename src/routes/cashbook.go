@@ -0,0 +1,282 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) CashbookCreateExpense(ctx context.Context, in *pbWallet.CashbookCreateExpenseRequest) (*pbWallet.ExpenseSingleResponse, error) {
+
+	log.Printf("CashbookCreateExpense request")
+	success, status, message, data := controllers.CreateExpense(a.DB, in)
+
+	return &pbWallet.ExpenseSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookUpdateOneExpense(ctx context.Context, in *pbWallet.CashbookCreateExpenseRequest) (*pbWallet.ExpenseSingleResponse, error) {
+
+	log.Printf("CashbookUpdateOneExpense request")
+	success, status, message, data := controllers.UpdateExpense(a.DB, in)
+
+	return &pbWallet.ExpenseSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookDeleteOneExpense(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.ExpenseSingleResponse, error) {
+
+	log.Printf("CashbookDeleteOneExpense request")
+	success, status, message, data := controllers.DeleteExpense(a.DB, in)
+
+	return &pbWallet.ExpenseSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindOneExpense(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.ExpenseSingleResponse, error) {
+
+	log.Printf("CashbookFindOneExpense request")
+	success, status, message, data := controllers.FindOneExpense(a.DB, in)
+
+	return &pbWallet.ExpenseSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllExpense(ctx context.Context, in *pbWallet.ClientRequest) (*pbWallet.ExpenseRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllExpense request")
+	success, status, message, data := controllers.FindAllExpense(a.DB, in)
+
+	return &pbWallet.ExpenseRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllBranchExpense(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.ExpenseRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllBranchExpense request")
+	success, status, message, data := controllers.FindAllBranchExpense(a.DB, in)
+
+	return &pbWallet.ExpenseRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookApproveExpense(ctx context.Context, in *pbWallet.CashbookApproveExpenseRequest) (*pbWallet.ExpenseSingleResponse, error) {
+
+	log.Printf("CashbookApproveExpense request")
+	success, status, message, data := controllers.ApproveExpense(a.DB, in)
+
+	return &pbWallet.ExpenseSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookCreateExpenseType(ctx context.Context, in *pbWallet.CashbookCreateExpenseTypeRequest) (*pbWallet.ExpenseTypeSingleResponse, error) {
+
+	log.Printf("CashbookCreateExpenseType request")
+	success, status, message, data := controllers.CreateExpenseType(a.DB, in)
+
+	return &pbWallet.ExpenseTypeSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookUpdateExpenseType(ctx context.Context, in *pbWallet.CashbookCreateExpenseTypeRequest) (*pbWallet.ExpenseTypeSingleResponse, error) {
+
+	log.Printf("CashbookUpdateExpenseType request")
+	success, status, message, data := controllers.UpdateExpenseType(a.DB, in)
+
+	return &pbWallet.ExpenseTypeSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookDeleteExpenseType(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.ExpenseTypeSingleResponse, error) {
+
+	log.Printf("CashbookDeleteExpenseType request")
+	success, status, message, data := controllers.DeleteExpenseType(a.DB, in)
+
+	return &pbWallet.ExpenseTypeSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllExpenseType(ctx context.Context, in *pbWallet.ClientRequest) (*pbWallet.ExpenseTypeRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllExpenseType request")
+	success, status, message, data := controllers.FindAllExpenseType(a.DB, in)
+
+	return &pbWallet.ExpenseTypeRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllClientExpenseType(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.ExpenseTypeRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllClientExpenseType request")
+	success, status, message, data := controllers.FindAllClientExpenseType(a.DB, in)
+
+	return &pbWallet.ExpenseTypeRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookSetExpenseBudget(ctx context.Context, in *pbWallet.ExpenseBudgetRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CashbookSetExpenseBudget request")
+	success, status, message, data := controllers.SetExpenseBudget(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookSetExpenseApprover(ctx context.Context, in *pbWallet.ExpenseApproverRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CashbookSetExpenseApprover request")
+	success, status, message, data := controllers.SetExpenseApprover(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookHandleReport(ctx context.Context, in *pbWallet.HandleReportRequest) (*pbWallet.LastApprovedResponseObj, error) {
+
+	log.Printf("CashbookHandleReport request")
+	success, status, message, data := controllers.HandleReport(a.DB, in)
+
+	return &pbWallet.LastApprovedResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFetchLastApproved(ctx context.Context, in *pbWallet.FetchLastApprovedRequest) (*pbWallet.LastApprovedResponse, error) {
+
+	log.Printf("CashbookFetchLastApproved request")
+	success, status, message, data := controllers.FetchLastApproved(a.DB, in)
+
+	return &pbWallet.LastApprovedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookVerifyFinalTransaction(ctx context.Context, in *pbWallet.FetchLastApprovedRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CashbookVerifyFinalTransaction request")
+	success, status, message, data := controllers.VerifyFinalTransaction(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFetchSalesReport(ctx context.Context, in *pbWallet.FetchSalesReportRequest) (*pbWallet.SalesReportResponseArray, error) {
+
+	log.Printf("CashbookFetchSalesReport request")
+	success, status, message, data := controllers.FetchSalesReport(a.DB, in)
+
+	return &pbWallet.SalesReportResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFetchReport(ctx context.Context, in *pbWallet.FetchReportRequest) (*pbWallet.FetchReportResponse, error) {
+
+	log.Printf("CashbookFetchReport request")
+	success, status, message, data := controllers.FetchReport(a.DB, in)
+
+	return &pbWallet.FetchReportResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFetchMonthlyShopReport(ctx context.Context, in *pbWallet.FetchReportRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CashbookFetchMonthlyShopReport request")
+	success, status, message, data := controllers.FetchMonthlyShopReport(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CurrentReport(ctx context.Context, in *pbWallet.FetchReportRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CurrentReport request")
+	success, status, message, data := controllers.CurrentReport(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
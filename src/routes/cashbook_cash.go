@@ -0,0 +1,218 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+	"github.com/zoroplay/go-wallet-service/models"
+)
+
+func (a *App) CashbookCreateCashIn(ctx context.Context, in *pbWallet.CashbookCreateCashInOutRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookCreateCashIn request")
+	success, status, message, data := controllers.CreateCashInOut(a.DB, models.CashIn, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookUpdateCashIn(ctx context.Context, in *pbWallet.CashbookCreateCashInOutRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookUpdateCashIn request")
+	success, status, message, data := controllers.UpdateCashInOut(a.DB, models.CashIn, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookDeleteOneCashIn(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookDeleteOneCashIn request")
+	success, status, message, data := controllers.DeleteCashInOut(a.DB, models.CashIn, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindOneCashIn(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookFindOneCashIn request")
+	success, status, message, data := controllers.FindOneCashInOut(a.DB, models.CashIn, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllCashIn(ctx context.Context, in *pbWallet.EmptyRequest) (*pbWallet.CashInOutRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllCashIn request")
+	success, status, message, data := controllers.FindAllCashInOut(a.DB, models.CashIn)
+
+	return &pbWallet.CashInOutRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllBranchCashIn(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.CashInOutRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllBranchCashIn request")
+	success, status, message, data := controllers.FindAllBranchCashInOut(a.DB, models.CashIn, in)
+
+	return &pbWallet.CashInOutRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) FindAllBranchApprovedCashinWDate(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.CashInOutRepeatedResponse, error) {
+
+	log.Printf("FindAllBranchApprovedCashinWDate request")
+	success, status, message, data := controllers.FindAllBranchCashInOutByStatus(a.DB, models.CashIn, models.CashbookApproved, in)
+
+	return &pbWallet.CashInOutRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) FindAllBranchPendingCashinWDate(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.CashInOutRepeatedResponse, error) {
+
+	log.Printf("FindAllBranchPendingCashinWDate request")
+	success, status, message, data := controllers.FindAllBranchCashInOutByStatus(a.DB, models.CashIn, models.CashbookPending, in)
+
+	return &pbWallet.CashInOutRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookApproveCashIn(ctx context.Context, in *pbWallet.CashbookApproveCashInOutRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookApproveCashIn request")
+	success, status, message, data := controllers.ApproveCashInOut(a.DB, models.CashIn, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookCreateCashOut(ctx context.Context, in *pbWallet.CashbookCreateCashInOutRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookCreateCashOut request")
+	success, status, message, data := controllers.CreateCashInOut(a.DB, models.CashOut, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookUpdateCashOut(ctx context.Context, in *pbWallet.CashbookCreateCashInOutRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookUpdateCashOut request")
+	success, status, message, data := controllers.UpdateCashInOut(a.DB, models.CashOut, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookDeleteOneCashOut(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookDeleteOneCashOut request")
+	success, status, message, data := controllers.DeleteCashInOut(a.DB, models.CashOut, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindOneCashOut(ctx context.Context, in *pbWallet.CashbookIdRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookFindOneCashOut request")
+	success, status, message, data := controllers.FindOneCashInOut(a.DB, models.CashOut, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllCashOut(ctx context.Context, in *pbWallet.EmptyRequest) (*pbWallet.CashInOutRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllCashOut request")
+	success, status, message, data := controllers.FindAllCashInOut(a.DB, models.CashOut)
+
+	return &pbWallet.CashInOutRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookFindAllBranchCashOut(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.CashInOutRepeatedResponse, error) {
+
+	log.Printf("CashbookFindAllBranchCashOut request")
+	success, status, message, data := controllers.FindAllBranchCashInOut(a.DB, models.CashOut, in)
+
+	return &pbWallet.CashInOutRepeatedResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CashbookApproveCashOut(ctx context.Context, in *pbWallet.CashbookApproveCashInOutRequest) (*pbWallet.CashInOutSingleResponse, error) {
+
+	log.Printf("CashbookApproveCashOut request")
+	success, status, message, data := controllers.ApproveCashInOut(a.DB, models.CashOut, in)
+
+	return &pbWallet.CashInOutSingleResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
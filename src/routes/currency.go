@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) SaveClientCurrency(ctx context.Context, in *pbWallet.ClientCurrencyRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("SaveClientCurrency request")
+	success, status, message, data := controllers.SaveClientCurrency(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) GetClientCurrencies(ctx context.Context, in *pbWallet.ClientCurrencyRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("GetClientCurrencies request")
+	success, status, message, data := controllers.GetClientCurrencies(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
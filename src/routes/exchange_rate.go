@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) WalletTransfer(ctx context.Context, in *pbWallet.WalletTransferRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("WalletTransfer request")
+	success, status, message, data := controllers.WalletTransfer(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) SaveExchangeRate(ctx context.Context, in *pbWallet.ExchangeRateRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("SaveExchangeRate request")
+	success, status, message, data := controllers.SaveExchangeRate(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) ImportExchangeRates(ctx context.Context, in *pbWallet.ExchangeRateImportRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("ImportExchangeRates request")
+	success, status, message, data := controllers.ImportExchangeRates(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) GetExchangeRates(ctx context.Context, in *pbWallet.ExchangeRateRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("GetExchangeRates request")
+	success, status, message, data := controllers.GetExchangeRates(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zoroplay/go-wallet-service/controllers"
+)
+
+// Jobs runs the periodic background work of the service
+func (a *App) Jobs() {
+
+	interval := envInt("deposit_sweep_interval", 5)
+	timeout := envInt("deposit_pending_timeout", 30)
+
+	go controllers.BackfillPlayerTotals(a.DB)
+
+	log.Printf("deposit and inflow sweep every %d minutes for deposits pending over %d minutes", interval, timeout)
+
+	ticker := time.NewTicker(time.Duration(interval) * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+
+		controllers.ReconcilePendingDeposits(a.DB, timeout)
+		controllers.ReconcilePendingPayouts(a.DB, timeout)
+		controllers.SweepVirtualAccountInflows(a.DB)
+	}
+}
+
+// envInt reads a positive integer setting, falling back to def
+func envInt(name string, def int) int {
+
+	v, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || v <= 0 {
+
+		return def
+	}
+
+	return v
+}
+
+// DepositSweep reports what the pending deposit sweep has fixed per provider
+func (a *App) DepositSweep(c echo.Context) error {
+
+	return c.JSON(200, controllers.DepositSweepStats())
+}
@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) StkDepositNotification(ctx context.Context, in *pbWallet.StkTransactionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("StkDepositNotification request")
+	success, status, message, data := controllers.StkDepositNotification(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) StkWithdrawNotification(ctx context.Context, in *pbWallet.StkTransactionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("StkWithdrawNotification request")
+	success, status, message, data := controllers.StkWithdrawNotification(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) StkStatusNotification(ctx context.Context, in *pbWallet.StkTransactionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("StkStatusNotification request")
+	success, status, message, data := controllers.StkStatusNotification(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) StkRegisterUrl(ctx context.Context, in *pbWallet.StkRegisterUrlRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("StkRegisterUrl request")
+	success, status, message, data := controllers.StkRegisterUrl(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
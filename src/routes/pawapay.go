@@ -0,0 +1,143 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) HandleCreatePawaPay(ctx context.Context, in *pbWallet.CreatePawapayRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandleCreatePawaPay request")
+	success, status, message, data := controllers.CreatePawapay(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) PawapayCallback(ctx context.Context, in *pbWallet.PawapayRequest) (*pbWallet.PawapayResponse, error) {
+
+	log.Printf("PawapayCallback request")
+	success, message := controllers.PawapayCallback(a.DB, in)
+
+	return &pbWallet.PawapayResponse{
+		Success: success,
+		Message: message,
+	}, nil
+}
+
+func (a *App) HandleFetchPawaPay(ctx context.Context, in *pbWallet.FetchPawapayRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("HandleFetchPawaPay request")
+	success, status, message, data := controllers.FetchPawapay(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandlePawaPayResendCallback(ctx context.Context, in *pbWallet.FetchPawapayRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandlePawaPayResendCallback request")
+	success, status, message, data := controllers.ResendPawapayCallback(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandleCreateBulkPawaPay(ctx context.Context, in *pbWallet.CreateBulkPawapayRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("HandleCreateBulkPawaPay request")
+	success, status, message, data := controllers.CreateBulkPawapay(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandlePawaPayBalances(ctx context.Context, in *pbWallet.PawapayCountryRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("HandlePawaPayBalances request")
+	success, status, message, data := controllers.PawapayBalances(a.DB, &pbWallet.PawapayCountryRequest{ClientId: in.ClientId})
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandlePawaPayCountryBalances(ctx context.Context, in *pbWallet.PawapayCountryRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("HandlePawaPayCountryBalances request")
+
+	if in.Country == "" {
+
+		return &pbWallet.CommonResponseArray{Status: 400, Message: "Country is required"}, nil
+	}
+
+	success, status, message, data := controllers.PawapayBalances(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandlePawaPayActiveConf(ctx context.Context, in *pbWallet.PawapayCountryRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandlePawaPayActiveConf request")
+	success, status, message, data := controllers.PawapayActiveConf(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandlePawaPayPredCorr(ctx context.Context, in *pbWallet.PawapayPredCorrRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandlePawaPayPredCorr request")
+	success, status, message, data := controllers.PawapayPredictCorrespondent(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandlePawaPayToolkit(ctx context.Context, in *pbWallet.PawapayToolkitRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("HandlePawaPayToolkit request")
+	success, status, message, data := controllers.PawapayToolkit(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
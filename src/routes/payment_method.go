@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) SavePaymentMethod(ctx context.Context, in *pbWallet.PaymentMethodRequest) (*pbWallet.PaymentMethodResponse, error) {
+
+	log.Printf("SavePaymentMethod request")
+	success, status, message, data := controllers.SavePaymentMethod(a.DB, in)
+
+	return &pbWallet.PaymentMethodResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) GetPaymentMethods(ctx context.Context, in *pbWallet.GetPaymentMethodRequest) (*pbWallet.GetPaymentMethodResponse, error) {
+
+	log.Printf("GetPaymentMethods request")
+	success, status, message, data := controllers.GetPaymentMethods(a.DB, in)
+
+	return &pbWallet.GetPaymentMethodResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) DeletePaymentMethod(ctx context.Context, in *pbWallet.PaymentMethodRequest) (*pbWallet.PaymentMethodResponse, error) {
+
+	log.Printf("DeletePaymentMethod request")
+	success, status, message, data := controllers.DeletePaymentMethod(a.DB, in)
+
+	return &pbWallet.PaymentMethodResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
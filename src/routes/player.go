@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func errorMessage(success bool, message string) *string {
+
+	if success || message == "" {
+
+		return nil
+	}
+
+	return &message
+}
+
+func (a *App) FetchBetRange(ctx context.Context, in *pbWallet.FetchBetRangeRequest) (*pbWallet.FetchBetRangeResponse, error) {
+
+	log.Printf("FetchBetRange request")
+	success, status, message, data := controllers.FetchBetRange(a.DB, in)
+
+	return &pbWallet.FetchBetRangeResponse{
+		Status:  status,
+		Success: success,
+		Data:    data,
+		Error:   errorMessage(success, message),
+	}, nil
+}
+
+func (a *App) FetchDepositRange(ctx context.Context, in *pbWallet.FetchDepositRangeRequest) (*pbWallet.FetchDepositRangeResponse, error) {
+
+	log.Printf("FetchDepositRange request")
+	success, status, message, data := controllers.FetchDepositRange(a.DB, in)
+
+	return &pbWallet.FetchDepositRangeResponse{
+		Status:  status,
+		Success: success,
+		Data:    data,
+		Error:   errorMessage(success, message),
+	}, nil
+}
+
+func (a *App) FetchDepositCount(ctx context.Context, in *pbWallet.FetchDepositCountRequest) (*pbWallet.FetchDepositCountResponse, error) {
+
+	log.Printf("FetchDepositCount request")
+	success, status, message, data := controllers.FetchDepositCount(a.DB, in)
+
+	return &pbWallet.FetchDepositCountResponse{
+		Status:  status,
+		Success: success,
+		Data:    data,
+		Error:   errorMessage(success, message),
+	}, nil
+}
+
+func (a *App) GetPlayerWalletData(ctx context.Context, in *pbWallet.GetBalanceRequest) (*pbWallet.PlayerWalletData, error) {
+
+	log.Printf("GetPlayerWalletData request")
+	return controllers.GetPlayerWalletData(a.DB, in), nil
+}
+
+func (a *App) FetchPlayerDeposit(ctx context.Context, in *pbWallet.FetchPlayerDepositRequest) (*pbWallet.WalletResponse, error) {
+
+	log.Printf("FetchPlayerDeposit request")
+	success, status, message, data := controllers.FetchPlayerDeposit(a.DB, in)
+
+	return &pbWallet.WalletResponse{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) DeletePlayerData(ctx context.Context, in *pbWallet.DeletePlayerDataRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("DeletePlayerData request")
+	success, status, message, data := controllers.DeletePlayerData(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
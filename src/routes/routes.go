@@ -10,6 +10,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/zoroplay/go-wallet-service/controllers"
 	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
 	"github.com/zoroplay/go-wallet-service/initializers"
 	"google.golang.org/grpc"
@@ -29,6 +30,12 @@ func (a *App) Initialize() {
 
 	a.DB = initializers.DbInstance()
 
+	// the running player totals start with this service, the backfill covers what came before
+	if err := controllers.RecordPlayerTotalsCutoff(a.DB); err != nil {
+
+		log.Printf("error recording player totals cutoff %s ", err.Error())
+	}
+
 	// init webserver
 	a.E = echo.New()
 	a.E.Use(middleware.Gzip())
@@ -62,6 +69,7 @@ func (a *App) setRouters() {
 
 	a.E.POST("/status", a.Status)
 	a.E.GET("/status", a.Status)
+	a.E.GET("/jobs/deposit-sweep", a.DepositSweep)
 }
 
 // Run the app on it's router
@@ -0,0 +1,100 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) OpenTillSession(ctx context.Context, in *pbWallet.TillSessionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("OpenTillSession request")
+	success, status, message, data := controllers.OpenTillSession(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) CloseTillSession(ctx context.Context, in *pbWallet.TillSessionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CloseTillSession request")
+	success, status, message, data := controllers.CloseTillSession(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandoverTillSession(ctx context.Context, in *pbWallet.TillSessionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandoverTillSession request")
+	success, status, message, data := controllers.HandoverTillSession(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) GetTillSession(ctx context.Context, in *pbWallet.TillSessionRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("GetTillSession request")
+	success, status, message, data := controllers.GetTillSession(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) ListTillSessions(ctx context.Context, in *pbWallet.BranchRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("ListTillSessions request")
+	success, status, message, data := controllers.ListTillSessions(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) ProcessShopDeposit(ctx context.Context, in *pbWallet.ProcessRetailTransaction) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("ProcessShopDeposit request")
+	success, status, message, data := controllers.ProcessShopDeposit(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) ProcessShopWithdrawal(ctx context.Context, in *pbWallet.ProcessRetailTransaction) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("ProcessShopWithdrawal request")
+	success, status, message, data := controllers.ProcessShopWithdrawal(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) ListDeposits(ctx context.Context, in *pbWallet.ListDepositRequests) (*pbWallet.PaginationResponse, error) {
+
+	log.Printf("ListDeposits request")
+	return controllers.ListDeposits(a.DB, in), nil
+}
+
+func (a *App) GetMoneyTransaction(ctx context.Context, in *pbWallet.GetTransactionsRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("GetMoneyTransaction request")
+	success, status, message, data := controllers.GetMoneyTransaction(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) GetSystemTransaction(ctx context.Context, in *pbWallet.GetTransactionsRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("GetSystemTransaction request")
+	success, status, message, data := controllers.GetSystemTransaction(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) SaveWalletType(ctx context.Context, in *pbWallet.WalletTypeRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("SaveWalletType request")
+	success, status, message, data := controllers.SaveWalletType(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) GetWalletTypes(ctx context.Context, in *pbWallet.WalletTypeRequest) (*pbWallet.CommonResponseArray, error) {
+
+	log.Printf("GetWalletTypes request")
+	success, status, message, data := controllers.GetWalletTypes(a.DB, in)
+
+	return &pbWallet.CommonResponseArray{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
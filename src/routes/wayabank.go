@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) CreateVirtualAccount(ctx context.Context, in *pbWallet.WayaBankRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("CreateVirtualAccount request")
+	success, status, message, data := controllers.CreateVirtualAccount(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) WayabankAccountEnquiry(ctx context.Context, in *pbWallet.WayaBankRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("WayabankAccountEnquiry request")
+	success, status, message, data := controllers.WayabankAccountEnquiry(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) HandleWayaQuickInit(ctx context.Context, in *pbWallet.WayaQuickRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandleWayaQuickInit request")
+	success, status, message, data := controllers.WayaQuickInit(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
+
+func (a *App) HandleWayaQuickVerify(ctx context.Context, in *pbWallet.WayaQuickRequest) (*pbWallet.CommonResponseObj, error) {
+
+	log.Printf("HandleWayaQuickVerify request")
+	success, status, message, data := controllers.WayaQuickVerify(a.DB, in)
+
+	return &pbWallet.CommonResponseObj{
+		Status:  status,
+		Success: success,
+		Message: message,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"context"
+	"log"
+
+	"github.com/zoroplay/go-wallet-service/controllers"
+	pbWallet "github.com/zoroplay/go-wallet-service/grpc/protobuf"
+)
+
+func (a *App) TigoWebhook(ctx context.Context, in *pbWallet.TigoWebhookRequest) (*pbWallet.TigoResponse, error) {
+
+	log.Printf("TigoWebhook request")
+	success, message := controllers.TigoWebhook(a.DB, in)
+
+	return &pbWallet.TigoResponse{
+		Success: success,
+		Message: message,
+	}, nil
+}